@@ -30,8 +30,10 @@ import (
 
 	resourceClient "github.com/zopdev/zopdev/api/resources/client"
 	resourceHandler "github.com/zopdev/zopdev/api/resources/handler/resource"
+	"github.com/zopdev/zopdev/api/resources/pricing"
 	"github.com/zopdev/zopdev/api/resources/providers/aws"
 	gcpResource "github.com/zopdev/zopdev/api/resources/providers/gcp"
+	"github.com/zopdev/zopdev/api/resources/providers/oci"
 	resourceService "github.com/zopdev/zopdev/api/resources/service/resource"
 	resourceStore "github.com/zopdev/zopdev/api/resources/store/resource"
 
@@ -116,25 +118,69 @@ func registerCloudResourceRoutes(app *gofr.App) {
 	client := resourceClient.New()
 	gcpClient := gcpResource.New()
 	awsClient := aws.New()
+	ociClient := oci.New()
 	resStore := resourceStore.New()
-	resSvc := resourceService.New(gcpClient, awsClient, client, resStore)
+
+	catalog, err := pricing.Load(app.Config.GetOrDefault("PRICING_CATALOG_DIR", "./configs/pricing"))
+	if err != nil {
+		app.Logger().Errorf("failed to load pricing catalog, resource costs will not be estimated: %v", err)
+
+		catalog = &pricing.Catalog{}
+	}
+
+	resSvc := resourceService.New(gcpClient, awsClient, ociClient, client, resStore, catalog,
+		[]byte(app.Config.Get("DELETE_CONFIRMATION_KEY")))
 	resHld := resourceHandler.New(resSvc)
 
 	// TODO: Figure out a way to sync resources on startup.
 
 	app.AddCronJob("0 * * * *", "resource-sync", resSvc.SyncCron)
+	app.AddCronJob("* * * * *", "resource-operations", resSvc.PollOperations)
+	app.AddCronJob("*/15 * * * *", "resource-restop", resSvc.RestopCron)
 
+	app.GET("/resources", resHld.ListResources)
 	app.GET("/cloud-account/{id}/resources", resHld.GetResources)
+	app.GET("/cloud-account/{id}/resources/cost", resHld.GetCost)
+	app.GET("/cloud-account/{id}/resources/savings", resHld.GetSavings)
 	app.POST("/cloud-account/{id}/resources/state", resHld.ChangeState)
 	app.POST("/cloud-account/{id}/resources/sync", resHld.SyncResources)
+	app.GET("/cloud-account/{id}/resources/sync-runs", resHld.GetSyncRuns)
+	app.GET("/cloud-account/{id}/resources/{resID}/operations", resHld.GetOperations)
+	app.GET("/cloud-account/{id}/resources/{resID}/history", resHld.GetHistory)
+	app.GET("/cloud-account/{id}/resources/{resID}/idle", resHld.GetIdle)
+	app.POST("/cloud-account/{id}/resources/{resID}/delete-confirmation", resHld.ConfirmDelete)
+	app.DELETE("/cloud-account/{id}/resources/{resID}", resHld.DeleteResource)
+	app.POST("/cloud-account/{id}/resources/{resID}/locks", resHld.CreateLock)
+	app.GET("/cloud-account/{id}/locks", resHld.GetLocks)
+	app.DELETE("/cloud-account/{id}/locks/{lockID}", resHld.ReleaseLock)
 
 	rgStr := resGroupStore.New()
 	rgSvc := resGroupService.New(rgStr, resSvc)
 	rgHld := resGroupHandler.New(rgSvc)
 
+	app.AddCronJob("* * * * *", "resource-group-schedule", rgSvc.ScheduleCron)
+	app.AddCronJob("* * * * *", "resource-group-calendar", rgSvc.CalendarCron)
+	app.AddCronJob("* * * * *", "resource-group-changes", rgSvc.ChangeCron)
+
 	app.GET("/cloud-account/{id}/resource-groups", rgHld.GetAllResourceGroups)
 	app.GET("/cloud-account/{id}/resource-groups/{rgID}", rgHld.GetResourceGroup)
 	app.POST("/cloud-account/{id}/resource-groups", rgHld.CreateResourceGroup)
 	app.PUT("/cloud-account/{id}/resource-groups/{rgID}", rgHld.UpdateResourceGroup)
 	app.DELETE("/cloud-account/{id}/resource-groups/{rgID}", rgHld.DeleteResourceGroup)
+	app.POST("/cloud-account/{id}/resource-groups/{rgID}/state", rgHld.ChangeGroupState)
+	app.GET("/cloud-account/{id}/resource-groups/{rgID}/state", rgHld.GetGroupState)
+	app.POST("/cloud-account/{id}/resource-groups/{rgID}/locks", rgHld.CreateLock)
+
+	app.GET("/cloud-account/{id}/resource-groups/{rgID}/schedule", rgHld.GetSchedule)
+	app.PUT("/cloud-account/{id}/resource-groups/{rgID}/schedule", rgHld.SetSchedule)
+	app.DELETE("/cloud-account/{id}/resource-groups/{rgID}/schedule", rgHld.DeleteSchedule)
+
+	app.POST("/cloud-account/{id}/calendars", rgHld.CreateCalendar)
+	app.GET("/cloud-account/{id}/calendars", rgHld.GetCalendars)
+	app.GET("/cloud-account/{id}/calendars/{calID}", rgHld.GetCalendar)
+	app.PUT("/cloud-account/{id}/calendars/{calID}", rgHld.UpdateCalendar)
+	app.DELETE("/cloud-account/{id}/calendars/{calID}", rgHld.DeleteCalendar)
+	app.GET("/cloud-account/{id}/resource-groups/{rgID}/calendars", rgHld.GetGroupCalendars)
+	app.PUT("/cloud-account/{id}/resource-groups/{rgID}/calendars/{calID}", rgHld.AttachCalendar)
+	app.DELETE("/cloud-account/{id}/resource-groups/{rgID}/calendars/{calID}", rgHld.DetachCalendar)
 }
@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addResourceOperationsTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE IF NOT EXISTS resource_operations (
+										id INTEGER PRIMARY KEY AUTOINCREMENT,
+										resource_id INTEGER NOT NULL,
+										cloud_account_id BIGINT NOT NULL,
+										action VARCHAR(20) NOT NULL,
+										target_state VARCHAR(20) NOT NULL,
+										status VARCHAR(20) NOT NULL,
+										error TEXT NOT NULL DEFAULT '',
+										created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+										updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+										completed_at TIMESTAMP DEFAULT NULL)`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addResourceEventsTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE IF NOT EXISTS resource_events (
+										id INTEGER PRIMARY KEY AUTOINCREMENT,
+										resource_id INTEGER NOT NULL,
+										cloud_account_id BIGINT NOT NULL,
+										event_type VARCHAR(30) NOT NULL,
+										from_status VARCHAR(20) NOT NULL DEFAULT '',
+										to_status VARCHAR(20) NOT NULL DEFAULT '',
+										actor VARCHAR(255) NOT NULL DEFAULT '',
+										message TEXT NOT NULL DEFAULT '',
+										created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP)`)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(`CREATE INDEX IF NOT EXISTS idx_resource_events_resource 
+										ON resource_events (cloud_account_id, resource_id, created_at)`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addResourceLabelsTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE IF NOT EXISTS resource_labels (
+										id INTEGER PRIMARY KEY AUTOINCREMENT,
+										resource_id INTEGER NOT NULL,
+										label_key VARCHAR(255) NOT NULL,
+										label_value VARCHAR(255) NOT NULL DEFAULT '',
+										UNIQUE(resource_id, label_key))`)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(`CREATE INDEX IF NOT EXISTS idx_resource_labels_key_value 
+										ON resource_labels (label_key, label_value)`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
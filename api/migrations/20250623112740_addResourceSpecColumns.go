@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addResourceSpecColumns() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			columns := []string{
+				`ALTER TABLE resources ADD COLUMN vcpu REAL NOT NULL DEFAULT 0`,
+				`ALTER TABLE resources ADD COLUMN memory_gb REAL NOT NULL DEFAULT 0`,
+				`ALTER TABLE resources ADD COLUMN storage_gb BIGINT NOT NULL DEFAULT 0`,
+				`ALTER TABLE resources ADD COLUMN machine_class VARCHAR(100) NOT NULL DEFAULT ''`,
+				`ALTER TABLE resources ADD COLUMN high_availability BOOLEAN NOT NULL DEFAULT FALSE`,
+			}
+
+			for _, column := range columns {
+				_, err := d.SQL.Exec(column)
+				if err != nil {
+					return err
+				}
+			}
+
+			_, err := d.SQL.Exec(`CREATE INDEX IF NOT EXISTS idx_resources_machine_class ON resources (machine_class)`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addResourceSavingsTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE IF NOT EXISTS resource_savings (
+										id INTEGER PRIMARY KEY AUTOINCREMENT,
+										resource_id INTEGER NOT NULL,
+										cloud_account_id BIGINT NOT NULL,
+										hourly_rate REAL NOT NULL DEFAULT 0,
+										currency VARCHAR(10) NOT NULL DEFAULT '',
+										stopped_at TIMESTAMP NOT NULL,
+										started_at TIMESTAMP DEFAULT NULL,
+										amount REAL NOT NULL DEFAULT 0,
+										created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP)`)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(`CREATE INDEX IF NOT EXISTS idx_resource_savings_account 
+										ON resource_savings (cloud_account_id, stopped_at)`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
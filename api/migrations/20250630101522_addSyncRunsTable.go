@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addSyncRunsTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`CREATE TABLE IF NOT EXISTS sync_runs (
+										id INTEGER PRIMARY KEY AUTOINCREMENT,
+										cloud_account_id BIGINT NOT NULL,
+										started_at TIMESTAMP NOT NULL,
+										finished_at TIMESTAMP DEFAULT NULL,
+										counts TEXT NOT NULL DEFAULT '{}',
+										errors TEXT NOT NULL DEFAULT '[]',
+										created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP)`)
+			if err != nil {
+				return err
+			}
+
+			_, err = d.SQL.Exec(`CREATE INDEX IF NOT EXISTS idx_sync_runs_account ON sync_runs (cloud_account_id)`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addSyncRunStatuses() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			_, err := d.SQL.Exec(`ALTER TABLE sync_runs ADD COLUMN statuses TEXT NOT NULL DEFAULT '{}'`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
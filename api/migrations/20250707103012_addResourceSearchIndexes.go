@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addResourceSearchIndexes() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			// The resources of all the cloud accounts are paginated by the sort column then by ID.
+			indexes := []string{
+				`CREATE INDEX IF NOT EXISTS idx_resources_name ON resources (name, id)`,
+				`CREATE INDEX IF NOT EXISTS idx_resources_state ON resources (state, id)`,
+				`CREATE INDEX IF NOT EXISTS idx_resources_region ON resources (region, id)`,
+				`CREATE INDEX IF NOT EXISTS idx_resources_type ON resources (resource_type, id)`,
+				`CREATE INDEX IF NOT EXISTS idx_resources_provider ON resources (cloud_provider, id)`,
+			}
+
+			for _, index := range indexes {
+				if _, err := d.SQL.Exec(index); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
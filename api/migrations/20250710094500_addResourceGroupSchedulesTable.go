@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addResourceGroupSchedulesTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			// A resource group has at most one schedule, last_state is the state last applied to its members.
+			_, err := d.SQL.Exec(`CREATE TABLE IF NOT EXISTS resource_group_schedules (
+										id INTEGER PRIMARY KEY AUTOINCREMENT,
+										group_id INTEGER NOT NULL UNIQUE,
+										timezone VARCHAR(64) NOT NULL,
+										windows TEXT NOT NULL DEFAULT '[]',
+										last_state VARCHAR(16) NOT NULL DEFAULT '',
+										created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+										updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+										FOREIGN KEY (group_id) REFERENCES resource_groups(id))`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
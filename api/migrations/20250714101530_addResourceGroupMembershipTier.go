@@ -0,0 +1,19 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addResourceGroupMembershipTier() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			// The members of a resource group are started by ascending tier and stopped by descending tier.
+			_, err := d.SQL.Exec(`ALTER TABLE resource_group_memberships ADD COLUMN tier INTEGER NOT NULL DEFAULT 0`)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
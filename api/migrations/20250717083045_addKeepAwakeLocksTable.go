@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addKeepAwakeLocksTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			// A lock is held either on a resource or on a resource group, the other ID is 0.
+			_, err := d.SQL.Exec(`CREATE TABLE IF NOT EXISTS keep_awake_locks (
+										id INTEGER PRIMARY KEY AUTOINCREMENT,
+										cloud_account_id BIGINT NOT NULL,
+										resource_id BIGINT NOT NULL DEFAULT 0,
+										group_id BIGINT NOT NULL DEFAULT 0,
+										until TIMESTAMP NOT NULL,
+										author VARCHAR(255) NOT NULL,
+										reason TEXT NOT NULL DEFAULT '',
+										created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP)`)
+			if err != nil {
+				return err
+			}
+
+			indexes := []string{
+				`CREATE INDEX IF NOT EXISTS idx_keep_awake_locks_resource ON keep_awake_locks (resource_id, until)`,
+				`CREATE INDEX IF NOT EXISTS idx_keep_awake_locks_group ON keep_awake_locks (group_id, until)`,
+				`CREATE INDEX IF NOT EXISTS idx_keep_awake_locks_account ON keep_awake_locks (cloud_account_id, until)`,
+			}
+
+			for _, index := range indexes {
+				if _, err := d.SQL.Exec(index); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addCalendarTables() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			queries := []string{
+				`CREATE TABLE IF NOT EXISTS calendars (
+										id INTEGER PRIMARY KEY AUTOINCREMENT,
+										name VARCHAR(255) NOT NULL,
+										timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+										created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+										updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP)`,
+				`CREATE TABLE IF NOT EXISTS calendar_periods (
+										id INTEGER PRIMARY KEY AUTOINCREMENT,
+										calendar_id BIGINT NOT NULL,
+										name VARCHAR(255) NOT NULL DEFAULT '',
+										start_at TIMESTAMP NOT NULL,
+										end_at TIMESTAMP NOT NULL)`,
+				`CREATE INDEX IF NOT EXISTS idx_calendar_periods_calendar ON calendar_periods (calendar_id, start_at)`,
+				// last_state is the state applied to the members of the group by an off calendar.
+				`CREATE TABLE IF NOT EXISTS resource_group_calendars (
+										group_id BIGINT NOT NULL,
+										calendar_id BIGINT NOT NULL,
+										kind VARCHAR(16) NOT NULL,
+										last_state VARCHAR(20) NOT NULL DEFAULT '',
+										PRIMARY KEY (group_id, calendar_id))`,
+			}
+
+			for _, query := range queries {
+				if _, err := d.SQL.Exec(query); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addResourceGroupChangesTable() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			queries := []string{
+				// tiers holds the members of the group in the order their tiers are changed, tier is the index of
+				// the tier changed last and members the outcome of every member so far.
+				`CREATE TABLE IF NOT EXISTS resource_group_changes (
+										id INTEGER PRIMARY KEY AUTOINCREMENT,
+										group_id BIGINT NOT NULL,
+										cloud_account_id BIGINT NOT NULL,
+										state VARCHAR(16) NOT NULL,
+										requested_by VARCHAR(255) NOT NULL DEFAULT '',
+										tiers TEXT NOT NULL DEFAULT '[]',
+										tier INTEGER NOT NULL DEFAULT -1,
+										tier_timeout INTEGER NOT NULL,
+										tier_deadline TIMESTAMP,
+										members TEXT NOT NULL DEFAULT '[]',
+										status VARCHAR(20) NOT NULL,
+										created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+										updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+										completed_at TIMESTAMP,
+										FOREIGN KEY (group_id) REFERENCES resource_groups(id))`,
+				// A resource group has at most one change in progress.
+				`CREATE UNIQUE INDEX IF NOT EXISTS idx_resource_group_changes_in_progress
+										ON resource_group_changes (group_id) WHERE status = 'IN_PROGRESS'`,
+				`CREATE INDEX IF NOT EXISTS idx_resource_group_changes_status ON resource_group_changes (status)`,
+			}
+
+			for _, query := range queries {
+				if _, err := d.SQL.Exec(query); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addResourceGroupChangeAttempts() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			queries := []string{
+				// attempt counts the changes of a group to the same state by a cron job whose members failed to change.
+				`ALTER TABLE resource_group_changes ADD COLUMN attempt INTEGER NOT NULL DEFAULT 1`,
+				// last_error is the failure of the last state the schedule gave up applying to the members.
+				`ALTER TABLE resource_group_schedules ADD COLUMN last_error TEXT NOT NULL DEFAULT ''`,
+			}
+
+			for _, query := range queries {
+				if _, err := d.SQL.Exec(query); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
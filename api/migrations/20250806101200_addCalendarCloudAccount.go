@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"gofr.dev/pkg/gofr/migration"
+)
+
+func addCalendarCloudAccount() migration.Migrate {
+	return migration.Migrate{
+		UP: func(d migration.Datasource) error {
+			queries := []string{
+				`ALTER TABLE calendars ADD COLUMN cloud_account_id BIGINT NOT NULL DEFAULT 0`,
+				// A calendar belongs to the cloud account of the resource groups it is attached to.
+				`UPDATE calendars SET cloud_account_id = COALESCE((SELECT MIN(g.cloud_account_id)
+										FROM resource_group_calendars gc JOIN resource_groups g ON g.id = gc.group_id
+										WHERE gc.calendar_id = calendars.id), 0)`,
+				`CREATE INDEX IF NOT EXISTS idx_calendars_cloud_account ON calendars (cloud_account_id)`,
+			}
+
+			for _, query := range queries {
+				if _, err := d.SQL.Exec(query); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
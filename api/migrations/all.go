@@ -16,5 +16,20 @@ func All() map[int64]migration.Migrate {
 		20250506162207: createTableAuditResults(),
 		20250526163931: addResourcesTable(),
 		20250531164921: addResourceGroup(),
+		20250612103015: addResourceOperationsTable(),
+		20250616094512: addResourceEventsTable(),
+		20250619141203: addResourceLabelsTable(),
+		20250623112740: addResourceSpecColumns(),
+		20250626093015: addResourceSavingsTable(),
+		20250630101522: addSyncRunsTable(),
+		20250702090418: addSyncRunStatuses(),
+		20250707103012: addResourceSearchIndexes(),
+		20250710094500: addResourceGroupSchedulesTable(),
+		20250714101530: addResourceGroupMembershipTier(),
+		20250717083045: addKeepAwakeLocksTable(),
+		20250721094210: addCalendarTables(),
+		20250728093020: addResourceGroupChangesTable(),
+		20250804091500: addResourceGroupChangeAttempts(),
+		20250806101200: addCalendarCloudAccount(),
 	}
 }
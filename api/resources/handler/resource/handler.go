@@ -1,14 +1,23 @@
 package resource
 
 import (
+	"errors"
 	"strconv"
+	"strings"
+	"time"
 
 	"gofr.dev/pkg/gofr"
 	gofrHttp "gofr.dev/pkg/gofr/http"
 
+	"github.com/zopdev/zopdev/api/resources/models"
 	"github.com/zopdev/zopdev/api/resources/service/resource"
 )
 
+var errInvalidLabelSelector = errors.New("invalid label selector")
+
+// defaultIdleWindow is the window over which a resource is checked for idleness when none is requested.
+const defaultIdleWindow = 24 * time.Hour
+
 type Handler struct {
 	svc Service
 }
@@ -30,7 +39,48 @@ func (h *Handler) GetResources(ctx *gofr.Context) (any, error) {
 
 	resourceType := ctx.Params("type")
 
-	res, err := h.svc.GetAll(ctx, accID, resourceType)
+	selectors, err := parseLabelSelectors(ctx.Params("label"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"label"}}
+	}
+
+	res, err := h.svc.GetAll(ctx, accID, resourceType, selectors...)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ListResources lists a page of the resources of all the cloud accounts. The sort query parameter is a field prefixed
+// with - for a descending sort, and the cursor query parameter is the next_cursor of the previous page.
+func (h *Handler) ListResources(ctx *gofr.Context) (any, error) {
+	selectors, err := parseLabelSelectors(ctx.Params("label"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"label"}}
+	}
+
+	sort := ctx.Param("sort")
+	q := &models.ResourceQuery{
+		Name:       ctx.Param("name"),
+		Statuses:   ctx.Params("status"),
+		Regions:    ctx.Params("region"),
+		Providers:  ctx.Params("provider"),
+		Types:      ctx.Params("type"),
+		Selectors:  selectors,
+		SortBy:     strings.TrimPrefix(sort, "-"),
+		Descending: strings.HasPrefix(sort, "-"),
+		Cursor:     ctx.Param("cursor"),
+	}
+
+	if limit := ctx.Param("limit"); limit != "" {
+		q.Limit, err = strconv.Atoi(limit)
+		if err != nil {
+			return nil, gofrHttp.ErrorInvalidParam{Params: []string{"limit"}}
+		}
+	}
+
+	res, err := h.svc.Search(ctx, q)
 	if err != nil {
 		return nil, err
 	}
@@ -84,3 +134,353 @@ func (h *Handler) SyncResources(ctx *gofr.Context) (any, error) {
 
 	return res, nil
 }
+
+// GetCost returns the estimated cost of the resources of a cloud account, aggregated by type, region and resource group.
+func (h *Handler) GetCost(ctx *gofr.Context) (any, error) {
+	id := ctx.PathParam("id")
+	if id == "" {
+		return nil, gofrHttp.ErrorMissingParam{Params: []string{"id"}}
+	}
+
+	accID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	res, err := h.svc.GetCost(ctx, accID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetSavings returns the amount saved by suspending the resources of a cloud account in a month given as YYYY-MM,
+// the current month by default.
+func (h *Handler) GetSavings(ctx *gofr.Context) (any, error) {
+	id := ctx.PathParam("id")
+	if id == "" {
+		return nil, gofrHttp.ErrorMissingParam{Params: []string{"id"}}
+	}
+
+	accID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var month time.Time
+
+	if m := ctx.Param("month"); m != "" {
+		month, err = time.Parse(resource.MonthFormat, m)
+		if err != nil {
+			return nil, gofrHttp.ErrorInvalidParam{Params: []string{"month"}}
+		}
+	}
+
+	res, err := h.svc.GetSavings(ctx, accID, month)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetSyncRuns returns the most recent sync runs of a cloud account.
+func (h *Handler) GetSyncRuns(ctx *gofr.Context) (any, error) {
+	id := ctx.PathParam("id")
+	if id == "" {
+		return nil, gofrHttp.ErrorMissingParam{Params: []string{"id"}}
+	}
+
+	accID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	res, err := h.svc.GetSyncRuns(ctx, accID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *Handler) GetOperations(ctx *gofr.Context) (any, error) {
+	id := ctx.PathParam("id")
+	if id == "" {
+		return nil, gofrHttp.ErrorMissingParam{Params: []string{"id"}}
+	}
+
+	accID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	resID, err := strconv.ParseInt(ctx.PathParam("resID"), 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"resID"}}
+	}
+
+	res, err := h.svc.GetOperations(ctx, accID, resID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *Handler) GetHistory(ctx *gofr.Context) (any, error) {
+	id := ctx.PathParam("id")
+	if id == "" {
+		return nil, gofrHttp.ErrorMissingParam{Params: []string{"id"}}
+	}
+
+	accID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	resID, err := strconv.ParseInt(ctx.PathParam("resID"), 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"resID"}}
+	}
+
+	from, err := parseTime(ctx.Param("from"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"from"}}
+	}
+
+	to, err := parseTime(ctx.Param("to"))
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"to"}}
+	}
+
+	res, err := h.svc.GetHistory(ctx, accID, resID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ConfirmDelete issues the short-lived token that confirms the deletion of a waste candidate such as an unattached
+// disk.
+func (h *Handler) ConfirmDelete(ctx *gofr.Context) (any, error) {
+	id := ctx.PathParam("id")
+	if id == "" {
+		return nil, gofrHttp.ErrorMissingParam{Params: []string{"id"}}
+	}
+
+	accID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	resID, err := strconv.ParseInt(ctx.PathParam("resID"), 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"resID"}}
+	}
+
+	res, err := h.svc.ConfirmDelete(ctx, accID, resID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// DeleteResource deletes a waste candidate such as an unattached disk, the request body must hold the token issued
+// by ConfirmDelete to confirm the deletion.
+func (h *Handler) DeleteResource(ctx *gofr.Context) (any, error) {
+	var req models.DeleteRequest
+
+	err := ctx.Bind(&req)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"request body"}}
+	}
+
+	id := ctx.PathParam("id")
+	if id == "" {
+		return nil, gofrHttp.ErrorMissingParam{Params: []string{"id"}}
+	}
+
+	accID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	resID, err := strconv.ParseInt(ctx.PathParam("resID"), 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"resID"}}
+	}
+
+	err = h.svc.Delete(ctx, accID, resID, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// GetIdle reports whether a resource such as a cache served no client over the window query parameter, a Go duration
+// that defaults to a day.
+func (h *Handler) GetIdle(ctx *gofr.Context) (any, error) {
+	id := ctx.PathParam("id")
+	if id == "" {
+		return nil, gofrHttp.ErrorMissingParam{Params: []string{"id"}}
+	}
+
+	accID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	resID, err := strconv.ParseInt(ctx.PathParam("resID"), 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"resID"}}
+	}
+
+	window := defaultIdleWindow
+
+	if w := ctx.Param("window"); w != "" {
+		window, err = time.ParseDuration(w)
+		if err != nil {
+			return nil, gofrHttp.ErrorInvalidParam{Params: []string{"window"}}
+		}
+	}
+
+	res, err := h.svc.IsIdle(ctx, accID, resID, window)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CreateLock keeps a resource awake until the time or for the duration of the lock in the request body.
+func (h *Handler) CreateLock(ctx *gofr.Context) (any, error) {
+	id := ctx.PathParam("id")
+	if id == "" {
+		return nil, gofrHttp.ErrorMissingParam{Params: []string{"id"}}
+	}
+
+	accID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	resID, err := strconv.ParseInt(ctx.PathParam("resID"), 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"resID"}}
+	}
+
+	var lock models.Lock
+
+	if err = ctx.Bind(&lock); err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"request body"}}
+	}
+
+	res, err := h.svc.LockResource(ctx, accID, resID, &lock)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetLocks lists the active keep-awake locks of a cloud account, optionally those held on the resource or on the
+// resource group of the resourceID or groupID query parameter.
+func (h *Handler) GetLocks(ctx *gofr.Context) (any, error) {
+	id := ctx.PathParam("id")
+	if id == "" {
+		return nil, gofrHttp.ErrorMissingParam{Params: []string{"id"}}
+	}
+
+	accID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	var resID, groupID int64
+
+	if v := ctx.Param("resourceID"); v != "" {
+		if resID, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, gofrHttp.ErrorInvalidParam{Params: []string{"resourceID"}}
+		}
+	}
+
+	if v := ctx.Param("groupID"); v != "" {
+		if groupID, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, gofrHttp.ErrorInvalidParam{Params: []string{"groupID"}}
+		}
+	}
+
+	res, err := h.svc.GetLocks(ctx, accID, resID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ReleaseLock releases a keep-awake lock before it expires.
+func (h *Handler) ReleaseLock(ctx *gofr.Context) (any, error) {
+	id := ctx.PathParam("id")
+	if id == "" {
+		return nil, gofrHttp.ErrorMissingParam{Params: []string{"id"}}
+	}
+
+	accID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}
+	}
+
+	lockID, err := strconv.ParseInt(ctx.PathParam("lockID"), 10, 64)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"lockID"}}
+	}
+
+	if err = h.svc.ReleaseLock(ctx, accID, lockID); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// parseTime parses an RFC 3339 time query parameter, an empty value returns the zero time.
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+// parseLabelSelectors parses the label selectors of the form key:value (equality), key!:value (inequality)
+// and key (existence).
+func parseLabelSelectors(values []string) ([]models.LabelSelector, error) {
+	selectors := make([]models.LabelSelector, 0, len(values))
+
+	for _, v := range values {
+		var sel models.LabelSelector
+
+		key, value, found := strings.Cut(v, ":")
+
+		switch {
+		case !found:
+			sel = models.LabelSelector{Key: key, Operator: models.LabelExists}
+		case strings.HasSuffix(key, "!"):
+			sel = models.LabelSelector{Key: strings.TrimSuffix(key, "!"), Value: value, Operator: models.LabelNotEquals}
+		default:
+			sel = models.LabelSelector{Key: key, Value: value, Operator: models.LabelEquals}
+		}
+
+		if sel.Key == "" {
+			return nil, errInvalidLabelSelector
+		}
+
+		selectors = append(selectors, sel)
+	}
+
+	return selectors, nil
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
@@ -63,6 +64,26 @@ func TestHandler_GetResources(t *testing.T) {
 					Return(mockResp, nil)
 			},
 		},
+		{
+			name:         "label selectors",
+			typeQuery:    "type=sql&label=team:payments&label=env!:prod&label=owner",
+			id:           "1",
+			expectedResp: mockResp,
+			mockCall: func() {
+				mockSvc.EXPECT().GetAll(ctx, int64(1), []string{"sql"},
+					models.LabelSelector{Key: "team", Value: "payments", Operator: models.LabelEquals},
+					models.LabelSelector{Key: "env", Value: "prod", Operator: models.LabelNotEquals},
+					models.LabelSelector{Key: "owner", Operator: models.LabelExists}).
+					Return(mockResp, nil)
+			},
+		},
+		{
+			name:        "invalid label selector",
+			typeQuery:   "label=:payments",
+			id:          "1",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"label"}},
+			mockCall:    func() {},
+		},
 		{
 			name:        "error in service",
 			typeQuery:   "type=sql",
@@ -196,8 +217,9 @@ func TestHandler_SyncResources(t *testing.T) {
 	ctx := &gofr.Context{
 		Context: context.Background(),
 	}
-	mockResp := []models.Resource{
-		{Name: "sql-instance-1"}, {Name: "sql-instance-2"},
+	mockResp := &models.SyncResult{
+		Resources: []models.Resource{{Name: "sql-instance-1"}, {Name: "sql-instance-2"}},
+		Statuses:  models.SyncStatuses{"SQL": {Status: "SUCCEEDED"}, "GCE": {Status: "FAILED", Error: "access denied"}},
 	}
 	h := New(mockSvc)
 
@@ -254,3 +276,941 @@ func TestHandler_SyncResources(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_GetCost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := NewMockService(ctrl)
+	ctx := &gofr.Context{
+		Context: context.Background(),
+	}
+	mockResp := &models.CostSummary{
+		Total:  models.CostBreakdown{Count: 1, Hourly: 0.2, Monthly: 146, Savings: 146},
+		ByType: map[string]models.CostBreakdown{"SQL": {Count: 1, Hourly: 0.2, Monthly: 146, Savings: 146}},
+	}
+	h := New(mockSvc)
+
+	testCases := []struct {
+		name        string
+		pathParam   string
+		expectedErr error
+		expectedRes any
+		mockCall    func()
+	}{
+		{
+			name:        "Success",
+			pathParam:   "123",
+			expectedRes: mockResp,
+			mockCall: func() {
+				mockSvc.EXPECT().GetCost(ctx, int64(123)).Return(mockResp, nil)
+			},
+		},
+		{
+			name:        "Service error",
+			pathParam:   "123",
+			expectedErr: errMock,
+			mockCall: func() {
+				mockSvc.EXPECT().GetCost(ctx, int64(123)).Return(nil, errMock)
+			},
+		},
+		{
+			name:        "Invalid id",
+			pathParam:   "a",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Missing id",
+			pathParam:   "",
+			expectedErr: gofrHttp.ErrorMissingParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCall()
+
+			req := httptest.NewRequest(http.MethodGet, "/cloud-account/{id}/resources/cost", http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{"id": tc.pathParam})
+			req.Header.Set("content-type", "application/json")
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			resp, err := h.GetCost(ctx)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedRes, resp)
+		})
+	}
+}
+
+func TestHandler_GetSavings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := NewMockService(ctrl)
+	ctx := &gofr.Context{
+		Context: context.Background(),
+	}
+	month := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	mockResp := &models.SavingsSummary{Month: "2025-06", Total: models.SavingsBreakdown{DowntimeHours: 10, Amount: 2}}
+	h := New(mockSvc)
+
+	testCases := []struct {
+		name        string
+		id          string
+		query       string
+		expectedErr error
+		expectedRes any
+		mockCall    func()
+	}{
+		{
+			name:        "Success",
+			id:          "123",
+			query:       "month=2025-06",
+			expectedRes: mockResp,
+			mockCall: func() {
+				mockSvc.EXPECT().GetSavings(ctx, int64(123), month).Return(mockResp, nil)
+			},
+		},
+		{
+			name:        "Success without month",
+			id:          "123",
+			expectedRes: mockResp,
+			mockCall: func() {
+				mockSvc.EXPECT().GetSavings(ctx, int64(123), time.Time{}).Return(mockResp, nil)
+			},
+		},
+		{
+			name:        "Service error",
+			id:          "123",
+			expectedErr: errMock,
+			mockCall: func() {
+				mockSvc.EXPECT().GetSavings(ctx, int64(123), time.Time{}).Return(nil, errMock)
+			},
+		},
+		{
+			name:        "Invalid month",
+			id:          "123",
+			query:       "month=june",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"month"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Invalid id",
+			id:          "a",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Missing id",
+			id:          "",
+			expectedErr: gofrHttp.ErrorMissingParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCall()
+
+			req := httptest.NewRequest(http.MethodGet, "/cloud-account/{id}/resources/savings?"+tc.query, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+			req.Header.Set("content-type", "application/json")
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			resp, err := h.GetSavings(ctx)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedRes, resp)
+		})
+	}
+}
+
+func TestHandler_GetSyncRuns(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := NewMockService(ctrl)
+	ctx := &gofr.Context{
+		Context: context.Background(),
+	}
+	mockResp := []models.SyncRun{{ID: 1, CloudAccountID: 123, Counts: models.SyncCounts{"SQL": {Added: 2}}}}
+	h := New(mockSvc)
+
+	testCases := []struct {
+		name        string
+		pathParam   string
+		expectedErr error
+		expectedRes any
+		mockCall    func()
+	}{
+		{
+			name:        "Success",
+			pathParam:   "123",
+			expectedRes: mockResp,
+			mockCall: func() {
+				mockSvc.EXPECT().GetSyncRuns(ctx, int64(123)).Return(mockResp, nil)
+			},
+		},
+		{
+			name:        "Service error",
+			pathParam:   "123",
+			expectedErr: errMock,
+			mockCall: func() {
+				mockSvc.EXPECT().GetSyncRuns(ctx, int64(123)).Return(nil, errMock)
+			},
+		},
+		{
+			name:        "Invalid id",
+			pathParam:   "a",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Missing id",
+			pathParam:   "",
+			expectedErr: gofrHttp.ErrorMissingParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCall()
+
+			req := httptest.NewRequest(http.MethodGet, "/cloud-account/{id}/resources/sync-runs", http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{"id": tc.pathParam})
+			req.Header.Set("content-type", "application/json")
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			resp, err := h.GetSyncRuns(ctx)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedRes, resp)
+		})
+	}
+}
+
+func TestHandler_GetOperations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := NewMockService(ctrl)
+	ctx := &gofr.Context{
+		Context: context.Background(),
+	}
+	mockResp := []models.Operation{
+		{ID: 1, ResourceID: 2, Action: "START", TargetState: "RUNNING", Status: "IN_PROGRESS"},
+	}
+	h := New(mockSvc)
+
+	testCases := []struct {
+		name        string
+		id          string
+		resID       string
+		expectedErr error
+		expectedRes any
+		mockCall    func()
+	}{
+		{
+			name:        "Success",
+			id:          "123",
+			resID:       "2",
+			expectedRes: mockResp,
+			mockCall: func() {
+				mockSvc.EXPECT().GetOperations(ctx, int64(123), int64(2)).Return(mockResp, nil)
+			},
+		},
+		{
+			name:        "Service error",
+			id:          "123",
+			resID:       "2",
+			expectedErr: errMock,
+			mockCall: func() {
+				mockSvc.EXPECT().GetOperations(ctx, int64(123), int64(2)).Return(nil, errMock)
+			},
+		},
+		{
+			name:        "Invalid resource id",
+			id:          "123",
+			resID:       "a",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"resID"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Missing id",
+			id:          "",
+			resID:       "2",
+			expectedErr: gofrHttp.ErrorMissingParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCall()
+
+			req := httptest.NewRequest(http.MethodGet, "/cloud-account/{id}/resources/{resID}/operations", http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id, "resID": tc.resID})
+			req.Header.Set("content-type", "application/json")
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			resp, err := h.GetOperations(ctx)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedRes, resp)
+		})
+	}
+}
+
+func TestHandler_GetHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := NewMockService(ctrl)
+	ctx := &gofr.Context{
+		Context: context.Background(),
+	}
+	from := time.Date(2025, 6, 1, 3, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 1, 4, 0, 0, 0, time.UTC)
+	mockResp := []models.Event{
+		{ID: 1, ResourceID: 2, Type: "STATE_CHANGE_REQUESTED", FromStatus: "RUNNING", ToStatus: "STOPPED", Actor: "jane"},
+	}
+	h := New(mockSvc)
+
+	testCases := []struct {
+		name        string
+		id          string
+		query       string
+		expectedErr error
+		expectedRes any
+		mockCall    func()
+	}{
+		{
+			name:        "Success",
+			id:          "123",
+			query:       "from=2025-06-01T03:00:00Z&to=2025-06-01T04:00:00Z",
+			expectedRes: mockResp,
+			mockCall: func() {
+				mockSvc.EXPECT().GetHistory(ctx, int64(123), int64(2), from, to).Return(mockResp, nil)
+			},
+		},
+		{
+			name:        "Success without time range",
+			id:          "123",
+			expectedRes: mockResp,
+			mockCall: func() {
+				mockSvc.EXPECT().GetHistory(ctx, int64(123), int64(2), time.Time{}, time.Time{}).Return(mockResp, nil)
+			},
+		},
+		{
+			name:        "Service error",
+			id:          "123",
+			expectedErr: errMock,
+			mockCall: func() {
+				mockSvc.EXPECT().GetHistory(ctx, int64(123), int64(2), time.Time{}, time.Time{}).Return(nil, errMock)
+			},
+		},
+		{
+			name:        "Invalid from",
+			id:          "123",
+			query:       "from=yesterday",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"from"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Invalid to",
+			id:          "123",
+			query:       "to=2025-06-01",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"to"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Missing id",
+			id:          "",
+			expectedErr: gofrHttp.ErrorMissingParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCall()
+
+			req := httptest.NewRequest(http.MethodGet, "/cloud-account/{id}/resources/{resID}/history?"+tc.query, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id, "resID": "2"})
+			req.Header.Set("content-type", "application/json")
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			resp, err := h.GetHistory(ctx)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedRes, resp)
+		})
+	}
+}
+
+func TestHandler_ConfirmDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := NewMockService(ctrl)
+	ctx := &gofr.Context{
+		Context: context.Background(),
+	}
+	h := New(mockSvc)
+	confirmation := &models.DeleteConfirmation{ResourceID: 2, Token: "1700000000.abc",
+		ExpiresAt: time.Unix(1700000000, 0)}
+
+	testCases := []struct {
+		name        string
+		id          string
+		resID       string
+		expectedRes any
+		expectedErr error
+		mockCall    func()
+	}{
+		{
+			name:        "Success",
+			id:          "123",
+			resID:       "2",
+			expectedRes: confirmation,
+			mockCall: func() {
+				mockSvc.EXPECT().ConfirmDelete(ctx, int64(123), int64(2)).Return(confirmation, nil)
+			},
+		},
+		{
+			name:        "Service error",
+			id:          "123",
+			resID:       "2",
+			expectedErr: errMock,
+			mockCall: func() {
+				mockSvc.EXPECT().ConfirmDelete(ctx, int64(123), int64(2)).Return(nil, errMock)
+			},
+		},
+		{
+			name:        "Invalid resID",
+			id:          "123",
+			resID:       "abc",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"resID"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Missing id",
+			id:          "",
+			resID:       "2",
+			expectedErr: gofrHttp.ErrorMissingParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCall()
+
+			req := httptest.NewRequest(http.MethodPost, "/cloud-account/{id}/resources/{resID}/delete-confirmation",
+				http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id, "resID": tc.resID})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			resp, err := h.ConfirmDelete(ctx)
+
+			assert.Equal(t, tc.expectedErr, err)
+
+			if tc.expectedErr == nil {
+				assert.Equal(t, tc.expectedRes, resp)
+			} else {
+				assert.Nil(t, resp)
+			}
+		})
+	}
+}
+
+func TestHandler_DeleteResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := NewMockService(ctrl)
+	ctx := &gofr.Context{
+		Context: context.Background(),
+	}
+	h := New(mockSvc)
+
+	testCases := []struct {
+		name        string
+		id          string
+		resID       string
+		reqBody     string
+		expectedErr error
+		mockCall    func()
+	}{
+		{
+			name:    "Success",
+			id:      "123",
+			resID:   "2",
+			reqBody: `{"token": "1700000000.abc", "requestedBy": "jane"}`,
+			mockCall: func() {
+				mockSvc.EXPECT().Delete(ctx, int64(123), int64(2),
+					&models.DeleteRequest{Token: "1700000000.abc", RequestedBy: "jane"}).Return(nil)
+			},
+		},
+		{
+			name:        "Service error",
+			id:          "123",
+			resID:       "2",
+			reqBody:     `{}`,
+			expectedErr: errMock,
+			mockCall: func() {
+				mockSvc.EXPECT().Delete(ctx, int64(123), int64(2), &models.DeleteRequest{}).Return(errMock)
+			},
+		},
+		{
+			name:        "Invalid request body",
+			id:          "123",
+			resID:       "2",
+			reqBody:     `""token": "abc"}`,
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"request body"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Invalid resID",
+			id:          "123",
+			resID:       "abc",
+			reqBody:     `{}`,
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"resID"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Missing id",
+			id:          "",
+			resID:       "2",
+			reqBody:     `{}`,
+			expectedErr: gofrHttp.ErrorMissingParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCall()
+
+			req := httptest.NewRequest(http.MethodDelete, "/cloud-account/{id}/resources/{resID}",
+				bytes.NewBufferString(tc.reqBody))
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id, "resID": tc.resID})
+			req.Header.Set("content-type", "application/json")
+
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			resp, err := h.DeleteResource(ctx)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Nil(t, resp)
+		})
+	}
+}
+
+func TestHandler_GetIdle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := NewMockService(ctrl)
+	ctx := &gofr.Context{
+		Context: context.Background(),
+	}
+	h := New(mockSvc)
+	check := &models.IdleCheck{ResourceID: 2, Window: "1h0m0s", Idle: true}
+
+	testCases := []struct {
+		name        string
+		id          string
+		resID       string
+		query       string
+		expectedRes any
+		expectedErr error
+		mockCall    func()
+	}{
+		{
+			name:        "Success",
+			id:          "123",
+			resID:       "2",
+			query:       "window=1h",
+			expectedRes: check,
+			mockCall: func() {
+				mockSvc.EXPECT().IsIdle(ctx, int64(123), int64(2), time.Hour).Return(check, nil)
+			},
+		},
+		{
+			name:        "Default window",
+			id:          "123",
+			resID:       "2",
+			expectedErr: errMock,
+			mockCall: func() {
+				mockSvc.EXPECT().IsIdle(ctx, int64(123), int64(2), 24*time.Hour).Return(nil, errMock)
+			},
+		},
+		{
+			name:        "Invalid window",
+			id:          "123",
+			resID:       "2",
+			query:       "window=day",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"window"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Invalid resID",
+			id:          "123",
+			resID:       "abc",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"resID"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Missing id",
+			id:          "",
+			resID:       "2",
+			expectedErr: gofrHttp.ErrorMissingParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCall()
+
+			req := httptest.NewRequest(http.MethodGet, "/cloud-account/{id}/resources/{resID}/idle?"+tc.query, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id, "resID": tc.resID})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			resp, err := h.GetIdle(ctx)
+
+			assert.Equal(t, tc.expectedErr, err)
+
+			if tc.expectedRes == nil {
+				assert.Nil(t, resp)
+			} else {
+				assert.Equal(t, tc.expectedRes, resp)
+			}
+		})
+	}
+}
+
+func TestHandler_ListResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := NewMockService(ctrl)
+	ctx := &gofr.Context{
+		Context: context.Background(),
+	}
+	page := &models.ResourcePage{Resources: []models.Resource{{ID: 1, Name: "sql-instance-1"}}, NextCursor: "abc"}
+	h := New(mockSvc)
+
+	testCases := []struct {
+		name         string
+		query        string
+		expectedErr  error
+		expectedResp any
+		mockCall     func()
+	}{
+		{
+			name: "all filters",
+			query: "name=sql&status=RUNNING&region=us-east-1&region=eu-west-1&provider=aws&type=RDS" +
+				"&label=team:payments&sort=-created_at&cursor=xyz&limit=20",
+			expectedResp: page,
+			mockCall: func() {
+				mockSvc.EXPECT().Search(ctx, &models.ResourceQuery{
+					Name: "sql", Statuses: []string{"RUNNING"}, Regions: []string{"us-east-1", "eu-west-1"},
+					Providers: []string{"aws"}, Types: []string{"RDS"},
+					Selectors:  []models.LabelSelector{{Key: "team", Value: "payments", Operator: models.LabelEquals}},
+					SortBy:     models.SortByCreatedAt,
+					Descending: true,
+					Cursor:     "xyz",
+					Limit:      20,
+				}).Return(page, nil)
+			},
+		},
+		{
+			name:        "no filters",
+			expectedErr: errMock,
+			mockCall: func() {
+				mockSvc.EXPECT().Search(ctx, gomock.Any()).
+					DoAndReturn(func(_ *gofr.Context, q *models.ResourceQuery) (*models.ResourcePage, error) {
+						assert.Empty(t, q.Name)
+						assert.Empty(t, q.Statuses)
+						assert.Empty(t, q.SortBy)
+						assert.False(t, q.Descending)
+						assert.Zero(t, q.Limit)
+
+						return nil, errMock
+					})
+			},
+		},
+		{
+			name:        "invalid limit",
+			query:       "limit=ten",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"limit"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "invalid label selector",
+			query:       "label=:payments",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"label"}},
+			mockCall:    func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCall()
+
+			req := httptest.NewRequest(http.MethodGet, "/resources?"+tc.query, http.NoBody)
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			resp, err := h.ListResources(ctx)
+
+			assert.Equal(t, tc.expectedErr, err)
+
+			if tc.expectedResp == nil {
+				assert.Nil(t, resp)
+			} else {
+				assert.Equal(t, tc.expectedResp, resp)
+			}
+		})
+	}
+}
+
+func TestHandler_CreateLock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := NewMockService(ctrl)
+	ctx := &gofr.Context{
+		Context: context.Background(),
+	}
+	h := New(mockSvc)
+	lock := &models.Lock{Duration: "4h", Author: "jane", Reason: "demo"}
+	created := &models.Lock{ID: 3, CloudAccountID: 123, ResourceID: 2, Until: time.Now().Add(4 * time.Hour),
+		Author: "jane", Reason: "demo"}
+
+	testCases := []struct {
+		name        string
+		id          string
+		resID       string
+		body        string
+		expectedRes any
+		expectedErr error
+		mockCall    func()
+	}{
+		{
+			name:        "Success",
+			id:          "123",
+			resID:       "2",
+			body:        `{"duration":"4h","author":"jane","reason":"demo"}`,
+			expectedRes: created,
+			mockCall: func() {
+				mockSvc.EXPECT().LockResource(ctx, int64(123), int64(2), lock).Return(created, nil)
+			},
+		},
+		{
+			name:        "Service error",
+			id:          "123",
+			resID:       "2",
+			body:        `{"duration":"4h","author":"jane","reason":"demo"}`,
+			expectedErr: errMock,
+			mockCall: func() {
+				mockSvc.EXPECT().LockResource(ctx, int64(123), int64(2), lock).Return(nil, errMock)
+			},
+		},
+		{
+			name:        "Invalid body",
+			id:          "123",
+			resID:       "2",
+			body:        `{`,
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"request body"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Invalid resID",
+			id:          "123",
+			resID:       "abc",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"resID"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Missing id",
+			id:          "",
+			resID:       "2",
+			expectedErr: gofrHttp.ErrorMissingParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCall()
+
+			req := httptest.NewRequest(http.MethodPost, "/cloud-account/{id}/resources/{resID}/locks",
+				bytes.NewBufferString(tc.body))
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id, "resID": tc.resID})
+			req.Header.Set("Content-Type", "application/json")
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			resp, err := h.CreateLock(ctx)
+
+			assert.Equal(t, tc.expectedErr, err)
+
+			if tc.expectedRes == nil {
+				assert.Nil(t, resp)
+			} else {
+				assert.Equal(t, tc.expectedRes, resp)
+			}
+		})
+	}
+}
+
+func TestHandler_GetLocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := NewMockService(ctrl)
+	ctx := &gofr.Context{
+		Context: context.Background(),
+	}
+	h := New(mockSvc)
+	locks := []models.Lock{{ID: 3, CloudAccountID: 123, GroupID: 4, Author: "jane"}}
+
+	testCases := []struct {
+		name        string
+		id          string
+		query       string
+		expectedRes any
+		expectedErr error
+		mockCall    func()
+	}{
+		{
+			name:        "Success",
+			id:          "123",
+			query:       "groupID=4",
+			expectedRes: locks,
+			mockCall: func() {
+				mockSvc.EXPECT().GetLocks(ctx, int64(123), int64(0), int64(4)).Return(locks, nil)
+			},
+		},
+		{
+			name:        "Service error",
+			id:          "123",
+			query:       "resourceID=2",
+			expectedErr: errMock,
+			mockCall: func() {
+				mockSvc.EXPECT().GetLocks(ctx, int64(123), int64(2), int64(0)).Return(nil, errMock)
+			},
+		},
+		{
+			name:        "Invalid resourceID",
+			id:          "123",
+			query:       "resourceID=abc",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"resourceID"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Invalid groupID",
+			id:          "123",
+			query:       "groupID=abc",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"groupID"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Invalid id",
+			id:          "abc",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCall()
+
+			req := httptest.NewRequest(http.MethodGet, "/cloud-account/{id}/locks?"+tc.query, http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			resp, err := h.GetLocks(ctx)
+
+			assert.Equal(t, tc.expectedErr, err)
+
+			if tc.expectedRes == nil {
+				assert.Nil(t, resp)
+			} else {
+				assert.Equal(t, tc.expectedRes, resp)
+			}
+		})
+	}
+}
+
+func TestHandler_ReleaseLock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSvc := NewMockService(ctrl)
+	ctx := &gofr.Context{
+		Context: context.Background(),
+	}
+	h := New(mockSvc)
+
+	testCases := []struct {
+		name        string
+		id          string
+		lockID      string
+		expectedErr error
+		mockCall    func()
+	}{
+		{
+			name:   "Success",
+			id:     "123",
+			lockID: "3",
+			mockCall: func() {
+				mockSvc.EXPECT().ReleaseLock(ctx, int64(123), int64(3)).Return(nil)
+			},
+		},
+		{
+			name:        "Service error",
+			id:          "123",
+			lockID:      "3",
+			expectedErr: errMock,
+			mockCall: func() {
+				mockSvc.EXPECT().ReleaseLock(ctx, int64(123), int64(3)).Return(errMock)
+			},
+		},
+		{
+			name:        "Invalid lockID",
+			id:          "123",
+			lockID:      "abc",
+			expectedErr: gofrHttp.ErrorInvalidParam{Params: []string{"lockID"}},
+			mockCall:    func() {},
+		},
+		{
+			name:        "Missing id",
+			id:          "",
+			lockID:      "3",
+			expectedErr: gofrHttp.ErrorMissingParam{Params: []string{"id"}},
+			mockCall:    func() {},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCall()
+
+			req := httptest.NewRequest(http.MethodDelete, "/cloud-account/{id}/locks/{lockID}", http.NoBody)
+			req = mux.SetURLVars(req, map[string]string{"id": tc.id, "lockID": tc.lockID})
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			resp, err := h.ReleaseLock(ctx)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Nil(t, resp)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package resource
 
 import (
+	"time"
+
 	"gofr.dev/pkg/gofr"
 
 	"github.com/zopdev/zopdev/api/resources/models"
@@ -8,7 +10,19 @@ import (
 )
 
 type Service interface {
-	GetAll(ctx *gofr.Context, id int64, resourceType []string) ([]models.Resource, error)
-	SyncResources(ctx *gofr.Context, id int64) ([]models.Resource, error)
+	GetAll(ctx *gofr.Context, id int64, resourceType []string, selectors ...models.LabelSelector) ([]models.Resource, error)
+	Search(ctx *gofr.Context, q *models.ResourceQuery) (*models.ResourcePage, error)
+	SyncResources(ctx *gofr.Context, id int64) (*models.SyncResult, error)
 	ChangeState(ctx *gofr.Context, resDetails resource.ResourceDetails) error
+	GetOperations(ctx *gofr.Context, cloudAccID, resourceID int64) ([]models.Operation, error)
+	GetHistory(ctx *gofr.Context, cloudAccID, resourceID int64, from, to time.Time) ([]models.Event, error)
+	GetCost(ctx *gofr.Context, id int64) (*models.CostSummary, error)
+	GetSavings(ctx *gofr.Context, id int64, month time.Time) (*models.SavingsSummary, error)
+	GetSyncRuns(ctx *gofr.Context, id int64) ([]models.SyncRun, error)
+	ConfirmDelete(ctx *gofr.Context, cloudAccID, resourceID int64) (*models.DeleteConfirmation, error)
+	Delete(ctx *gofr.Context, cloudAccID, resourceID int64, req *models.DeleteRequest) error
+	IsIdle(ctx *gofr.Context, cloudAccID, resourceID int64, window time.Duration) (*models.IdleCheck, error)
+	LockResource(ctx *gofr.Context, cloudAccID, resourceID int64, lock *models.Lock) (*models.Lock, error)
+	GetLocks(ctx *gofr.Context, cloudAccID, resourceID, groupID int64) ([]models.Lock, error)
+	ReleaseLock(ctx *gofr.Context, cloudAccID, lockID int64) error
 }
@@ -10,10 +10,11 @@
 package resource
 
 import (
-	service "github.com/zopdev/zopdev/api/resources/service/resource"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/zopdev/zopdev/api/resources/models"
+	resource "github.com/zopdev/zopdev/api/resources/service/resource"
 	gomock "go.uber.org/mock/gomock"
 	gofr "gofr.dev/pkg/gofr"
 )
@@ -43,7 +44,7 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 }
 
 // ChangeState mocks base method.
-func (m *MockService) ChangeState(ctx *gofr.Context, resDetails service.ResourceDetails) error {
+func (m *MockService) ChangeState(ctx *gofr.Context, resDetails resource.ResourceDetails) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ChangeState", ctx, resDetails)
 	ret0, _ := ret[0].(error)
@@ -56,26 +57,209 @@ func (mr *MockServiceMockRecorder) ChangeState(ctx, resDetails any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeState", reflect.TypeOf((*MockService)(nil).ChangeState), ctx, resDetails)
 }
 
+// ConfirmDelete mocks base method.
+func (m *MockService) ConfirmDelete(ctx *gofr.Context, cloudAccID, resourceID int64) (*models.DeleteConfirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmDelete", ctx, cloudAccID, resourceID)
+	ret0, _ := ret[0].(*models.DeleteConfirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmDelete indicates an expected call of ConfirmDelete.
+func (mr *MockServiceMockRecorder) ConfirmDelete(ctx, cloudAccID, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmDelete", reflect.TypeOf((*MockService)(nil).ConfirmDelete), ctx, cloudAccID, resourceID)
+}
+
+// Delete mocks base method.
+func (m *MockService) Delete(ctx *gofr.Context, cloudAccID, resourceID int64, req *models.DeleteRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, cloudAccID, resourceID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockServiceMockRecorder) Delete(ctx, cloudAccID, resourceID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, cloudAccID, resourceID, req)
+}
+
 // GetAll mocks base method.
-func (m *MockService) GetAll(ctx *gofr.Context, id int64, resourceType []string) ([]models.Resource, error) {
+func (m *MockService) GetAll(ctx *gofr.Context, id int64, resourceType []string, selectors ...models.LabelSelector) ([]models.Resource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx, id, resourceType)
+	varargs := []any{ctx, id, resourceType}
+	for _, a := range selectors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAll", varargs...)
 	ret0, _ := ret[0].([]models.Resource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockServiceMockRecorder) GetAll(ctx, id, resourceType any) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetAll(ctx, id, resourceType any, selectors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, resourceType}, selectors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockService)(nil).GetAll), varargs...)
+}
+
+// GetCost mocks base method.
+func (m *MockService) GetCost(ctx *gofr.Context, id int64) (*models.CostSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCost", ctx, id)
+	ret0, _ := ret[0].(*models.CostSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCost indicates an expected call of GetCost.
+func (mr *MockServiceMockRecorder) GetCost(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockService)(nil).GetAll), ctx, id, resourceType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCost", reflect.TypeOf((*MockService)(nil).GetCost), ctx, id)
+}
+
+// GetHistory mocks base method.
+func (m *MockService) GetHistory(ctx *gofr.Context, cloudAccID, resourceID int64, from, to time.Time) ([]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, cloudAccID, resourceID, from, to)
+	ret0, _ := ret[0].([]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockServiceMockRecorder) GetHistory(ctx, cloudAccID, resourceID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockService)(nil).GetHistory), ctx, cloudAccID, resourceID, from, to)
+}
+
+// GetLocks mocks base method.
+func (m *MockService) GetLocks(ctx *gofr.Context, cloudAccID, resourceID, groupID int64) ([]models.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocks", ctx, cloudAccID, resourceID, groupID)
+	ret0, _ := ret[0].([]models.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocks indicates an expected call of GetLocks.
+func (mr *MockServiceMockRecorder) GetLocks(ctx, cloudAccID, resourceID, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocks", reflect.TypeOf((*MockService)(nil).GetLocks), ctx, cloudAccID, resourceID, groupID)
+}
+
+// GetOperations mocks base method.
+func (m *MockService) GetOperations(ctx *gofr.Context, cloudAccID, resourceID int64) ([]models.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperations", ctx, cloudAccID, resourceID)
+	ret0, _ := ret[0].([]models.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperations indicates an expected call of GetOperations.
+func (mr *MockServiceMockRecorder) GetOperations(ctx, cloudAccID, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperations", reflect.TypeOf((*MockService)(nil).GetOperations), ctx, cloudAccID, resourceID)
+}
+
+// GetSavings mocks base method.
+func (m *MockService) GetSavings(ctx *gofr.Context, id int64, month time.Time) (*models.SavingsSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavings", ctx, id, month)
+	ret0, _ := ret[0].(*models.SavingsSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavings indicates an expected call of GetSavings.
+func (mr *MockServiceMockRecorder) GetSavings(ctx, id, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavings", reflect.TypeOf((*MockService)(nil).GetSavings), ctx, id, month)
+}
+
+// GetSyncRuns mocks base method.
+func (m *MockService) GetSyncRuns(ctx *gofr.Context, id int64) ([]models.SyncRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSyncRuns", ctx, id)
+	ret0, _ := ret[0].([]models.SyncRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSyncRuns indicates an expected call of GetSyncRuns.
+func (mr *MockServiceMockRecorder) GetSyncRuns(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncRuns", reflect.TypeOf((*MockService)(nil).GetSyncRuns), ctx, id)
+}
+
+// IsIdle mocks base method.
+func (m *MockService) IsIdle(ctx *gofr.Context, cloudAccID, resourceID int64, window time.Duration) (*models.IdleCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsIdle", ctx, cloudAccID, resourceID, window)
+	ret0, _ := ret[0].(*models.IdleCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsIdle indicates an expected call of IsIdle.
+func (mr *MockServiceMockRecorder) IsIdle(ctx, cloudAccID, resourceID, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsIdle", reflect.TypeOf((*MockService)(nil).IsIdle), ctx, cloudAccID, resourceID, window)
+}
+
+// LockResource mocks base method.
+func (m *MockService) LockResource(ctx *gofr.Context, cloudAccID, resourceID int64, lock *models.Lock) (*models.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockResource", ctx, cloudAccID, resourceID, lock)
+	ret0, _ := ret[0].(*models.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockResource indicates an expected call of LockResource.
+func (mr *MockServiceMockRecorder) LockResource(ctx, cloudAccID, resourceID, lock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockResource", reflect.TypeOf((*MockService)(nil).LockResource), ctx, cloudAccID, resourceID, lock)
+}
+
+// ReleaseLock mocks base method.
+func (m *MockService) ReleaseLock(ctx *gofr.Context, cloudAccID, lockID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseLock", ctx, cloudAccID, lockID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseLock indicates an expected call of ReleaseLock.
+func (mr *MockServiceMockRecorder) ReleaseLock(ctx, cloudAccID, lockID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLock", reflect.TypeOf((*MockService)(nil).ReleaseLock), ctx, cloudAccID, lockID)
+}
+
+// Search mocks base method.
+func (m *MockService) Search(ctx *gofr.Context, q *models.ResourceQuery) (*models.ResourcePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, q)
+	ret0, _ := ret[0].(*models.ResourcePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockServiceMockRecorder) Search(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockService)(nil).Search), ctx, q)
 }
 
 // SyncResources mocks base method.
-func (m *MockService) SyncResources(ctx *gofr.Context, id int64) ([]models.Resource, error) {
+func (m *MockService) SyncResources(ctx *gofr.Context, id int64) (*models.SyncResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SyncResources", ctx, id)
-	ret0, _ := ret[0].([]models.Resource)
+	ret0, _ := ret[0].(*models.SyncResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -0,0 +1,201 @@
+package resourcegroup
+
+import (
+	"strconv"
+
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func (h *Handler) CreateCalendar(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var cal models.Calendar
+
+	err = ctx.Bind(&cal)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	res, err := h.svc.CreateCalendar(ctx, accID, &cal)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *Handler) GetCalendars(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.svc.GetCalendars(ctx, accID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *Handler) GetCalendar(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calID, err := getCalendarID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.svc.GetCalendar(ctx, accID, calID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *Handler) UpdateCalendar(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calID, err := getCalendarID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var cal models.Calendar
+
+	err = ctx.Bind(&cal)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	cal.ID = calID
+
+	res, err := h.svc.UpdateCalendar(ctx, accID, &cal)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *Handler) DeleteCalendar(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calID, err := getCalendarID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.svc.DeleteCalendar(ctx, accID, calID)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (h *Handler) GetGroupCalendars(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rgID, err := getResourceGroupID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.svc.GetGroupCalendars(ctx, accID, rgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *Handler) AttachCalendar(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rgID, err := getResourceGroupID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calID, err := getCalendarID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var gc models.GroupCalendar
+
+	err = ctx.Bind(&gc)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	gc.CalendarID = calID
+
+	res, err := h.svc.AttachCalendar(ctx, accID, rgID, &gc)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *Handler) DetachCalendar(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rgID, err := getResourceGroupID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	calID, err := getCalendarID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.svc.DetachCalendar(ctx, accID, rgID, calID)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func getCalendarID(ctx *gofr.Context) (int64, error) {
+	calIDStr := ctx.PathParam("calID")
+	if calIDStr == "" {
+		return 0, gofrHttp.ErrorMissingParam{Params: []string{"calID"}}
+	}
+
+	calID, err := strconv.ParseInt(calIDStr, 10, 64)
+	if err != nil {
+		return 0, gofrHttp.ErrorInvalidParam{Params: []string{"calID"}}
+	}
+
+	return calID, nil
+}
@@ -0,0 +1,383 @@
+package resourcegroup
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func TestHandler_CreateCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+	start := time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)
+	cal := &models.Calendar{Name: "Holidays",
+		Periods: []models.CalendarPeriod{{Name: "Christmas", Start: start, End: start.AddDate(0, 0, 1)}}}
+	sampleRes := &models.Calendar{ID: 4, Name: "Holidays", Timezone: "UTC", Periods: cal.Periods}
+
+	mSvc.EXPECT().CreateCalendar(ctx, int64(1), cal).Return(sampleRes, nil)
+	mSvc.EXPECT().CreateCalendar(ctx, int64(1), &models.Calendar{Name: "Empty"}).Return(nil, assert.AnError)
+
+	for body, exp := range map[string]struct {
+		res any
+		err error
+	}{
+		`{"name":"Holidays","periods":[{"name":"Christmas","start":"2025-12-25T00:00:00Z",` +
+			`"end":"2025-12-26T00:00:00Z"}]}`: {res: sampleRes},
+		`{"name":"Empty"}`: {err: assert.AnError},
+		`{`:                {err: gofrHttp.ErrorInvalidParam{Params: []string{"body"}}},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/cloud-account/{id}/calendars", bytes.NewBufferString(body))
+		req = mux.SetURLVars(req, map[string]string{"id": "1"})
+		req.Header.Set("Content-Type", "application/json")
+
+		ctx.Request = gofrHttp.NewRequest(req)
+
+		res, err := h.CreateCalendar(ctx)
+
+		assert.Equal(t, exp.err, err, body)
+		assert.Equal(t, exp.res, res, body)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/cloud-account/{id}/calendars", bytes.NewBufferString(`{}`))
+	req = mux.SetURLVars(req, map[string]string{"id": "invalid"})
+
+	ctx.Request = gofrHttp.NewRequest(req)
+
+	res, err := h.CreateCalendar(ctx)
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"id"}}, err)
+	assert.Nil(t, res)
+}
+
+func TestHandler_GetCalendars(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+	calendars := []models.Calendar{{ID: 4, Name: "Holidays", Timezone: "UTC"}}
+
+	mSvc.EXPECT().GetCalendars(ctx, int64(1)).Return(calendars, nil)
+	mSvc.EXPECT().GetCalendars(ctx, int64(2)).Return(nil, assert.AnError)
+
+	for accID, exp := range map[string]struct {
+		res any
+		err error
+	}{
+		"1":       {res: calendars},
+		"2":       {err: assert.AnError},
+		"invalid": {err: gofrHttp.ErrorInvalidParam{Params: []string{"id"}}},
+		"":        {err: gofrHttp.ErrorMissingParam{Params: []string{"id"}}},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/cloud-account/{id}/calendars", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": accID})
+
+		ctx.Request = gofrHttp.NewRequest(req)
+
+		res, err := h.GetCalendars(ctx)
+
+		assert.Equal(t, exp.err, err, accID)
+		assert.Equal(t, exp.res, res, accID)
+	}
+}
+
+func TestHandler_GetCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+	sampleRes := &models.Calendar{ID: 4, Name: "Holidays", Timezone: "UTC"}
+
+	mSvc.EXPECT().GetCalendar(ctx, int64(1), int64(4)).Return(sampleRes, nil)
+	mSvc.EXPECT().GetCalendar(ctx, int64(1), int64(5)).Return(nil, assert.AnError)
+
+	for calID, exp := range map[string]struct {
+		res any
+		err error
+	}{
+		"4":       {res: sampleRes},
+		"5":       {err: assert.AnError},
+		"invalid": {err: gofrHttp.ErrorInvalidParam{Params: []string{"calID"}}},
+		"":        {err: gofrHttp.ErrorMissingParam{Params: []string{"calID"}}},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/cloud-account/{id}/calendars/{calID}", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "1", "calID": calID})
+
+		ctx.Request = gofrHttp.NewRequest(req)
+
+		res, err := h.GetCalendar(ctx)
+
+		assert.Equal(t, exp.err, err, calID)
+		assert.Equal(t, exp.res, res, calID)
+	}
+}
+
+func TestHandler_UpdateCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+	body := `{"name":"Holidays","timezone":"Asia/Kolkata","ics":"BEGIN:VCALENDAR\nEND:VCALENDAR\n"}`
+	cal := &models.Calendar{ID: 4, Name: "Holidays", Timezone: "Asia/Kolkata",
+		ICS: "BEGIN:VCALENDAR\nEND:VCALENDAR\n"}
+	sampleRes := &models.Calendar{ID: 4, Name: "Holidays", Timezone: "Asia/Kolkata"}
+
+	testCases := []struct {
+		name      string
+		accID     string
+		calID     string
+		body      string
+		expErr    error
+		expRes    any
+		mockCalls []*gomock.Call
+	}{
+		{
+			name:   "success",
+			accID:  "1",
+			calID:  "4",
+			body:   body,
+			expRes: sampleRes,
+			mockCalls: []*gomock.Call{
+				mSvc.EXPECT().UpdateCalendar(ctx, int64(1), cal).Return(sampleRes, nil),
+			},
+		},
+		{
+			name:   "invalid cloud account ID",
+			accID:  "invalid",
+			calID:  "4",
+			expErr: gofrHttp.ErrorInvalidParam{Params: []string{"id"}},
+		},
+		{
+			name:   "invalid calendar ID",
+			accID:  "1",
+			calID:  "invalid",
+			expErr: gofrHttp.ErrorInvalidParam{Params: []string{"calID"}},
+		},
+		{
+			name:   "invalid bind",
+			accID:  "1",
+			calID:  "4",
+			body:   `{`,
+			expErr: gofrHttp.ErrorInvalidParam{Params: []string{"body"}},
+		},
+		{
+			name:   "service error",
+			accID:  "1",
+			calID:  "4",
+			body:   body,
+			expErr: assert.AnError,
+			mockCalls: []*gomock.Call{
+				mSvc.EXPECT().UpdateCalendar(ctx, int64(1), cal).Return(nil, assert.AnError),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/cloud-account/{id}/calendars/{calID}",
+				bytes.NewBufferString(tc.body))
+			req = mux.SetURLVars(req, map[string]string{"id": tc.accID, "calID": tc.calID})
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			res, err := h.UpdateCalendar(ctx)
+
+			assert.Equal(t, tc.expErr, err)
+			assert.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestHandler_DeleteCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+
+	mSvc.EXPECT().DeleteCalendar(ctx, int64(1), int64(4)).Return(nil)
+	mSvc.EXPECT().DeleteCalendar(ctx, int64(1), int64(5)).Return(assert.AnError)
+
+	for calID, expErr := range map[string]error{
+		"4":       nil,
+		"5":       assert.AnError,
+		"invalid": gofrHttp.ErrorInvalidParam{Params: []string{"calID"}},
+	} {
+		req := httptest.NewRequest(http.MethodDelete, "/cloud-account/{id}/calendars/{calID}", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "1", "calID": calID})
+
+		ctx.Request = gofrHttp.NewRequest(req)
+
+		res, err := h.DeleteCalendar(ctx)
+
+		assert.Equal(t, expErr, err, calID)
+		assert.Nil(t, res)
+	}
+}
+
+func TestHandler_GetGroupCalendars(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+	sampleRes := []models.GroupCalendar{{GroupID: 2, CloudAccountID: 1, CalendarID: 4, Kind: models.CalendarOff}}
+
+	mSvc.EXPECT().GetGroupCalendars(ctx, int64(1), int64(2)).Return(sampleRes, nil)
+	mSvc.EXPECT().GetGroupCalendars(ctx, int64(1), int64(3)).Return(nil, assert.AnError)
+
+	for groupID, exp := range map[string]struct {
+		res any
+		err error
+	}{
+		"2":       {res: sampleRes},
+		"3":       {err: assert.AnError},
+		"invalid": {err: gofrHttp.ErrorInvalidParam{Params: []string{"rgId"}}},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/cloud-account/{id}/resource-groups/{rgID}/calendars",
+			http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "1", "rgID": groupID})
+
+		ctx.Request = gofrHttp.NewRequest(req)
+
+		res, err := h.GetGroupCalendars(ctx)
+
+		assert.Equal(t, exp.err, err, groupID)
+		assert.Equal(t, exp.res, res, groupID)
+	}
+}
+
+func TestHandler_AttachCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+	gc := &models.GroupCalendar{CalendarID: 4, Kind: models.CalendarBlackout}
+	sampleRes := []models.GroupCalendar{{GroupID: 2, CloudAccountID: 1, CalendarID: 4,
+		Kind: models.CalendarBlackout}}
+
+	testCases := []struct {
+		name      string
+		accID     string
+		groupID   string
+		calID     string
+		body      string
+		expErr    error
+		expRes    any
+		mockCalls []*gomock.Call
+	}{
+		{
+			name:    "success",
+			accID:   "1",
+			groupID: "2",
+			calID:   "4",
+			body:    `{"kind":"BLACKOUT"}`,
+			expRes:  sampleRes,
+			mockCalls: []*gomock.Call{
+				mSvc.EXPECT().AttachCalendar(ctx, int64(1), int64(2), gc).Return(sampleRes, nil),
+			},
+		},
+		{
+			name:   "invalid cloud account ID",
+			accID:  "invalid",
+			expErr: gofrHttp.ErrorInvalidParam{Params: []string{"id"}},
+		},
+		{
+			name:    "missing calendar ID",
+			accID:   "1",
+			groupID: "2",
+			expErr:  gofrHttp.ErrorMissingParam{Params: []string{"calID"}},
+		},
+		{
+			name:    "invalid bind",
+			accID:   "1",
+			groupID: "2",
+			calID:   "4",
+			body:    `{`,
+			expErr:  gofrHttp.ErrorInvalidParam{Params: []string{"body"}},
+		},
+		{
+			name:    "service error",
+			accID:   "1",
+			groupID: "2",
+			calID:   "4",
+			body:    `{"kind":"BLACKOUT"}`,
+			expErr:  assert.AnError,
+			mockCalls: []*gomock.Call{
+				mSvc.EXPECT().AttachCalendar(ctx, int64(1), int64(2), gc).Return(nil, assert.AnError),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut,
+				"/cloud-account/{id}/resource-groups/{rgID}/calendars/{calID}", bytes.NewBufferString(tc.body))
+			req = mux.SetURLVars(req, map[string]string{"id": tc.accID, "rgID": tc.groupID, "calID": tc.calID})
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			res, err := h.AttachCalendar(ctx)
+
+			assert.Equal(t, tc.expErr, err)
+			assert.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestHandler_DetachCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+
+	mSvc.EXPECT().DetachCalendar(ctx, int64(1), int64(2), int64(4)).Return(nil)
+	mSvc.EXPECT().DetachCalendar(ctx, int64(1), int64(2), int64(5)).Return(assert.AnError)
+
+	for calID, expErr := range map[string]error{
+		"4":       nil,
+		"5":       assert.AnError,
+		"invalid": gofrHttp.ErrorInvalidParam{Params: []string{"calID"}},
+	} {
+		req := httptest.NewRequest(http.MethodDelete,
+			"/cloud-account/{id}/resource-groups/{rgID}/calendars/{calID}", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "1", "rgID": "2", "calID": calID})
+
+		ctx.Request = gofrHttp.NewRequest(req)
+
+		res, err := h.DetachCalendar(ctx)
+
+		assert.Equal(t, expErr, err, calID)
+		assert.Nil(t, res)
+	}
+}
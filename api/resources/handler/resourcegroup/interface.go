@@ -12,4 +12,20 @@ type Service interface {
 	CreateResourceGroup(ctx *gofr.Context, rg *models.RGCreate) (*models.ResourceGroupData, error)
 	UpdateResourceGroup(ctx *gofr.Context, rg *models.RGUpdate) (*models.ResourceGroupData, error)
 	DeleteResourceGroup(ctx *gofr.Context, cloudAccID, id int64) error
+	LockGroup(ctx *gofr.Context, cloudAccID, groupID int64, lock *models.Lock) (*models.Lock, error)
+	ChangeGroupState(ctx *gofr.Context, cloudAccID, groupID int64, req *models.RGStateChange) (*models.RGStateResult, error)
+	GetGroupState(ctx *gofr.Context, cloudAccID, groupID int64) (*models.RGStateResult, error)
+
+	GetSchedule(ctx *gofr.Context, cloudAccID, groupID int64) (*models.Schedule, error)
+	SetSchedule(ctx *gofr.Context, cloudAccID, groupID int64, sch *models.Schedule) (*models.Schedule, error)
+	DeleteSchedule(ctx *gofr.Context, cloudAccID, groupID int64) error
+
+	CreateCalendar(ctx *gofr.Context, cloudAccID int64, cal *models.Calendar) (*models.Calendar, error)
+	GetCalendars(ctx *gofr.Context, cloudAccID int64) ([]models.Calendar, error)
+	GetCalendar(ctx *gofr.Context, cloudAccID, id int64) (*models.Calendar, error)
+	UpdateCalendar(ctx *gofr.Context, cloudAccID int64, cal *models.Calendar) (*models.Calendar, error)
+	DeleteCalendar(ctx *gofr.Context, cloudAccID, id int64) error
+	GetGroupCalendars(ctx *gofr.Context, cloudAccID, groupID int64) ([]models.GroupCalendar, error)
+	AttachCalendar(ctx *gofr.Context, cloudAccID, groupID int64, gc *models.GroupCalendar) ([]models.GroupCalendar, error)
+	DetachCalendar(ctx *gofr.Context, cloudAccID, groupID, calendarID int64) error
 }
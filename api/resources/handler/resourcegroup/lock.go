@@ -0,0 +1,34 @@
+package resourcegroup
+
+import (
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func (h *Handler) CreateLock(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rgID, err := getResourceGroupID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock models.Lock
+
+	err = ctx.Bind(&lock)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	res, err := h.svc.LockGroup(ctx, accID, rgID, &lock)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
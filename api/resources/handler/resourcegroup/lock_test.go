@@ -0,0 +1,96 @@
+package resourcegroup
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func TestHandler_CreateLock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+	body := `{"duration":"2h","author":"jane","reason":"release testing"}`
+	lock := &models.Lock{Duration: "2h", Author: "jane", Reason: "release testing"}
+	sampleRes := &models.Lock{ID: 5, CloudAccountID: 1, GroupID: 2, Until: time.Now().Add(2 * time.Hour),
+		Author: "jane", Reason: "release testing"}
+
+	testCases := []struct {
+		name      string
+		accID     string
+		groupID   string
+		body      string
+		expErr    error
+		expRes    any
+		mockCalls []*gomock.Call
+	}{
+		{
+			name:    "success",
+			accID:   "1",
+			groupID: "2",
+			body:    body,
+			expRes:  sampleRes,
+			mockCalls: []*gomock.Call{
+				mSvc.EXPECT().LockGroup(ctx, int64(1), int64(2), lock).Return(sampleRes, nil),
+			},
+		},
+		{
+			name:   "invalid cloud account ID",
+			accID:  "invalid",
+			expErr: gofrHttp.ErrorInvalidParam{Params: []string{"id"}},
+		},
+		{
+			name:   "missing resource group ID",
+			accID:  "1",
+			expErr: gofrHttp.ErrorMissingParam{Params: []string{"rgId"}},
+		},
+		{
+			name:    "invalid bind",
+			accID:   "1",
+			groupID: "2",
+			body:    `{`,
+			expErr:  gofrHttp.ErrorInvalidParam{Params: []string{"body"}},
+		},
+		{
+			name:    "service error",
+			accID:   "1",
+			groupID: "2",
+			body:    body,
+			expErr:  assert.AnError,
+			mockCalls: []*gomock.Call{
+				mSvc.EXPECT().LockGroup(ctx, int64(1), int64(2), lock).Return(nil, assert.AnError),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost,
+				"/cloud-account/{id}/resource-groups/{rgID}/locks", bytes.NewBufferString(tc.body))
+			req = mux.SetURLVars(req, map[string]string{"id": tc.accID, "rgID": tc.groupID})
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			res, err := h.CreateLock(ctx)
+
+			assert.Equal(t, tc.expErr, err)
+			assert.Equal(t, tc.expRes, res)
+		})
+	}
+}
@@ -41,6 +41,51 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AttachCalendar mocks base method.
+func (m *MockService) AttachCalendar(ctx *gofr.Context, cloudAccID, groupID int64, gc *models.GroupCalendar) ([]models.GroupCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachCalendar", ctx, cloudAccID, groupID, gc)
+	ret0, _ := ret[0].([]models.GroupCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachCalendar indicates an expected call of AttachCalendar.
+func (mr *MockServiceMockRecorder) AttachCalendar(ctx, cloudAccID, groupID, gc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachCalendar", reflect.TypeOf((*MockService)(nil).AttachCalendar), ctx, cloudAccID, groupID, gc)
+}
+
+// ChangeGroupState mocks base method.
+func (m *MockService) ChangeGroupState(ctx *gofr.Context, cloudAccID, groupID int64, req *models.RGStateChange) (*models.RGStateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeGroupState", ctx, cloudAccID, groupID, req)
+	ret0, _ := ret[0].(*models.RGStateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeGroupState indicates an expected call of ChangeGroupState.
+func (mr *MockServiceMockRecorder) ChangeGroupState(ctx, cloudAccID, groupID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeGroupState", reflect.TypeOf((*MockService)(nil).ChangeGroupState), ctx, cloudAccID, groupID, req)
+}
+
+// CreateCalendar mocks base method.
+func (m *MockService) CreateCalendar(ctx *gofr.Context, cloudAccID int64, cal *models.Calendar) (*models.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCalendar", ctx, cloudAccID, cal)
+	ret0, _ := ret[0].(*models.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCalendar indicates an expected call of CreateCalendar.
+func (mr *MockServiceMockRecorder) CreateCalendar(ctx, cloudAccID, cal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendar", reflect.TypeOf((*MockService)(nil).CreateCalendar), ctx, cloudAccID, cal)
+}
+
 // CreateResourceGroup mocks base method.
 func (m *MockService) CreateResourceGroup(ctx *gofr.Context, rg *models.RGCreate) (*models.ResourceGroupData, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +101,20 @@ func (mr *MockServiceMockRecorder) CreateResourceGroup(ctx, rg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceGroup", reflect.TypeOf((*MockService)(nil).CreateResourceGroup), ctx, rg)
 }
 
+// DeleteCalendar mocks base method.
+func (m *MockService) DeleteCalendar(ctx *gofr.Context, cloudAccID, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCalendar", ctx, cloudAccID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCalendar indicates an expected call of DeleteCalendar.
+func (mr *MockServiceMockRecorder) DeleteCalendar(ctx, cloudAccID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCalendar", reflect.TypeOf((*MockService)(nil).DeleteCalendar), ctx, cloudAccID, id)
+}
+
 // DeleteResourceGroup mocks base method.
 func (m *MockService) DeleteResourceGroup(ctx *gofr.Context, cloudAccID, id int64) error {
 	m.ctrl.T.Helper()
@@ -70,6 +129,34 @@ func (mr *MockServiceMockRecorder) DeleteResourceGroup(ctx, cloudAccID, id any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceGroup", reflect.TypeOf((*MockService)(nil).DeleteResourceGroup), ctx, cloudAccID, id)
 }
 
+// DeleteSchedule mocks base method.
+func (m *MockService) DeleteSchedule(ctx *gofr.Context, cloudAccID, groupID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", ctx, cloudAccID, groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockServiceMockRecorder) DeleteSchedule(ctx, cloudAccID, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockService)(nil).DeleteSchedule), ctx, cloudAccID, groupID)
+}
+
+// DetachCalendar mocks base method.
+func (m *MockService) DetachCalendar(ctx *gofr.Context, cloudAccID, groupID, calendarID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachCalendar", ctx, cloudAccID, groupID, calendarID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachCalendar indicates an expected call of DetachCalendar.
+func (mr *MockServiceMockRecorder) DetachCalendar(ctx, cloudAccID, groupID, calendarID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachCalendar", reflect.TypeOf((*MockService)(nil).DetachCalendar), ctx, cloudAccID, groupID, calendarID)
+}
+
 // GetAllResourceGroups mocks base method.
 func (m *MockService) GetAllResourceGroups(ctx *gofr.Context, cloudAccID int64) ([]models.ResourceGroupData, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +172,66 @@ func (mr *MockServiceMockRecorder) GetAllResourceGroups(ctx, cloudAccID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllResourceGroups", reflect.TypeOf((*MockService)(nil).GetAllResourceGroups), ctx, cloudAccID)
 }
 
+// GetCalendar mocks base method.
+func (m *MockService) GetCalendar(ctx *gofr.Context, cloudAccID, id int64) (*models.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendar", ctx, cloudAccID, id)
+	ret0, _ := ret[0].(*models.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendar indicates an expected call of GetCalendar.
+func (mr *MockServiceMockRecorder) GetCalendar(ctx, cloudAccID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockService)(nil).GetCalendar), ctx, cloudAccID, id)
+}
+
+// GetCalendars mocks base method.
+func (m *MockService) GetCalendars(ctx *gofr.Context, cloudAccID int64) ([]models.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendars", ctx, cloudAccID)
+	ret0, _ := ret[0].([]models.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendars indicates an expected call of GetCalendars.
+func (mr *MockServiceMockRecorder) GetCalendars(ctx, cloudAccID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendars", reflect.TypeOf((*MockService)(nil).GetCalendars), ctx, cloudAccID)
+}
+
+// GetGroupCalendars mocks base method.
+func (m *MockService) GetGroupCalendars(ctx *gofr.Context, cloudAccID, groupID int64) ([]models.GroupCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupCalendars", ctx, cloudAccID, groupID)
+	ret0, _ := ret[0].([]models.GroupCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupCalendars indicates an expected call of GetGroupCalendars.
+func (mr *MockServiceMockRecorder) GetGroupCalendars(ctx, cloudAccID, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupCalendars", reflect.TypeOf((*MockService)(nil).GetGroupCalendars), ctx, cloudAccID, groupID)
+}
+
+// GetGroupState mocks base method.
+func (m *MockService) GetGroupState(ctx *gofr.Context, cloudAccID, groupID int64) (*models.RGStateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupState", ctx, cloudAccID, groupID)
+	ret0, _ := ret[0].(*models.RGStateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupState indicates an expected call of GetGroupState.
+func (mr *MockServiceMockRecorder) GetGroupState(ctx, cloudAccID, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupState", reflect.TypeOf((*MockService)(nil).GetGroupState), ctx, cloudAccID, groupID)
+}
+
 // GetResourceGroupByID mocks base method.
 func (m *MockService) GetResourceGroupByID(ctx *gofr.Context, cloudAccID, id int64) (*models.ResourceGroupData, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +247,66 @@ func (mr *MockServiceMockRecorder) GetResourceGroupByID(ctx, cloudAccID, id any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceGroupByID", reflect.TypeOf((*MockService)(nil).GetResourceGroupByID), ctx, cloudAccID, id)
 }
 
+// GetSchedule mocks base method.
+func (m *MockService) GetSchedule(ctx *gofr.Context, cloudAccID, groupID int64) (*models.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedule", ctx, cloudAccID, groupID)
+	ret0, _ := ret[0].(*models.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedule indicates an expected call of GetSchedule.
+func (mr *MockServiceMockRecorder) GetSchedule(ctx, cloudAccID, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedule", reflect.TypeOf((*MockService)(nil).GetSchedule), ctx, cloudAccID, groupID)
+}
+
+// LockGroup mocks base method.
+func (m *MockService) LockGroup(ctx *gofr.Context, cloudAccID, groupID int64, lock *models.Lock) (*models.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockGroup", ctx, cloudAccID, groupID, lock)
+	ret0, _ := ret[0].(*models.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockGroup indicates an expected call of LockGroup.
+func (mr *MockServiceMockRecorder) LockGroup(ctx, cloudAccID, groupID, lock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockGroup", reflect.TypeOf((*MockService)(nil).LockGroup), ctx, cloudAccID, groupID, lock)
+}
+
+// SetSchedule mocks base method.
+func (m *MockService) SetSchedule(ctx *gofr.Context, cloudAccID, groupID int64, sch *models.Schedule) (*models.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSchedule", ctx, cloudAccID, groupID, sch)
+	ret0, _ := ret[0].(*models.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSchedule indicates an expected call of SetSchedule.
+func (mr *MockServiceMockRecorder) SetSchedule(ctx, cloudAccID, groupID, sch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSchedule", reflect.TypeOf((*MockService)(nil).SetSchedule), ctx, cloudAccID, groupID, sch)
+}
+
+// UpdateCalendar mocks base method.
+func (m *MockService) UpdateCalendar(ctx *gofr.Context, cloudAccID int64, cal *models.Calendar) (*models.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCalendar", ctx, cloudAccID, cal)
+	ret0, _ := ret[0].(*models.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCalendar indicates an expected call of UpdateCalendar.
+func (mr *MockServiceMockRecorder) UpdateCalendar(ctx, cloudAccID, cal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCalendar", reflect.TypeOf((*MockService)(nil).UpdateCalendar), ctx, cloudAccID, cal)
+}
+
 // UpdateResourceGroup mocks base method.
 func (m *MockService) UpdateResourceGroup(ctx *gofr.Context, rg *models.RGUpdate) (*models.ResourceGroupData, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,72 @@
+package resourcegroup
+
+import (
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func (h *Handler) GetSchedule(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rgID, err := getResourceGroupID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.svc.GetSchedule(ctx, accID, rgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *Handler) SetSchedule(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rgID, err := getResourceGroupID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sch models.Schedule
+
+	err = ctx.Bind(&sch)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	res, err := h.svc.SetSchedule(ctx, accID, rgID, &sch)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *Handler) DeleteSchedule(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rgID, err := getResourceGroupID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.svc.DeleteSchedule(ctx, accID, rgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
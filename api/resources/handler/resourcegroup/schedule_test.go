@@ -0,0 +1,156 @@
+package resourcegroup
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func TestHandler_SetSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+	body := `{"timezone":"Asia/Kolkata","windows":[{"days":["MON","TUE"],"start":"09:00","stop":"19:00"}]}`
+	sch := &models.Schedule{Timezone: "Asia/Kolkata",
+		Windows: models.ScheduleWindows{{Days: []string{"MON", "TUE"}, Start: "09:00", Stop: "19:00"}}}
+	sampleRes := &models.Schedule{GroupID: 2, CloudAccountID: 1, Timezone: "Asia/Kolkata", Windows: sch.Windows}
+
+	testCases := []struct {
+		name      string
+		accID     string
+		groupID   string
+		body      string
+		expErr    error
+		expRes    any
+		mockCalls []*gomock.Call
+	}{
+		{
+			name:    "success",
+			accID:   "1",
+			groupID: "2",
+			body:    body,
+			expRes:  sampleRes,
+			mockCalls: []*gomock.Call{
+				mSvc.EXPECT().SetSchedule(ctx, int64(1), int64(2), sch).Return(sampleRes, nil),
+			},
+		},
+		{
+			name:   "invalid cloud account ID",
+			accID:  "invalid",
+			expErr: gofrHttp.ErrorInvalidParam{Params: []string{"id"}},
+		},
+		{
+			name:   "missing resource group ID",
+			accID:  "1",
+			expErr: gofrHttp.ErrorMissingParam{Params: []string{"rgId"}},
+		},
+		{
+			name:    "invalid bind",
+			accID:   "1",
+			groupID: "2",
+			body:    `{`,
+			expErr:  gofrHttp.ErrorInvalidParam{Params: []string{"body"}},
+		},
+		{
+			name:    "service error",
+			accID:   "1",
+			groupID: "2",
+			body:    body,
+			expErr:  assert.AnError,
+			mockCalls: []*gomock.Call{
+				mSvc.EXPECT().SetSchedule(ctx, int64(1), int64(2), sch).Return(nil, assert.AnError),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut,
+				"/cloud-account/{id}/resource-groups/{rgID}/schedule", bytes.NewBufferString(tc.body))
+			req = mux.SetURLVars(req, map[string]string{"id": tc.accID, "rgID": tc.groupID})
+
+			req.Header.Set("Content-Type", "application/json")
+
+			ctx.Request = gofrHttp.NewRequest(req)
+
+			res, err := h.SetSchedule(ctx)
+
+			assert.Equal(t, tc.expErr, err)
+			assert.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestHandler_GetSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+	sampleRes := &models.Schedule{GroupID: 2, CloudAccountID: 1, Timezone: "UTC"}
+
+	mSvc.EXPECT().GetSchedule(ctx, int64(1), int64(2)).Return(sampleRes, nil)
+	mSvc.EXPECT().GetSchedule(ctx, int64(1), int64(3)).Return(nil, assert.AnError)
+
+	for groupID, exp := range map[string]struct {
+		res any
+		err error
+	}{
+		"2":       {res: sampleRes},
+		"3":       {err: assert.AnError},
+		"invalid": {err: gofrHttp.ErrorInvalidParam{Params: []string{"rgId"}}},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/cloud-account/{id}/resource-groups/{rgID}/schedule", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "1", "rgID": groupID})
+
+		ctx.Request = gofrHttp.NewRequest(req)
+
+		res, err := h.GetSchedule(ctx)
+
+		assert.Equal(t, exp.err, err, groupID)
+		assert.Equal(t, exp.res, res, groupID)
+	}
+}
+
+func TestHandler_DeleteSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+
+	mSvc.EXPECT().DeleteSchedule(ctx, int64(1), int64(2)).Return(nil)
+	mSvc.EXPECT().DeleteSchedule(ctx, int64(1), int64(3)).Return(assert.AnError)
+
+	for groupID, expErr := range map[string]error{
+		"2":       nil,
+		"3":       assert.AnError,
+		"invalid": gofrHttp.ErrorInvalidParam{Params: []string{"rgId"}},
+	} {
+		req := httptest.NewRequest(http.MethodDelete, "/cloud-account/{id}/resource-groups/{rgID}/schedule",
+			http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": "1", "rgID": groupID})
+
+		ctx.Request = gofrHttp.NewRequest(req)
+
+		res, err := h.DeleteSchedule(ctx)
+
+		assert.Equal(t, expErr, err, groupID)
+		assert.Nil(t, res)
+	}
+}
@@ -0,0 +1,53 @@
+package resourcegroup
+
+import (
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func (h *Handler) ChangeGroupState(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rgID, err := getResourceGroupID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var req models.RGStateChange
+
+	err = ctx.Bind(&req)
+	if err != nil {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"body"}}
+	}
+
+	res, err := h.svc.ChangeGroupState(ctx, accID, rgID, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *Handler) GetGroupState(ctx *gofr.Context) (any, error) {
+	accID, err := getCloudAccountID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rgID, err := getResourceGroupID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := h.svc.GetGroupState(ctx, accID, rgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
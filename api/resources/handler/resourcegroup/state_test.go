@@ -0,0 +1,159 @@
+package resourcegroup
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func TestHandler_ChangeGroupState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+	body := `{"state":"SUSPEND","requested_by":"alice"}`
+	req := &models.RGStateChange{State: "SUSPEND", RequestedBy: "alice"}
+	sampleRes := &models.RGStateResult{GroupID: 2, State: "SUSPEND", Status: "TRANSITIONING",
+		Members: []models.MemberOutcome{{ResourceID: 10, Name: "db", Status: "STOPPING", Succeeded: true}}}
+
+	testCases := []struct {
+		name      string
+		accID     string
+		groupID   string
+		body      string
+		expErr    error
+		expRes    any
+		mockCalls []*gomock.Call
+	}{
+		{
+			name:    "success",
+			accID:   "1",
+			groupID: "2",
+			body:    body,
+			expRes:  sampleRes,
+			mockCalls: []*gomock.Call{
+				mSvc.EXPECT().ChangeGroupState(ctx, int64(1), int64(2), req).Return(sampleRes, nil),
+			},
+		},
+		{
+			name:   "missing cloud account ID",
+			expErr: gofrHttp.ErrorMissingParam{Params: []string{"id"}},
+		},
+		{
+			name:    "invalid resource group ID",
+			accID:   "1",
+			groupID: "invalid",
+			expErr:  gofrHttp.ErrorInvalidParam{Params: []string{"rgId"}},
+		},
+		{
+			name:    "invalid bind",
+			accID:   "1",
+			groupID: "2",
+			body:    `{`,
+			expErr:  gofrHttp.ErrorInvalidParam{Params: []string{"body"}},
+		},
+		{
+			name:    "service error",
+			accID:   "1",
+			groupID: "2",
+			body:    body,
+			expErr:  assert.AnError,
+			mockCalls: []*gomock.Call{
+				mSvc.EXPECT().ChangeGroupState(ctx, int64(1), int64(2), req).Return(nil, assert.AnError),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost,
+				"/cloud-account/{id}/resource-groups/{rgID}/state", bytes.NewBufferString(tc.body))
+			r = mux.SetURLVars(r, map[string]string{"id": tc.accID, "rgID": tc.groupID})
+
+			r.Header.Set("Content-Type", "application/json")
+
+			ctx.Request = gofrHttp.NewRequest(r)
+
+			res, err := h.ChangeGroupState(ctx)
+
+			assert.Equal(t, tc.expErr, err)
+			assert.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestHandler_GetGroupState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mSvc := NewMockService(ctrl)
+	h := New(mSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+	sampleRes := &models.RGStateResult{GroupID: 2, State: "SUSPEND", Status: "TRANSITIONING", ChangeID: 7,
+		ChangeStatus: "IN_PROGRESS", Members: []models.MemberOutcome{
+			{ResourceID: 10, Name: "db", Status: "STOPPING", Succeeded: true}, {ResourceID: 11, Pending: true}}}
+
+	testCases := []struct {
+		name      string
+		accID     string
+		groupID   string
+		expErr    error
+		expRes    any
+		mockCalls []*gomock.Call
+	}{
+		{
+			name:    "success",
+			accID:   "1",
+			groupID: "2",
+			expRes:  sampleRes,
+			mockCalls: []*gomock.Call{
+				mSvc.EXPECT().GetGroupState(ctx, int64(1), int64(2)).Return(sampleRes, nil),
+			},
+		},
+		{
+			name:   "missing cloud account ID",
+			expErr: gofrHttp.ErrorMissingParam{Params: []string{"id"}},
+		},
+		{
+			name:    "invalid resource group ID",
+			accID:   "1",
+			groupID: "invalid",
+			expErr:  gofrHttp.ErrorInvalidParam{Params: []string{"rgId"}},
+		},
+		{
+			name:    "service error",
+			accID:   "1",
+			groupID: "2",
+			expErr:  assert.AnError,
+			mockCalls: []*gomock.Call{
+				mSvc.EXPECT().GetGroupState(ctx, int64(1), int64(2)).Return(nil, assert.AnError),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/cloud-account/{id}/resource-groups/{rgID}/state", http.NoBody)
+			r = mux.SetURLVars(r, map[string]string{"id": tc.accID, "rgID": tc.groupID})
+
+			ctx.Request = gofrHttp.NewRequest(r)
+
+			res, err := h.GetGroupState(ctx)
+
+			assert.Equal(t, tc.expErr, err)
+			assert.Equal(t, tc.expRes, res)
+		})
+	}
+}
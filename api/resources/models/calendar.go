@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// Kinds of calendars attached to a resource group.
+const (
+	// CalendarOff suspends the members of the group during the periods of the calendar, e.g. public holidays.
+	CalendarOff = "OFF"
+	// CalendarBlackout keeps the members of the group from being suspended during the periods of the calendar, e.g.
+	// a quarter-end close.
+	CalendarBlackout = "BLACKOUT"
+)
+
+// Calendar is a named list of date ranges of a cloud account. The periods are either listed or imported from an
+// iCalendar (.ics) file, the dates of the file without a time zone are read in the IANA time zone of the calendar.
+type Calendar struct {
+	ID             int64            `json:"id"`
+	CloudAccountID int64            `json:"cloud_account_id"`
+	Name           string           `json:"name"`
+	Timezone       string           `json:"timezone"`
+	Periods        []CalendarPeriod `json:"periods,omitempty"`
+	ICS            string           `json:"ics,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+// CalendarPeriod is a date range of a calendar, it begins at its start and ends just before its end.
+type CalendarPeriod struct {
+	Name  string    `json:"name,omitempty"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// GroupCalendar is a calendar attached to a resource group as an off or a blackout calendar. Active tells whether a
+// period of the calendar is in progress.
+type GroupCalendar struct {
+	GroupID        int64  `json:"group_id"`
+	CloudAccountID int64  `json:"cloud_account_id"`
+	CalendarID     int64  `json:"calendar_id"`
+	CalendarName   string `json:"calendar_name"`
+	Kind           string `json:"kind"`
+	Active         bool   `json:"active"`
+	LastState      string `json:"last_state,omitempty"`
+}
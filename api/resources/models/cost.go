@@ -0,0 +1,31 @@
+package models
+
+// Cost is the estimated cost of a resource, priced from the pricing catalog of its cloud provider.
+// Hourly and Monthly are the cost while the resource is running, StoppedMonthly is the cost that remains
+// while it is stopped, e.g. the storage of a stopped database.
+type Cost struct {
+	Hourly         float64 `json:"hourly"`
+	Monthly        float64 `json:"monthly"`
+	StoppedMonthly float64 `json:"stopped_monthly"`
+	Currency       string  `json:"currency"`
+	CatalogVersion string  `json:"catalog_version"`
+}
+
+// CostBreakdown is the estimated cost of a set of resources in their current state. Savings is the monthly
+// cost that would be saved by stopping the running resources of the set.
+type CostBreakdown struct {
+	Count   int     `json:"count"`
+	Hourly  float64 `json:"hourly"`
+	Monthly float64 `json:"monthly"`
+	Savings float64 `json:"savings"`
+}
+
+// CostSummary aggregates the estimated cost of the resources of a cloud account. Unpriced is the number of
+// resources for which the pricing catalog has no price, these are counted but do not add to the cost.
+type CostSummary struct {
+	Total           CostBreakdown            `json:"total"`
+	ByType          map[string]CostBreakdown `json:"by_type"`
+	ByRegion        map[string]CostBreakdown `json:"by_region"`
+	ByResourceGroup map[string]CostBreakdown `json:"by_resource_group"`
+	Unpriced        int                      `json:"unpriced"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// DeleteConfirmation is the token issued to confirm the deletion of a resource. The token is only valid for the
+// resource it was issued for and until it expires.
+type DeleteConfirmation struct {
+	ResourceID int64     `json:"resource_id"`
+	Token      string    `json:"token"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// DeleteRequest confirms the deletion of a resource with the token of its delete confirmation.
+type DeleteRequest struct {
+	Token       string `json:"token"`
+	RequestedBy string `json:"requestedBy,omitempty"`
+}
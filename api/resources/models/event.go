@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Event is an entry of the append-only change history of a resource.
+type Event struct {
+	ID             int64     `json:"id"`
+	ResourceID     int64     `json:"resource_id"`
+	CloudAccountID int64     `json:"cloud_account_id"`
+	Type           string    `json:"type"`
+	FromStatus     string    `json:"from_status,omitempty"`
+	ToStatus       string    `json:"to_status,omitempty"`
+	Actor          string    `json:"actor,omitempty"`
+	Message        string    `json:"message,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
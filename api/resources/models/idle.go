@@ -0,0 +1,8 @@
+package models
+
+// IdleCheck is the outcome of checking whether a resource served no client over a window of time.
+type IdleCheck struct {
+	ResourceID int64  `json:"resource_id"`
+	Window     string `json:"window"`
+	Idle       bool   `json:"idle"`
+}
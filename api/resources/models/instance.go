@@ -16,6 +16,9 @@ type Resource struct {
 	Status       string       `json:"status"`
 	UID          string       `json:"uid"`
 	Settings     Settings     `json:"settings"`
+	Labels       Labels       `json:"labels,omitempty"`
+	Spec         Spec         `json:"spec"`
+	Cost         *Cost        `json:"cost,omitempty"`
 	CreatedAt    time.Time    `json:"created_at"`
 	UpdatedAt    time.Time    `json:"updated_at"`
 }
@@ -39,6 +42,16 @@ func (s *Settings) Scan(value any) error {
 	return json.Unmarshal(data, &s)
 }
 
+// Spec is the normalized size of a resource across cloud providers, used to compare and cost resources.
+// A zero value means that the attribute is not known for the resource.
+type Spec struct {
+	VCPU             float64 `json:"vcpu"`
+	MemoryGB         float64 `json:"memory_gb"`
+	StorageGB        int64   `json:"storage_gb"`
+	MachineClass     string  `json:"machine_class"`
+	HighAvailability bool    `json:"high_availability"`
+}
+
 type CloudAccount struct {
 	ID   int64  `json:"id"`
 	Type string `json:"type"`
@@ -0,0 +1,18 @@
+package models
+
+// Labels are the key value pairs attached to a resource on the cloud, i.e. GCP labels, AWS tags and OCI freeform tags.
+type Labels map[string]string
+
+// Label selector operators.
+const (
+	LabelEquals    = "EQUALS"
+	LabelNotEquals = "NOT_EQUALS"
+	LabelExists    = "EXISTS"
+)
+
+// LabelSelector filters resources by a label. Resources without the label match a NOT_EQUALS selector.
+type LabelSelector struct {
+	Key      string
+	Value    string
+	Operator string
+}
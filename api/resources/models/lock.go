@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Lock is a time-boxed keep-awake lock on a resource or on a resource group, it keeps the resource, or every member of
+// the group, from being suspended until it expires. A lock is created either until a time or for a duration, e.g. "4h".
+type Lock struct {
+	ID             int64     `json:"id"`
+	CloudAccountID int64     `json:"cloud_account_id"`
+	ResourceID     int64     `json:"resource_id,omitempty"`
+	GroupID        int64     `json:"group_id,omitempty"`
+	Until          time.Time `json:"until"`
+	Duration       string    `json:"duration,omitempty"`
+	Author         string    `json:"author"`
+	Reason         string    `json:"reason,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Operation tracks a state change requested for a resource until the cloud provider reports the target state.
+type Operation struct {
+	ID             int64      `json:"id"`
+	ResourceID     int64      `json:"resource_id"`
+	CloudAccountID int64      `json:"cloud_account_id"`
+	Action         string     `json:"action"`
+	TargetState    string     `json:"target_state"`
+	Status         string     `json:"status"`
+	Error          string     `json:"error,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	CompletedAt    *time.Time `json:"completed_at,omitempty"`
+}
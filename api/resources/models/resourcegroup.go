@@ -1,5 +1,11 @@
 package models
 
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
 type ResourceGroup struct {
 	ID             int64  `json:"id"`
 	CloudAccountID int64  `json:"cloud_account_id"`
@@ -11,19 +17,100 @@ type ResourceGroup struct {
 type ResourceGroupData struct {
 	ResourceGroup
 	Resources []Resource `json:"resources,omitempty"`
+	Tiers     [][]int64  `json:"tiers,omitempty"`
 }
 
+// RGCreate is a request to create a resource group. The tiers order the start of the members: the members of the
+// first tier are started first and stopped last. Members that are not in a tier belong to the first tier.
 type RGCreate struct {
-	Name           string  `json:"name"`
-	Description    string  `json:"description"`
-	CloudAccountID int64   `json:"cloud_account_id"`
-	ResourceIDs    []int64 `json:"resource_ids"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	CloudAccountID int64     `json:"cloud_account_id"`
+	ResourceIDs    []int64   `json:"resource_ids"`
+	Tiers          [][]int64 `json:"tiers,omitempty"`
 }
 
 type RGUpdate struct {
-	ID             int64   `json:"id"`
-	Name           string  `json:"name"`
-	Description    string  `json:"description"`
-	CloudAccountID int64   `json:"cloud_account_id"`
-	ResourceIDs    []int64 `json:"resource_ids"`
+	ID             int64     `json:"id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	CloudAccountID int64     `json:"cloud_account_id"`
+	ResourceIDs    []int64   `json:"resource_ids"`
+	Tiers          [][]int64 `json:"tiers,omitempty"`
+}
+
+// RGStateChange is a request to start or suspend all the members of a resource group. The tier timeout, e.g. "5m",
+// bounds the wait for a tier to reach the state before the next tier is changed.
+type RGStateChange struct {
+	State       string `json:"state"`
+	RequestedBy string `json:"requested_by,omitempty"`
+	TierTimeout string `json:"tier_timeout,omitempty"`
+}
+
+// RGStateResult is the outcome of a state change of a resource group. The members of the tiers that are not changed
+// yet are pending until the change completes.
+type RGStateResult struct {
+	GroupID      int64           `json:"group_id"`
+	State        string          `json:"state"`
+	Status       string          `json:"status"`
+	ChangeID     int64           `json:"change_id"`
+	ChangeStatus string          `json:"change_status"`
+	Members      []MemberOutcome `json:"members"`
+}
+
+// MemberOutcome is the outcome of a state change for a member of a resource group. The status is the status of the
+// member after the change. A member whose type is not started or suspended, e.g. an unattached disk, is skipped. A
+// member that cannot be changed for now, e.g. while it is kept awake or during a blackout, is deferred. A member of
+// a tier that is not changed yet is pending.
+type MemberOutcome struct {
+	ResourceID int64  `json:"resource_id"`
+	Name       string `json:"name,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Succeeded  bool   `json:"succeeded"`
+	Skipped    bool   `json:"skipped,omitempty"`
+	Deferred   bool   `json:"deferred,omitempty"`
+	Pending    bool   `json:"pending,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// RGChange is a state change of a resource group that goes through its tiers one at a time. The tiers hold the
+// members in the order they are changed, the tier is the index of the tier changed last and the members hold the
+// outcome of every member in the order of the tiers. The next tier is changed once the members of the tier reached
+// the state, the change fails when they do not before the deadline of the tier.
+type RGChange struct {
+	ID             int64          `json:"id"`
+	GroupID        int64          `json:"group_id"`
+	CloudAccountID int64          `json:"cloud_account_id"`
+	State          string         `json:"state"`
+	RequestedBy    string         `json:"requested_by,omitempty"`
+	Tiers          GroupTiers     `json:"tiers"`
+	Tier           int            `json:"tier"`
+	TierTimeout    time.Duration  `json:"-"`
+	TierDeadline   *time.Time     `json:"tier_deadline,omitempty"`
+	Members        MemberOutcomes `json:"members"`
+	Status         string         `json:"status"`
+	Attempt        int            `json:"attempt"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	CompletedAt    *time.Time     `json:"completed_at,omitempty"`
+}
+
+type GroupTiers [][]int64
+
+func (t GroupTiers) Value() (driver.Value, error) {
+	return json.Marshal(t)
+}
+
+func (t *GroupTiers) Scan(value any) error {
+	return scanJSON(value, t)
+}
+
+type MemberOutcomes []MemberOutcome
+
+func (m MemberOutcomes) Value() (driver.Value, error) {
+	return json.Marshal(m)
+}
+
+func (m *MemberOutcomes) Scan(value any) error {
+	return scanJSON(value, m)
 }
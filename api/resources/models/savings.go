@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// Savings is an entry of the savings ledger, the downtime of a resource suspended through zopdev. The entry is open
+// while the resource is suspended and is closed with the saved amount once the resource is started again.
+// HourlyRate is the cost that the resource does not incur per hour while it is suspended.
+type Savings struct {
+	ID             int64      `json:"id"`
+	ResourceID     int64      `json:"resource_id"`
+	CloudAccountID int64      `json:"cloud_account_id"`
+	HourlyRate     float64    `json:"hourly_rate"`
+	Currency       string     `json:"currency,omitempty"`
+	StoppedAt      time.Time  `json:"stopped_at"`
+	StartedAt      *time.Time `json:"started_at,omitempty"`
+	Amount         float64    `json:"amount"`
+}
+
+// SavingsBreakdown is the downtime and the amount saved by a set of resources.
+type SavingsBreakdown struct {
+	DowntimeHours float64 `json:"downtime_hours"`
+	Amount        float64 `json:"amount"`
+}
+
+// SavingsSummary is the amount saved by the resources of a cloud account in a month, in total and by resource group.
+// Resources that are still suspended count up to the time of the request.
+type SavingsSummary struct {
+	Month           string                      `json:"month"`
+	Total           SavingsBreakdown            `json:"total"`
+	ByResourceGroup map[string]SavingsBreakdown `json:"by_resource_group"`
+}
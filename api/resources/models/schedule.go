@@ -0,0 +1,49 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// Schedule holds the weekly windows during which the members of a resource group run. Outside the windows the
+// members are suspended. The windows are read in the IANA time zone of the schedule.
+type Schedule struct {
+	GroupID        int64           `json:"group_id"`
+	CloudAccountID int64           `json:"cloud_account_id"`
+	Timezone       string          `json:"timezone"`
+	Windows        ScheduleWindows `json:"windows"`
+	LastState      string          `json:"last_state,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// ScheduleWindow is a daily on window, e.g. {"days": ["MON", "TUE"], "start": "08:00", "stop": "20:00"}.
+// A window whose stop time is before its start time ends on the next day.
+type ScheduleWindow struct {
+	Days  []string `json:"days"`
+	Start string   `json:"start"`
+	Stop  string   `json:"stop"`
+}
+
+type ScheduleWindows []ScheduleWindow
+
+func (w ScheduleWindows) Value() (driver.Value, error) {
+	return json.Marshal(w)
+}
+
+func (w *ScheduleWindows) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	switch data := value.(type) {
+	case []byte:
+		return json.Unmarshal(data, w)
+	case string:
+		return json.Unmarshal([]byte(data), w)
+	default:
+		return driver.ErrSkip
+	}
+}
@@ -0,0 +1,43 @@
+package models
+
+// Fields by which the resources of all the cloud accounts are sorted.
+const (
+	SortByName      = "name"
+	SortByStatus    = "status"
+	SortByRegion    = "region"
+	SortByType      = "type"
+	SortByProvider  = "provider"
+	SortByCreatedAt = "created_at"
+)
+
+// ResourceQuery filters, sorts and paginates the resources of all the cloud accounts. Empty filters match every
+// resource, a filter with several values matches any of them.
+type ResourceQuery struct {
+	// Name matches the resources whose name contains it, ignoring case.
+	Name      string
+	Statuses  []string
+	Regions   []string
+	Providers []string
+	Types     []string
+	Selectors []LabelSelector
+
+	SortBy     string
+	Descending bool
+
+	// Cursor is the opaque cursor of the page returned by the previous query, After is the position it decodes to.
+	Cursor string
+	After  *PageCursor
+	Limit  int
+}
+
+// PageCursor is the position of the last resource of a page, the next page starts after it in the sort order.
+type PageCursor struct {
+	Value string `json:"value,omitempty"`
+	ID    int64  `json:"id"`
+}
+
+// ResourcePage is a page of resources, NextCursor is empty on the last page.
+type ResourcePage struct {
+	Resources  []Resource `json:"resources"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+}
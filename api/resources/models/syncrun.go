@@ -0,0 +1,83 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// SyncRun records what a sync of the resources of a cloud account did.
+type SyncRun struct {
+	ID             int64        `json:"id"`
+	CloudAccountID int64        `json:"cloud_account_id"`
+	StartedAt      time.Time    `json:"started_at"`
+	FinishedAt     *time.Time   `json:"finished_at,omitempty"`
+	Counts         SyncCounts   `json:"counts"`
+	Statuses       SyncStatuses `json:"statuses"`
+	Errors         SyncErrors   `json:"errors,omitempty"`
+}
+
+// SyncResult is the outcome of a sync, the resources of the cloud account and the sync status of every resource type.
+type SyncResult struct {
+	Resources []Resource   `json:"resources"`
+	Statuses  SyncStatuses `json:"sync_status"`
+}
+
+// SyncCount is the number of resources added, updated and removed by a sync.
+type SyncCount struct {
+	Added   int `json:"added"`
+	Updated int `json:"updated"`
+	Removed int `json:"removed"`
+}
+
+// SyncCounts are the counts of a sync by resource type.
+type SyncCounts map[string]SyncCount
+
+func (c SyncCounts) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+func (c *SyncCounts) Scan(value any) error {
+	return scanJSON(value, c)
+}
+
+// SyncStatus reports whether the resources of a type could be listed during a sync.
+type SyncStatus struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// SyncStatuses are the sync statuses by resource type.
+type SyncStatuses map[string]SyncStatus
+
+func (s SyncStatuses) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+func (s *SyncStatuses) Scan(value any) error {
+	return scanJSON(value, s)
+}
+
+// SyncErrors are the errors that failed a sync, the errors of the listers are reported by the sync statuses.
+type SyncErrors []string
+
+func (e SyncErrors) Value() (driver.Value, error) {
+	return json.Marshal(e)
+}
+
+func (e *SyncErrors) Scan(value any) error {
+	return scanJSON(value, e)
+}
+
+func scanJSON(value, dest any) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, dest)
+	case string:
+		return json.Unmarshal([]byte(v), dest)
+	default:
+		return driver.ErrSkip
+	}
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCounts_Value(t *testing.T) {
+	val, err := SyncCounts{"SQL": {Added: 1, Removed: 2}}.Value()
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"SQL":{"added":1,"updated":0,"removed":2}}`, string(val.([]byte)))
+}
+
+func TestSyncCounts_Scan(t *testing.T) {
+	var c SyncCounts
+
+	require.NoError(t, c.Scan([]byte(`{"GCE":{"added":0,"updated":3,"removed":0}}`)))
+	assert.Equal(t, SyncCounts{"GCE": {Updated: 3}}, c)
+
+	var s SyncCounts
+
+	require.NoError(t, s.Scan(`{"SQL":{"added":1,"updated":0,"removed":0}}`))
+	assert.Equal(t, SyncCounts{"SQL": {Added: 1}}, s)
+
+	var n SyncCounts
+
+	require.NoError(t, n.Scan(nil))
+	assert.Nil(t, n)
+
+	assert.Equal(t, driver.ErrSkip, n.Scan(123))
+}
+
+func TestSyncStatuses_ValueAndScan(t *testing.T) {
+	val, err := SyncStatuses{"RDS": {Status: "FAILED", Error: "access denied"}, "EC2": {Status: "SUCCEEDED"}}.Value()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"RDS":{"status":"FAILED","error":"access denied"},"EC2":{"status":"SUCCEEDED"}}`,
+		string(val.([]byte)))
+
+	var s SyncStatuses
+
+	require.NoError(t, s.Scan(val))
+	assert.Equal(t, SyncStatuses{"RDS": {Status: "FAILED", Error: "access denied"}, "EC2": {Status: "SUCCEEDED"}}, s)
+
+	assert.Equal(t, driver.ErrSkip, s.Scan(true))
+}
+
+func TestSyncErrors_ValueAndScan(t *testing.T) {
+	val, err := SyncErrors{"SQL: mock error"}.Value()
+	require.NoError(t, err)
+
+	var e SyncErrors
+
+	require.NoError(t, e.Scan(val))
+	assert.Equal(t, SyncErrors{"SQL: mock error"}, e)
+
+	assert.Equal(t, driver.ErrSkip, e.Scan(1.5))
+}
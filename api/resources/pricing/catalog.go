@@ -0,0 +1,165 @@
+package pricing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const (
+	// HoursPerMonth is the number of hours used to convert hourly prices to monthly prices.
+	HoursPerMonth = 730
+
+	// haFactor is applied to highly available resources, which run a standby with the same size as the primary.
+	haFactor = 2
+)
+
+var errMissingProviderOrVersion = errors.New("pricing catalog must have a provider and a version")
+
+// Price is a single entry of a pricing catalog. An entry with a SKU prices the machine class of the resource
+// at a fixed hourly rate. An entry without a SKU holds the rates of the resource type in the region, the compute
+// of resources without a SKU entry is priced by their vCPUs and memory and the storage of every resource is priced
+// by its size. An entry without a region applies to the regions that have no entry of their own.
+type Price struct {
+	ResourceType     string  `json:"resource_type"`
+	Region           string  `json:"region,omitempty"`
+	SKU              string  `json:"sku,omitempty"`
+	Hourly           float64 `json:"hourly,omitempty"`
+	VCPUHourly       float64 `json:"vcpu_hourly,omitempty"`
+	MemoryGBHourly   float64 `json:"memory_gb_hourly,omitempty"`
+	StorageGBMonthly float64 `json:"storage_gb_monthly,omitempty"`
+}
+
+// File is the content of a pricing catalog file, there is one file per cloud provider.
+type File struct {
+	Provider string  `json:"provider"`
+	Version  string  `json:"version"`
+	Currency string  `json:"currency"`
+	Prices   []Price `json:"prices"`
+}
+
+type priceKey struct {
+	resourceType string
+	region       string
+	sku          string
+}
+
+type providerCatalog struct {
+	version  string
+	currency string
+	prices   map[priceKey]Price
+}
+
+// Catalog estimates the cost of resources from offline pricing catalogs, keyed by the cloud provider.
+// The zero value is an empty catalog that does not price any resource.
+type Catalog struct {
+	providers map[string]*providerCatalog
+}
+
+// Load reads all the JSON pricing catalog files of the directory.
+func Load(dir string) (*Catalog, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Catalog{providers: make(map[string]*providerCatalog, len(paths))}
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var f File
+
+		err = json.Unmarshal(data, &f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pricing catalog %s: %w", path, err)
+		}
+
+		err = c.Add(&f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pricing catalog %s: %w", path, err)
+		}
+	}
+
+	return c, nil
+}
+
+// Add adds the prices of a catalog file, replacing the catalog of the same provider if it is already present.
+func (c *Catalog) Add(f *File) error {
+	if f.Provider == "" || f.Version == "" {
+		return errMissingProviderOrVersion
+	}
+
+	pc := &providerCatalog{version: f.Version, currency: f.Currency, prices: make(map[priceKey]Price, len(f.Prices))}
+
+	for _, p := range f.Prices {
+		pc.prices[priceKey{resourceType: p.ResourceType, region: p.Region, sku: p.SKU}] = p
+	}
+
+	if c.providers == nil {
+		c.providers = make(map[string]*providerCatalog)
+	}
+
+	// The cloud accounts store their provider in any case, the catalogs are keyed by the upper-cased provider.
+	c.providers[strings.ToUpper(f.Provider)] = pc
+
+	return nil
+}
+
+// Estimate returns the estimated cost of the resource, or nil when the catalog has no price for its compute.
+func (c *Catalog) Estimate(res *models.Resource) *models.Cost {
+	pc, ok := c.providers[strings.ToUpper(res.CloudAccount.Type)]
+	if !ok {
+		return nil
+	}
+
+	rates, _ := pc.lookup(res.Type, res.Region, "")
+
+	var computeHourly float64
+
+	if sku, found := pc.lookup(res.Type, res.Region, res.Spec.MachineClass); found && res.Spec.MachineClass != "" {
+		computeHourly = sku.Hourly
+	} else {
+		computeHourly = res.Spec.VCPU*rates.VCPUHourly + res.Spec.MemoryGB*rates.MemoryGBHourly
+	}
+
+	if computeHourly == 0 {
+		return nil
+	}
+
+	storageMonthly := float64(res.Spec.StorageGB) * rates.StorageGBMonthly
+
+	if res.Spec.HighAvailability {
+		computeHourly *= haFactor
+		storageMonthly *= haFactor
+	}
+
+	hourly := computeHourly + storageMonthly/HoursPerMonth
+
+	return &models.Cost{
+		Hourly:         hourly,
+		Monthly:        hourly * HoursPerMonth,
+		StoppedMonthly: storageMonthly,
+		Currency:       pc.currency,
+		CatalogVersion: pc.version,
+	}
+}
+
+// lookup returns the price of the SKU in the region, falling back to the price that applies to all regions.
+func (pc *providerCatalog) lookup(resourceType, region, sku string) (Price, bool) {
+	if p, ok := pc.prices[priceKey{resourceType: resourceType, region: region, sku: sku}]; ok {
+		return p, true
+	}
+
+	p, ok := pc.prices[priceKey{resourceType: resourceType, sku: sku}]
+
+	return p, ok
+}
@@ -0,0 +1,111 @@
+package pricing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, "gcp.json"), []byte(`{"provider": "GCP", "version": "v1", "currency": "USD",
+		"prices": [{"resource_type": "GCE", "sku": "e2-medium", "hourly": 0.03}]}`), 0o600)
+	require.NoError(t, err)
+
+	c, err := Load(dir)
+	require.NoError(t, err)
+
+	cost := c.Estimate(&models.Resource{Type: "GCE", Region: "us-central1", CloudAccount: models.CloudAccount{Type: "GCP"},
+		Spec: models.Spec{MachineClass: "e2-medium"}})
+	require.NotNil(t, cost)
+	assert.Equal(t, "v1", cost.CatalogVersion)
+
+	err = os.WriteFile(filepath.Join(dir, "aws.json"), []byte(`{"provider": "AWS"}`), 0o600)
+	require.NoError(t, err)
+
+	_, err = Load(dir)
+	require.ErrorIs(t, err, errMissingProviderOrVersion)
+
+	err = os.WriteFile(filepath.Join(dir, "aws.json"), []byte(`{`), 0o600)
+	require.NoError(t, err)
+
+	_, err = Load(dir)
+	require.Error(t, err)
+}
+
+func TestCatalog_Estimate(t *testing.T) {
+	c := &Catalog{}
+
+	err := c.Add(&File{Provider: "GCP", Version: "2025-06-01", Currency: "USD", Prices: []Price{
+		{ResourceType: "SQL", VCPUHourly: 0.04, MemoryGBHourly: 0.01, StorageGBMonthly: 0.2},
+		{ResourceType: "SQL", Region: "europe-west1", VCPUHourly: 0.05, MemoryGBHourly: 0.01, StorageGBMonthly: 0.2},
+		{ResourceType: "SQL", SKU: "db-f1-micro", Hourly: 0.01},
+	}})
+	require.NoError(t, err)
+
+	gcp := models.CloudAccount{Type: "GCP"}
+
+	tests := []struct {
+		desc     string
+		resource models.Resource
+		expected *models.Cost
+	}{
+		{
+			desc: "priced by SKU",
+			resource: models.Resource{Type: "SQL", Region: "us-central1", CloudAccount: gcp,
+				Spec: models.Spec{MachineClass: "db-f1-micro"}},
+			expected: &models.Cost{Hourly: 0.01, Monthly: 7.3, Currency: "USD", CatalogVersion: "2025-06-01"},
+		},
+		{
+			desc: "priced by size with storage",
+			resource: models.Resource{Type: "SQL", Region: "us-central1", CloudAccount: gcp,
+				Spec: models.Spec{VCPU: 2, MemoryGB: 8, StorageGB: 73, MachineClass: "db-custom-2-8192"}},
+			expected: &models.Cost{Hourly: 0.18, Monthly: 131.4, StoppedMonthly: 14.6, Currency: "USD",
+				CatalogVersion: "2025-06-01"},
+		},
+		{
+			desc: "regional rates with high availability",
+			resource: models.Resource{Type: "SQL", Region: "europe-west1", CloudAccount: gcp,
+				Spec: models.Spec{VCPU: 1, MemoryGB: 5, HighAvailability: true}},
+			expected: &models.Cost{Hourly: 0.2, Monthly: 146, Currency: "USD", CatalogVersion: "2025-06-01"},
+		},
+		{
+			desc: "lowercase provider",
+			resource: models.Resource{Type: "SQL", Region: "us-central1", CloudAccount: models.CloudAccount{Type: "gcp"},
+				Spec: models.Spec{MachineClass: "db-f1-micro"}},
+			expected: &models.Cost{Hourly: 0.01, Monthly: 7.3, Currency: "USD", CatalogVersion: "2025-06-01"},
+		},
+		{
+			desc:     "unknown size",
+			resource: models.Resource{Type: "SQL", Region: "us-central1", CloudAccount: gcp},
+		},
+		{
+			desc: "unknown provider",
+			resource: models.Resource{Type: "EC2", Region: "us-east-1", CloudAccount: models.CloudAccount{Type: "AWS"},
+				Spec: models.Spec{MachineClass: "t2.micro"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			cost := c.Estimate(&tc.resource)
+			if tc.expected == nil {
+				assert.Nil(t, cost)
+				return
+			}
+
+			require.NotNil(t, cost)
+			assert.InDelta(t, tc.expected.Hourly, cost.Hourly, 1e-9)
+			assert.InDelta(t, tc.expected.Monthly, cost.Monthly, 1e-9)
+			assert.InDelta(t, tc.expected.StoppedMonthly, cost.StoppedMonthly, 1e-9)
+			assert.Equal(t, tc.expected.Currency, cost.Currency)
+			assert.Equal(t, tc.expected.CatalogVersion, cost.CatalogVersion)
+		})
+	}
+}
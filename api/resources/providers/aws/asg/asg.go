@@ -0,0 +1,225 @@
+package asg
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/regional"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+)
+
+const (
+	// RUNNING Auto Scaling group state for zopdev.
+	RUNNING = "RUNNING"
+	// STOPPED Auto Scaling group state for zopdev, a group scaled to zero instances.
+	STOPPED = "STOPPED"
+	// STARTING Auto Scaling group state for zopdev, a group launching its desired instances.
+	STARTING = "STARTING"
+	// STOPPING Auto Scaling group state for zopdev, a group scaled to zero that still has instances.
+	STOPPING = "STOPPING"
+
+	// ASG is the resource type used for Auto Scaling groups.
+	ASG = "ASG"
+
+	// eksNodeGroupTag is set by EKS on the Auto Scaling groups of managed node groups, these are managed through
+	// their node group.
+	eksNodeGroupTag = "eks:nodegroup-name"
+	inService       = "InService"
+)
+
+// AutoScalingAPI defines the methods used from the AWS Auto Scaling client for easier testing/mocking.
+type AutoScalingAPI interface {
+	DescribeAutoScalingGroupsWithContext(ctx aws.Context, input *autoscaling.DescribeAutoScalingGroupsInput,
+		opts ...request.Option) (*autoscaling.DescribeAutoScalingGroupsOutput, error)
+	UpdateAutoScalingGroupWithContext(ctx aws.Context, input *autoscaling.UpdateAutoScalingGroupInput,
+		opts ...request.Option) (*autoscaling.UpdateAutoScalingGroupOutput, error)
+}
+
+// Size is the capacity of an Auto Scaling group, recorded when the group is scaled to zero and restored when it is
+// started again.
+type Size struct {
+	MinSize         int64 `json:"min_size"`
+	DesiredCapacity int64 `json:"desired_capacity"`
+}
+
+// Client lists and scales Auto Scaling groups across regions.
+// AutoScaling holds one region-scoped Auto Scaling client per AWS region, keyed by the region name.
+type Client struct {
+	AutoScaling map[string]AutoScalingAPI
+}
+
+// GetAllGroups lists the Auto Scaling groups of all the regions. The groups of EKS managed node groups are skipped
+// as they are scaled through their node group.
+func (c *Client) GetAllGroups(ctx *gofr.Context) ([]models.Resource, error) {
+	return regional.List(c.AutoScaling, func(cl AutoScalingAPI, region string) ([]models.Resource, error) {
+		return getRegionGroups(ctx, cl, region)
+	})
+}
+
+// getRegionGroups lists all the Auto Scaling groups of a single region, following the pagination tokens.
+func getRegionGroups(ctx *gofr.Context, cl AutoScalingAPI, region string) ([]models.Resource, error) {
+	input := &autoscaling.DescribeAutoScalingGroupsInput{}
+	groups := make([]models.Resource, 0)
+
+	for {
+		result, err := cl.DescribeAutoScalingGroupsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, g := range result.AutoScalingGroups {
+			labels := getLabels(g.Tags)
+			if _, ok := labels[eksNodeGroupTag]; ok {
+				continue
+			}
+
+			var creationTime string
+			if g.CreatedTime != nil {
+				creationTime = g.CreatedTime.Format(time.RFC3339)
+			}
+
+			groups = append(groups, models.Resource{
+				Name:         aws.StringValue(g.AutoScalingGroupName),
+				Type:         ASG,
+				UID:          aws.StringValue(g.AutoScalingGroupARN),
+				Region:       region,
+				CreationTime: creationTime,
+				Status:       getState(g),
+				Labels:       labels,
+				Spec:         models.Spec{MachineClass: getInstanceType(g)},
+				Settings: map[string]any{
+					"min_size":         aws.Int64Value(g.MinSize),
+					"max_size":         aws.Int64Value(g.MaxSize),
+					"desired_capacity": aws.Int64Value(g.DesiredCapacity),
+				},
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			})
+		}
+
+		if aws.StringValue(result.NextToken) == "" {
+			return groups, nil
+		}
+
+		input.NextToken = result.NextToken
+	}
+}
+
+// StopGroup scales an Auto Scaling group to zero instances by setting its minimum size and desired capacity to
+// zero, the maximum size is kept. The capacity of the group before it was scaled down is returned.
+func (c *Client) StopGroup(ctx *gofr.Context, region, name string) (*Size, error) {
+	cl, err := c.client(region)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := cl.DescribeAutoScalingGroupsWithContext(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []*string{aws.String(name)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.AutoScalingGroups) == 0 {
+		return nil, gofrHttp.ErrorEntityNotFound{Name: "auto scaling group", Value: name}
+	}
+
+	g := result.AutoScalingGroups[0]
+	size := &Size{MinSize: aws.Int64Value(g.MinSize), DesiredCapacity: aws.Int64Value(g.DesiredCapacity)}
+
+	err = c.update(ctx, cl, name, &Size{})
+	if err != nil {
+		return nil, err
+	}
+
+	return size, nil
+}
+
+// StartGroup restores the capacity of an Auto Scaling group that was scaled to zero.
+func (c *Client) StartGroup(ctx *gofr.Context, region, name string, size *Size) error {
+	cl, err := c.client(region)
+	if err != nil {
+		return err
+	}
+
+	return c.update(ctx, cl, name, size)
+}
+
+func (*Client) update(ctx *gofr.Context, cl AutoScalingAPI, name string, size *Size) error {
+	_, err := cl.UpdateAutoScalingGroupWithContext(ctx, &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(name),
+		MinSize:              aws.Int64(size.MinSize),
+		DesiredCapacity:      aws.Int64(size.DesiredCapacity),
+	})
+
+	return err
+}
+
+// client returns the Auto Scaling client scoped to the given region.
+func (c *Client) client(region string) (AutoScalingAPI, error) {
+	cl, ok := c.AutoScaling[region]
+	if !ok {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Region"}}
+	}
+
+	return cl, nil
+}
+
+// getState maps the capacity of an Auto Scaling group and its instances in service to the zopdev state, groups
+// being deleted keep their status.
+func getState(g *autoscaling.Group) string {
+	if status := aws.StringValue(g.Status); status != "" {
+		return status
+	}
+
+	var running int64
+
+	for _, inst := range g.Instances {
+		if aws.StringValue(inst.LifecycleState) == inService {
+			running++
+		}
+	}
+
+	desired := aws.Int64Value(g.DesiredCapacity)
+
+	switch {
+	case desired == 0 && len(g.Instances) == 0:
+		return STOPPED
+	case desired == 0:
+		return STOPPING
+	case running < desired:
+		return STARTING
+	default:
+		return RUNNING
+	}
+}
+
+// getInstanceType returns the instance type of the instances of a group, empty for groups without instances.
+func getInstanceType(g *autoscaling.Group) string {
+	for _, inst := range g.Instances {
+		if t := aws.StringValue(inst.InstanceType); t != "" {
+			return t
+		}
+	}
+
+	return ""
+}
+
+// getLabels converts the tags of an Auto Scaling group to resource labels.
+func getLabels(tags []*autoscaling.TagDescription) models.Labels {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	labels := make(models.Labels, len(tags))
+
+	for _, tag := range tags {
+		labels[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return labels
+}
@@ -0,0 +1,156 @@
+package asg
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+var errFail = errors.New("fail")
+
+type mockAutoScaling struct {
+	DescribeResp *autoscaling.DescribeAutoScalingGroupsOutput
+	DescribeErr  error
+	UpdateErr    error
+	Updates      []*autoscaling.UpdateAutoScalingGroupInput
+}
+
+func (m *mockAutoScaling) DescribeAutoScalingGroupsWithContext(_ aws.Context,
+	_ *autoscaling.DescribeAutoScalingGroupsInput, _ ...request.Option) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	if m.DescribeErr != nil {
+		return nil, m.DescribeErr
+	}
+
+	if m.DescribeResp == nil {
+		return &autoscaling.DescribeAutoScalingGroupsOutput{}, nil
+	}
+
+	return m.DescribeResp, nil
+}
+
+func (m *mockAutoScaling) UpdateAutoScalingGroupWithContext(_ aws.Context, input *autoscaling.UpdateAutoScalingGroupInput,
+	_ ...request.Option) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+	m.Updates = append(m.Updates, input)
+
+	return &autoscaling.UpdateAutoScalingGroupOutput{}, m.UpdateErr
+}
+
+func Test_GetAllGroups(t *testing.T) {
+	created := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	client := &Client{AutoScaling: map[string]AutoScalingAPI{
+		"us-east-1": &mockAutoScaling{DescribeResp: &autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{
+				{
+					AutoScalingGroupName: aws.String("web"),
+					AutoScalingGroupARN:  aws.String("arn:aws:autoscaling:us-east-1:123:autoScalingGroup:web"),
+					CreatedTime:          aws.Time(created),
+					MinSize:              aws.Int64(1), MaxSize: aws.Int64(4), DesiredCapacity: aws.Int64(2),
+					Instances: []*autoscaling.Instance{
+						{InstanceType: aws.String("t3.small"), LifecycleState: aws.String("InService")},
+						{InstanceType: aws.String("t3.small"), LifecycleState: aws.String("InService")},
+					},
+					Tags: []*autoscaling.TagDescription{{Key: aws.String("team"), Value: aws.String("web")}},
+				},
+				{
+					AutoScalingGroupName: aws.String("eks-pool"),
+					Tags: []*autoscaling.TagDescription{
+						{Key: aws.String("eks:nodegroup-name"), Value: aws.String("pool")}},
+				},
+			},
+		}},
+		"af-south-1": &mockAutoScaling{DescribeErr: awserr.New("InvalidClientTokenId", "region not enabled", nil)},
+	}}
+
+	groups, err := client.GetAllGroups(nil)
+
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+
+	groups[0].CreatedAt, groups[0].UpdatedAt = time.Time{}, time.Time{}
+
+	assert.Equal(t, models.Resource{
+		Name: "web", Type: ASG, UID: "arn:aws:autoscaling:us-east-1:123:autoScalingGroup:web", Region: "us-east-1",
+		CreationTime: "2025-06-01T10:00:00Z", Status: RUNNING, Labels: models.Labels{"team": "web"},
+		Spec:     models.Spec{MachineClass: "t3.small"},
+		Settings: map[string]any{"min_size": int64(1), "max_size": int64(4), "desired_capacity": int64(2)},
+	}, groups[0])
+}
+
+func Test_GetAllGroups_Error(t *testing.T) {
+	client := &Client{AutoScaling: map[string]AutoScalingAPI{
+		"us-east-1": &mockAutoScaling{DescribeErr: errFail},
+		"eu-west-1": &mockAutoScaling{},
+	}}
+
+	groups, err := client.GetAllGroups(nil)
+
+	require.ErrorIs(t, err, errFail)
+	assert.Nil(t, groups)
+}
+
+func Test_StopStartGroup(t *testing.T) {
+	m := &mockAutoScaling{DescribeResp: &autoscaling.DescribeAutoScalingGroupsOutput{
+		AutoScalingGroups: []*autoscaling.Group{
+			{AutoScalingGroupName: aws.String("web"), MinSize: aws.Int64(1), MaxSize: aws.Int64(4),
+				DesiredCapacity: aws.Int64(2)},
+		},
+	}}
+	client := &Client{AutoScaling: map[string]AutoScalingAPI{"us-east-1": m}}
+
+	size, err := client.StopGroup(nil, "us-east-1", "web")
+
+	require.NoError(t, err)
+	assert.Equal(t, &Size{MinSize: 1, DesiredCapacity: 2}, size)
+	require.NoError(t, client.StartGroup(nil, "us-east-1", "web", size))
+
+	assert.Equal(t, []*autoscaling.UpdateAutoScalingGroupInput{
+		{AutoScalingGroupName: aws.String("web"), MinSize: aws.Int64(0), DesiredCapacity: aws.Int64(0)},
+		{AutoScalingGroupName: aws.String("web"), MinSize: aws.Int64(1), DesiredCapacity: aws.Int64(2)},
+	}, m.Updates)
+}
+
+func Test_StopGroup_Errors(t *testing.T) {
+	client := &Client{AutoScaling: map[string]AutoScalingAPI{"us-east-1": &mockAutoScaling{}}}
+
+	_, err := client.StopGroup(nil, "mars-east-1", "web")
+	require.Error(t, err)
+
+	_, err = client.StopGroup(nil, "us-east-1", "web")
+	require.Error(t, err)
+
+	client = &Client{AutoScaling: map[string]AutoScalingAPI{"us-east-1": &mockAutoScaling{
+		DescribeResp: &autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{{AutoScalingGroupName: aws.String("web")}},
+		},
+		UpdateErr: errFail,
+	}}}
+
+	size, err := client.StopGroup(nil, "us-east-1", "web")
+
+	require.ErrorIs(t, err, errFail)
+	assert.Nil(t, size)
+	require.Error(t, client.StartGroup(nil, "mars-east-1", "web", &Size{}))
+}
+
+func Test_getState(t *testing.T) {
+	inService := &autoscaling.Instance{LifecycleState: aws.String("InService")}
+	pending := &autoscaling.Instance{LifecycleState: aws.String("Pending")}
+
+	assert.Equal(t, RUNNING, getState(&autoscaling.Group{DesiredCapacity: aws.Int64(1),
+		Instances: []*autoscaling.Instance{inService}}))
+	assert.Equal(t, STARTING, getState(&autoscaling.Group{DesiredCapacity: aws.Int64(2),
+		Instances: []*autoscaling.Instance{inService, pending}}))
+	assert.Equal(t, STOPPING, getState(&autoscaling.Group{DesiredCapacity: aws.Int64(0),
+		Instances: []*autoscaling.Instance{inService}}))
+	assert.Equal(t, STOPPED, getState(&autoscaling.Group{DesiredCapacity: aws.Int64(0)}))
+	assert.Equal(t, "Delete in progress", getState(&autoscaling.Group{Status: aws.String("Delete in progress")}))
+}
@@ -10,9 +10,20 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/asg"
 	"github.com/zopdev/zopdev/api/resources/providers/aws/database"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/ebs"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/ecsservice"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/eip"
+	cache "github.com/zopdev/zopdev/api/resources/providers/aws/elasticache"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/nodegroup"
 	"github.com/zopdev/zopdev/api/resources/providers/aws/vm"
 )
 
@@ -30,6 +41,120 @@ func New() *Client {
 
 // NewRDSClient creates a new RDS client with stored credentials.
 func (c *Client) NewRDSClient(_ context.Context, creds any) (*database.Client, error) {
+	sess, err := c.session(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &database.Client{RDS: regionalClients(func(cfg *aws.Config) database.RDSAPI {
+		return rds.New(sess, cfg)
+	})}, nil
+}
+
+// NewEC2Client creates a new EC2 client with stored credentials.
+func (c *Client) NewEC2Client(_ context.Context, creds any) (*vm.Client, error) {
+	sess, err := c.session(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vm.Client{EC2: regionalClients(func(cfg *aws.Config) vm.EC2API {
+		return ec2.New(sess, cfg)
+	})}, nil
+}
+
+// NewAutoScalingClient creates a new Auto Scaling client with stored credentials.
+func (c *Client) NewAutoScalingClient(_ context.Context, creds any) (*asg.Client, error) {
+	sess, err := c.session(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &asg.Client{AutoScaling: regionalClients(func(cfg *aws.Config) asg.AutoScalingAPI {
+		return autoscaling.New(sess, cfg)
+	})}, nil
+}
+
+// NewEKSClient creates a new EKS client with stored credentials.
+func (c *Client) NewEKSClient(_ context.Context, creds any) (*nodegroup.Client, error) {
+	sess, err := c.session(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodegroup.Client{EKS: regionalClients(func(cfg *aws.Config) nodegroup.EKSAPI {
+		return eks.New(sess, cfg)
+	})}, nil
+}
+
+// NewECSClient creates a new ECS client with stored credentials.
+func (c *Client) NewECSClient(_ context.Context, creds any) (*ecsservice.Client, error) {
+	sess, err := c.session(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ecsservice.Client{ECS: regionalClients(func(cfg *aws.Config) ecsservice.ECSAPI {
+		return ecs.New(sess, cfg)
+	})}, nil
+}
+
+// NewEBSClient creates a new EBS client with stored credentials, EBS volumes and snapshots are managed through EC2.
+func (c *Client) NewEBSClient(_ context.Context, creds any) (*ebs.Client, error) {
+	sess, err := c.session(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ebs.Client{EC2: regionalClients(func(cfg *aws.Config) ebs.EBSAPI {
+		return ec2.New(sess, cfg)
+	})}, nil
+}
+
+// NewElasticIPClient creates a new Elastic IP client with stored credentials, Elastic IPs are managed through EC2.
+func (c *Client) NewElasticIPClient(_ context.Context, creds any) (*eip.Client, error) {
+	sess, err := c.session(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eip.Client{EC2: regionalClients(func(cfg *aws.Config) eip.EIPAPI {
+		return ec2.New(sess, cfg)
+	})}, nil
+}
+
+// NewElastiCacheClient creates a new ElastiCache client with stored credentials, the connection metrics of the caches
+// are read from CloudWatch.
+func (c *Client) NewElastiCacheClient(_ context.Context, creds any) (*cache.Client, error) {
+	sess, err := c.session(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cache.Client{
+		ElastiCache: regionalClients(func(cfg *aws.Config) cache.ElastiCacheAPI {
+			return elasticache.New(sess, cfg)
+		}),
+		CloudWatch: regionalClients(func(cfg *aws.Config) cache.CloudWatchAPI {
+			return cloudwatch.New(sess, cfg)
+		}),
+	}, nil
+}
+
+// regionalClients creates one region-scoped client per AWS region, keyed by the region name.
+func regionalClients[T any](newClient func(cfg *aws.Config) T) map[string]T {
+	regions := vm.GetAWSRegions()
+	clients := make(map[string]T, len(regions))
+
+	for _, region := range regions {
+		clients[region] = newClient(aws.NewConfig().WithRegion(region))
+	}
+
+	return clients
+}
+
+// session creates a new AWS session with the stored credentials.
+func (c *Client) session(creds any) (*session.Session, error) {
 	awsCreds, err := getAWSCredentials(creds)
 	if err != nil {
 		return nil, ErrInvalidCredentials
@@ -40,7 +165,7 @@ func (c *Client) NewRDSClient(_ context.Context, creds any) (*database.Client, e
 		return nil, ErrInitializingClient
 	}
 
-	return &database.Client{RDS: rds.New(sess)}, nil
+	return sess, nil
 }
 
 type awsCredentials struct {
@@ -73,22 +198,8 @@ func getAWSCredentials(creds any) (awsCredentials, error) {
 	return awsCred, nil
 }
 
-// NewEC2Client creates a new EC2 client with stored credentials.
-func (c *Client) NewEC2Client(_ context.Context, creds any) (*vm.Client, error) {
-	awsCreds, err := getAWSCredentials(creds)
-	if err != nil {
-		return nil, ErrInvalidCredentials
-	}
-
-	sess, err := c.newSession(awsCreds.AccessKey, awsCreds.AccessSecret)
-	if err != nil {
-		return nil, ErrInitializingClient
-	}
-
-	return &vm.Client{EC2: ec2.New(sess)}, nil
-}
-
 // newSession creates a new AWS session with the stored config and credentials.
+// Service clients override the default region with the region they are scoped to.
 func (*Client) newSession(accessKey, secretKey string) (*session.Session, error) {
 	creds := credentials.NewStaticCredentials(accessKey, secretKey, "")
 
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/zopdev/zopdev/api/resources/providers/aws/vm"
 )
 
 func TestNew(t *testing.T) {
@@ -92,3 +94,76 @@ func TestNewEC2Client_Success(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, client)
 }
+
+func TestNewAutoScalingClient(t *testing.T) {
+	c := &Client{}
+
+	_, err := c.NewAutoScalingClient(context.Background(), map[string]string{})
+	require.Error(t, err)
+
+	creds := map[string]string{"aws_access_key_id": "key", "aws_secret_access_key": "secret"}
+	client, err := c.NewAutoScalingClient(context.Background(), creds)
+	require.NoError(t, err)
+	require.Len(t, client.AutoScaling, len(vm.GetAWSRegions()))
+}
+
+func TestNewEKSClient(t *testing.T) {
+	c := &Client{}
+
+	_, err := c.NewEKSClient(context.Background(), map[string]string{})
+	require.Error(t, err)
+
+	creds := map[string]string{"aws_access_key_id": "key", "aws_secret_access_key": "secret"}
+	client, err := c.NewEKSClient(context.Background(), creds)
+	require.NoError(t, err)
+	require.Len(t, client.EKS, len(vm.GetAWSRegions()))
+}
+
+func TestNewECSClient(t *testing.T) {
+	c := &Client{}
+
+	_, err := c.NewECSClient(context.Background(), map[string]string{})
+	require.Error(t, err)
+
+	creds := map[string]string{"aws_access_key_id": "key", "aws_secret_access_key": "secret"}
+	client, err := c.NewECSClient(context.Background(), creds)
+	require.NoError(t, err)
+	require.Len(t, client.ECS, len(vm.GetAWSRegions()))
+}
+
+func TestNewEBSClient(t *testing.T) {
+	c := &Client{}
+
+	_, err := c.NewEBSClient(context.Background(), map[string]string{})
+	require.Error(t, err)
+
+	creds := map[string]string{"aws_access_key_id": "key", "aws_secret_access_key": "secret"}
+	client, err := c.NewEBSClient(context.Background(), creds)
+	require.NoError(t, err)
+	require.Len(t, client.EC2, len(vm.GetAWSRegions()))
+}
+
+func TestNewElasticIPClient(t *testing.T) {
+	c := &Client{}
+
+	_, err := c.NewElasticIPClient(context.Background(), map[string]string{})
+	require.Error(t, err)
+
+	creds := map[string]string{"aws_access_key_id": "key", "aws_secret_access_key": "secret"}
+	client, err := c.NewElasticIPClient(context.Background(), creds)
+	require.NoError(t, err)
+	require.Len(t, client.EC2, len(vm.GetAWSRegions()))
+}
+
+func TestNewElastiCacheClient(t *testing.T) {
+	c := &Client{}
+
+	_, err := c.NewElastiCacheClient(context.Background(), map[string]string{})
+	require.Error(t, err)
+
+	creds := map[string]string{"aws_access_key_id": "key", "aws_secret_access_key": "secret"}
+	client, err := c.NewElastiCacheClient(context.Background(), creds)
+	require.NoError(t, err)
+	require.Len(t, client.ElastiCache, len(vm.GetAWSRegions()))
+	require.Len(t, client.CloudWatch, len(vm.GetAWSRegions()))
+}
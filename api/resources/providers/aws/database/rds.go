@@ -1,14 +1,17 @@
 package database
 
 import (
+	"errors"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/regional"
 	"gofr.dev/pkg/gofr"
 	gofrService "gofr.dev/pkg/gofr/http"
 )
@@ -18,6 +21,16 @@ const (
 	RUNNING = "RUNNING"
 	// STOPPED instance state for zopdev.
 	STOPPED = "STOPPED"
+	// STARTING instance state for zopdev.
+	STARTING = "STARTING"
+	// STOPPING instance state for zopdev.
+	STOPPING = "STOPPING"
+
+	// ServerlessInstanceClass is the instance class of the Aurora Serverless v2 instances.
+	ServerlessInstanceClass = "db.serverless"
+	// MinCapacity is the lowest capacity in Aurora capacity units (ACUs) supported by all the Aurora Serverless v2
+	// engine versions. A serverless cluster is suspended by scaling its capacity range down to it.
+	MinCapacity = 0.5
 )
 
 // RDSAPI defines the methods used from the AWS RDS client for easier testing/mocking.
@@ -28,13 +41,25 @@ type RDSAPI interface {
 	StartDBInstanceWithContext(ctx aws.Context, input *rds.StartDBInstanceInput, opts ...request.Option) (*rds.StartDBInstanceOutput, error)
 	StopDBClusterWithContext(ctx aws.Context, input *rds.StopDBClusterInput, opts ...request.Option) (*rds.StopDBClusterOutput, error)
 	StopDBInstanceWithContext(ctx aws.Context, input *rds.StopDBInstanceInput, opts ...request.Option) (*rds.StopDBInstanceOutput, error)
+	DescribeDBClustersWithContext(ctx aws.Context, input *rds.DescribeDBClustersInput,
+		opts ...request.Option) (*rds.DescribeDBClustersOutput, error)
+	ModifyDBClusterWithContext(ctx aws.Context, input *rds.ModifyDBClusterInput,
+		opts ...request.Option) (*rds.ModifyDBClusterOutput, error)
 }
 
+// Client lists and idles RDS instances across regions.
+// RDS holds one region-scoped RDS client per AWS region, keyed by the region name.
 type Client struct {
-	RDS RDSAPI
+	RDS map[string]RDSAPI
+}
+
+// Capacity is the capacity range of an Aurora Serverless v2 cluster in Aurora capacity units (ACUs).
+type Capacity struct {
+	MinCapacity float64 `json:"min_capacity"`
+	MaxCapacity float64 `json:"max_capacity"`
 }
 
-// mapRDSStatus maps AWS RDS DBInstanceStatus to RUNNING, STOPPED, or the original status.
+// mapRDSStatus maps AWS RDS DBInstanceStatus to RUNNING, STOPPED, STARTING, STOPPING, or the original status.
 func mapRDSStatus(status string) string {
 	switch strings.ToLower(status) {
 	case "available", "backing-up", "configuring-enhanced-monitoring", "configuring-iam-database-auth",
@@ -42,8 +67,12 @@ func mapRDSStatus(status string) string {
 		"rebooting", "resetting-master-credentials", "renaming", "restore-error", "storage-config-upgrade",
 		"storage-full", "storage-initialization", "storage-optimization", "upgrading":
 		return RUNNING
-	case "stopped", "stopping", "starting":
+	case "stopped":
 		return STOPPED
+	case "starting":
+		return STARTING
+	case "stopping":
+		return STOPPING
 	default:
 		return status
 	}
@@ -65,57 +94,182 @@ func extractEngineAndClusterID(resource *models.Resource) (engine, clusterID str
 }
 
 func (c *Client) GetAllInstances(ctx *gofr.Context) ([]models.Resource, error) {
+	return regional.List(c.RDS, func(cl RDSAPI, region string) ([]models.Resource, error) {
+		return getRegionInstances(ctx, cl, region)
+	})
+}
+
+// getRegionInstances lists all the DB instances of a single region, following the pagination markers.
+func getRegionInstances(ctx *gofr.Context, cl RDSAPI, region string) ([]models.Resource, error) {
 	input := &rds.DescribeDBInstancesInput{}
+	instances := make([]models.Resource, 0)
+
+	for {
+		result, err := cl.DescribeDBInstancesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, db := range result.DBInstances {
+			instances = append(instances, toResource(db, region))
+		}
+
+		if awsStringValue(result.Marker) == "" {
+			break
+		}
+
+		input.Marker = result.Marker
+	}
+
+	if err := setServerlessCapacity(ctx, cl, instances); err != nil {
+		return nil, err
+	}
+
+	return instances, nil
+}
 
-	result, err := c.RDS.DescribeDBInstancesWithContext(ctx, input)
+// toResource converts a DB instance of a region to a resource.
+func toResource(db *rds.DBInstance, region string) models.Resource {
+	return models.Resource{
+		Name:         awsStringValue(db.DBInstanceIdentifier),
+		Type:         "RDS",
+		UID:          awsStringValue(db.DBInstanceArn),
+		Region:       region,
+		CreationTime: db.InstanceCreateTime.String(),
+		Status:       mapRDSStatus(awsStringValue(db.DBInstanceStatus)),
+		Labels:       getLabels(db.TagList),
+		Spec: models.Spec{
+			StorageGB:        aws.Int64Value(db.AllocatedStorage),
+			MachineClass:     awsStringValue(db.DBInstanceClass),
+			HighAvailability: aws.BoolValue(db.MultiAZ),
+		},
+		CloudAccount: models.CloudAccount{}, // TODO: Set from context or parameter if available
+		Settings: map[string]any{
+			"engine":            awsStringValue(db.Engine),
+			"cluster_id":        awsStringValue(db.DBClusterIdentifier),
+			"availability_zone": awsStringValue(db.AvailabilityZone),
+		},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// DescribeInstance fetches the current state of a DB instance by its ARN, without listing the instances of the
+// account.
+func (c *Client) DescribeInstance(ctx *gofr.Context, resource *models.Resource) (*models.Resource, error) {
+	cl, err := c.client(resource.Region)
 	if err != nil {
 		return nil, err
 	}
 
-	instances := make([]models.Resource, 0, len(result.DBInstances))
-
-	for _, db := range result.DBInstances {
-		engine := awsStringValue(db.Engine)
-		clusterID := awsStringValue(db.DBClusterIdentifier)
-		status := awsStringValue(db.DBInstanceStatus)
-
-		mappedStatus := mapRDSStatus(status)
-
-		instance := models.Resource{
-			Name:         awsStringValue(db.DBInstanceIdentifier),
-			Type:         "RDS",
-			UID:          awsStringValue(db.DBInstanceArn),
-			Region:       awsStringValue(db.AvailabilityZone),
-			CreationTime: db.InstanceCreateTime.String(),
-			Status:       mappedStatus,
-			CloudAccount: models.CloudAccount{}, // TODO: Set from context or parameter if available
-			Settings: map[string]any{
-				"engine":     engine,
-				"cluster_id": clusterID,
-			},
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
+	result, err := cl.DescribeDBInstancesWithContext(ctx, &rds.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String(resource.UID),
+	})
+	if err != nil {
+		var aErr awserr.Error
+		if errors.As(err, &aErr) && aErr.Code() == rds.ErrCodeDBInstanceNotFoundFault {
+			return nil, gofrService.ErrorEntityNotFound{Name: "DB instance", Value: resource.UID}
 		}
-		instances = append(instances, instance)
+
+		return nil, err
 	}
 
-	return instances, nil
+	if len(result.DBInstances) == 0 {
+		return nil, gofrService.ErrorEntityNotFound{Name: "DB instance", Value: resource.UID}
+	}
+
+	instances := []models.Resource{toResource(result.DBInstances[0], resource.Region)}
+
+	if err = setServerlessCapacity(ctx, cl, instances); err != nil {
+		return nil, err
+	}
+
+	return &instances[0], nil
 }
 
-// StartInstance handles all RDS types: Aurora clusters and standard RDS. Aurora Serverless detection is not supported here.
+// setServerlessCapacity sets the capacity range of the cluster of the Aurora Serverless v2 instances in their settings.
+// A serverless instance does not stop, it is reported as STOPPED while its cluster is scaled down to MinCapacity.
+func setServerlessCapacity(ctx *gofr.Context, cl RDSAPI, instances []models.Resource) error {
+	var capacities map[string]*rds.ServerlessV2ScalingConfigurationInfo
+
+	for i := range instances {
+		if instances[i].Spec.MachineClass != ServerlessInstanceClass {
+			continue
+		}
+
+		// The clusters are only described when the region has serverless instances.
+		if capacities == nil {
+			var err error
+
+			capacities, err = getClusterCapacities(ctx, cl)
+			if err != nil {
+				return err
+			}
+		}
+
+		clusterID, _ := instances[i].Settings["cluster_id"].(string)
+
+		capacity := capacities[clusterID]
+		if capacity == nil {
+			continue
+		}
+
+		instances[i].Settings["min_capacity"] = aws.Float64Value(capacity.MinCapacity)
+		instances[i].Settings["max_capacity"] = aws.Float64Value(capacity.MaxCapacity)
+
+		if instances[i].Status == RUNNING && aws.Float64Value(capacity.MaxCapacity) <= MinCapacity {
+			instances[i].Status = STOPPED
+		}
+	}
+
+	return nil
+}
+
+// getClusterCapacities returns the capacity range of the Aurora Serverless v2 clusters of a region by cluster identifier.
+func getClusterCapacities(ctx *gofr.Context, cl RDSAPI) (map[string]*rds.ServerlessV2ScalingConfigurationInfo, error) {
+	capacities := make(map[string]*rds.ServerlessV2ScalingConfigurationInfo)
+	input := &rds.DescribeDBClustersInput{}
+
+	for {
+		result, err := cl.DescribeDBClustersWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cluster := range result.DBClusters {
+			if cluster.ServerlessV2ScalingConfiguration != nil {
+				capacities[awsStringValue(cluster.DBClusterIdentifier)] = cluster.ServerlessV2ScalingConfiguration
+			}
+		}
+
+		if awsStringValue(result.Marker) == "" {
+			return capacities, nil
+		}
+
+		input.Marker = result.Marker
+	}
+}
+
+// StartInstance handles all RDS types: Aurora clusters and standard RDS. Aurora Serverless v2 instances are not stopped,
+// their cluster is scaled back up with RestoreCluster.
 func (c *Client) StartInstance(ctx *gofr.Context, resource *models.Resource) error {
 	engine, clusterID, err := extractEngineAndClusterID(resource)
 	if err != nil {
 		return err
 	}
 
+	cl, err := c.client(resource.Region)
+	if err != nil {
+		return err
+	}
+
 	engine = strings.ToLower(engine)
 
 	if strings.Contains(engine, "aurora") && clusterID != "" {
 		input := &rds.StartDBClusterInput{
 			DBClusterIdentifier: aws.String(clusterID),
 		}
-		_, err = c.RDS.StartDBClusterWithContext(ctx, input)
+		_, err = cl.StartDBClusterWithContext(ctx, input)
 
 		return err
 	}
@@ -123,25 +277,31 @@ func (c *Client) StartInstance(ctx *gofr.Context, resource *models.Resource) err
 	input := &rds.StartDBInstanceInput{
 		DBInstanceIdentifier: aws.String(resource.Name),
 	}
-	_, err = c.RDS.StartDBInstanceWithContext(ctx, input)
+	_, err = cl.StartDBInstanceWithContext(ctx, input)
 
 	return err
 }
 
-// StopInstance handles all RDS types: Aurora clusters and standard RDS. Aurora Serverless detection is not supported here.
+// StopInstance handles all RDS types: Aurora clusters and standard RDS. Aurora Serverless v2 instances cannot be stopped,
+// their cluster is scaled down with ScaleDownCluster.
 func (c *Client) StopInstance(ctx *gofr.Context, resource *models.Resource) error {
 	engine, clusterID, err := extractEngineAndClusterID(resource)
 	if err != nil {
 		return err
 	}
 
+	cl, err := c.client(resource.Region)
+	if err != nil {
+		return err
+	}
+
 	engine = strings.ToLower(engine)
 
 	if strings.Contains(engine, "aurora") && clusterID != "" {
 		input := &rds.StopDBClusterInput{
 			DBClusterIdentifier: aws.String(clusterID),
 		}
-		_, err = c.RDS.StopDBClusterWithContext(ctx, input)
+		_, err = cl.StopDBClusterWithContext(ctx, input)
 
 		return err
 	}
@@ -149,11 +309,116 @@ func (c *Client) StopInstance(ctx *gofr.Context, resource *models.Resource) erro
 	input := &rds.StopDBInstanceInput{
 		DBInstanceIdentifier: aws.String(resource.Name),
 	}
-	_, err = c.RDS.StopDBInstanceWithContext(ctx, input)
+	_, err = cl.StopDBInstanceWithContext(ctx, input)
 
 	return err
 }
 
+// ScaleDownCluster suspends the Aurora Serverless v2 cluster of an instance by scaling its capacity range down to
+// MinCapacity, and returns the capacity range it had before to restore it.
+func (c *Client) ScaleDownCluster(ctx *gofr.Context, resource *models.Resource) (*Capacity, error) {
+	_, clusterID, err := extractEngineAndClusterID(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if clusterID == "" {
+		return nil, gofrService.ErrorInvalidParam{Params: []string{"resource.Settings.cluster_id"}}
+	}
+
+	cl, err := c.client(resource.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := cl.DescribeDBClustersWithContext(ctx, &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(clusterID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.DBClusters) == 0 || result.DBClusters[0].ServerlessV2ScalingConfiguration == nil {
+		return nil, gofrService.ErrorEntityNotFound{Name: "serverless cluster", Value: clusterID}
+	}
+
+	scaling := result.DBClusters[0].ServerlessV2ScalingConfiguration
+	capacity := &Capacity{
+		MinCapacity: aws.Float64Value(scaling.MinCapacity),
+		MaxCapacity: aws.Float64Value(scaling.MaxCapacity),
+	}
+
+	err = modifyCapacity(ctx, cl, clusterID, &Capacity{MinCapacity: MinCapacity, MaxCapacity: MinCapacity})
+	if err != nil {
+		return nil, err
+	}
+
+	return capacity, nil
+}
+
+// RestoreCluster restores the capacity range of the Aurora Serverless v2 cluster of an instance scaled down
+// by ScaleDownCluster.
+func (c *Client) RestoreCluster(ctx *gofr.Context, resource *models.Resource, capacity *Capacity) error {
+	_, clusterID, err := extractEngineAndClusterID(resource)
+	if err != nil {
+		return err
+	}
+
+	if clusterID == "" {
+		return gofrService.ErrorInvalidParam{Params: []string{"resource.Settings.cluster_id"}}
+	}
+
+	cl, err := c.client(resource.Region)
+	if err != nil {
+		return err
+	}
+
+	return modifyCapacity(ctx, cl, clusterID, capacity)
+}
+
+// modifyCapacity applies the capacity range of a serverless cluster immediately, not in the maintenance window.
+func modifyCapacity(ctx *gofr.Context, cl RDSAPI, clusterID string, capacity *Capacity) error {
+	_, err := cl.ModifyDBClusterWithContext(ctx, &rds.ModifyDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		ApplyImmediately:    aws.Bool(true),
+		ServerlessV2ScalingConfiguration: &rds.ServerlessV2ScalingConfiguration{
+			MinCapacity: aws.Float64(capacity.MinCapacity),
+			MaxCapacity: aws.Float64(capacity.MaxCapacity),
+		},
+	})
+
+	return err
+}
+
+// client returns the RDS client scoped to the given region. Resources synced before regions were tracked
+// store the availability zone instead, e.g. us-east-1a, for which the zone suffix is dropped.
+func (c *Client) client(region string) (RDSAPI, error) {
+	if cl, ok := c.RDS[region]; ok {
+		return cl, nil
+	}
+
+	if cl, ok := c.RDS[strings.TrimRight(region, "abcdefghijklmnopqrstuvwxyz")]; ok {
+		return cl, nil
+	}
+
+	return nil, gofrService.ErrorInvalidParam{Params: []string{"resource.Region"}}
+}
+
+// getLabels converts the tags of a DB instance to resource labels.
+func getLabels(tags []*rds.Tag) models.Labels {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	labels := make(models.Labels, len(tags))
+
+	for _, tag := range tags {
+		labels[awsStringValue(tag.Key)] = awsStringValue(tag.Value)
+	}
+
+	return labels
+}
+
 func awsStringValue(s *string) string {
 	if s == nil {
 		return ""
@@ -5,24 +5,56 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/zopdev/zopdev/api/resources/models"
+	gofrService "gofr.dev/pkg/gofr/http"
 )
 
 type mockRDS struct {
 	dbInstances []*rds.DBInstance
+	dbClusters  []*rds.DBCluster
+	modified    *rds.ModifyDBClusterInput
+	described   *rds.DescribeDBInstancesInput
+	describeErr error
 	shouldErr   bool
 }
 
-func (m *mockRDS) DescribeDBInstancesWithContext(_ aws.Context, _ *rds.DescribeDBInstancesInput,
+func (m *mockRDS) DescribeDBClustersWithContext(_ aws.Context, _ *rds.DescribeDBClustersInput,
+	_ ...request.Option) (*rds.DescribeDBClustersOutput, error) {
+	if m.shouldErr {
+		return nil, assert.AnError
+	}
+
+	return &rds.DescribeDBClustersOutput{DBClusters: m.dbClusters}, nil
+}
+
+func (m *mockRDS) ModifyDBClusterWithContext(_ aws.Context, input *rds.ModifyDBClusterInput,
+	_ ...request.Option) (*rds.ModifyDBClusterOutput, error) {
+	if m.shouldErr {
+		return nil, assert.AnError
+	}
+
+	m.modified = input
+
+	return &rds.ModifyDBClusterOutput{}, nil
+}
+
+func (m *mockRDS) DescribeDBInstancesWithContext(_ aws.Context, input *rds.DescribeDBInstancesInput,
 	_ ...request.Option) (*rds.DescribeDBInstancesOutput, error) {
 	if m.shouldErr {
 		return nil, assert.AnError
 	}
 
+	if m.describeErr != nil {
+		return nil, m.describeErr
+	}
+
+	m.described = input
+
 	return &rds.DescribeDBInstancesOutput{DBInstances: m.dbInstances}, nil
 }
 
@@ -72,6 +104,10 @@ func Test_GetAllInstances(t *testing.T) {
 				InstanceCreateTime:   aws.Time(time.Now()),
 				DBInstanceStatus:     aws.String("available"),
 				Engine:               aws.String("mysql"),
+				TagList:              []*rds.Tag{{Key: aws.String("env"), Value: aws.String("dev")}},
+				DBInstanceClass:      aws.String("db.m5.large"),
+				AllocatedStorage:     aws.Int64(100),
+				MultiAZ:              aws.Bool(true),
 			},
 			{
 				DBInstanceIdentifier: aws.String("test-rds-2"),
@@ -83,20 +119,24 @@ func Test_GetAllInstances(t *testing.T) {
 			},
 		},
 	}
-	client := &Client{RDS: mock}
+	client := &Client{RDS: map[string]RDSAPI{"us-east-1": mock}}
 	instances, err := client.GetAllInstances(nil)
 	require.NoError(t, err)
 	require.Len(t, instances, 2)
 	assert.Equal(t, "test-rds-1", instances[0].Name)
 	assert.Equal(t, "RDS", instances[0].Type)
-	assert.Equal(t, "us-east-1a", instances[0].Region)
+	assert.Equal(t, "us-east-1", instances[0].Region)
+	assert.Equal(t, "us-east-1a", instances[0].Settings["availability_zone"])
 	assert.Equal(t, RUNNING, instances[0].Status)
+	assert.Equal(t, models.Labels{"env": "dev"}, instances[0].Labels)
+	assert.Equal(t, models.Spec{StorageGB: 100, MachineClass: "db.m5.large", HighAvailability: true}, instances[0].Spec)
+	assert.Nil(t, instances[1].Labels)
 	assert.Equal(t, STOPPED, instances[1].Status)
 }
 
 func Test_GetAllInstances_Error(t *testing.T) {
 	mock := &mockRDS{shouldErr: true}
-	client := &Client{RDS: mock}
+	client := &Client{RDS: map[string]RDSAPI{"us-east-1": mock}}
 	instances, err := client.GetAllInstances(nil)
 	require.Error(t, err)
 	require.Nil(t, instances)
@@ -117,10 +157,11 @@ func Test_StartInstance(t *testing.T) {
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
 			mock := &mockRDS{shouldErr: c.shouldErr}
-			client := &Client{RDS: mock}
+			client := &Client{RDS: map[string]RDSAPI{"us-east-1": mock}}
 
 			resource := models.Resource{
-				Name: "test-instance",
+				Name:   "test-instance",
+				Region: "us-east-1",
 				Settings: map[string]any{
 					"engine":     c.engine,
 					"cluster_id": c.clusterID,
@@ -152,10 +193,11 @@ func Test_StopInstance(t *testing.T) {
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
 			mock := &mockRDS{shouldErr: c.shouldErr}
-			client := &Client{RDS: mock}
+			client := &Client{RDS: map[string]RDSAPI{"us-east-1": mock}}
 
 			resource := models.Resource{
-				Name: "test-instance",
+				Name:   "test-instance",
+				Region: "us-east-1",
 				Settings: map[string]any{
 					"engine":     c.engine,
 					"cluster_id": c.clusterID,
@@ -197,10 +239,157 @@ func Test_GetAllInstances_AllTypes(t *testing.T) {
 			DBClusterIdentifier:  aws.String(e.clusterID),
 		}
 		mock := &mockRDS{dbInstances: []*rds.DBInstance{db}}
-		client := &Client{RDS: mock}
+		client := &Client{RDS: map[string]RDSAPI{"us-east-1": mock}}
 		instances, err := client.GetAllInstances(nil)
 		require.NoError(t, err)
 		require.Len(t, instances, 1)
 		assert.Equal(t, "RDS", instances[0].Type)
 	}
 }
+
+func Test_StartInstance_UnknownRegion(t *testing.T) {
+	client := &Client{RDS: map[string]RDSAPI{"us-east-1": &mockRDS{}}}
+
+	// Rows synced before regions were tracked hold the availability zone.
+	require.NoError(t, client.StartInstance(nil, &models.Resource{Name: "db", Region: "us-east-1b"}))
+	require.Error(t, client.StartInstance(nil, &models.Resource{Name: "db", Region: "eu-west-1"}))
+	require.Error(t, client.StopInstance(nil, &models.Resource{Name: "db"}))
+}
+
+func Test_GetAllInstances_Serverless(t *testing.T) {
+	mock := &mockRDS{
+		dbInstances: []*rds.DBInstance{
+			{
+				DBInstanceIdentifier: aws.String("writer"),
+				InstanceCreateTime:   aws.Time(time.Now()),
+				DBInstanceStatus:     aws.String("available"),
+				Engine:               aws.String("aurora-postgresql"),
+				DBClusterIdentifier:  aws.String("idle"),
+				DBInstanceClass:      aws.String(ServerlessInstanceClass),
+			},
+			{
+				DBInstanceIdentifier: aws.String("reader"),
+				InstanceCreateTime:   aws.Time(time.Now()),
+				DBInstanceStatus:     aws.String("available"),
+				Engine:               aws.String("aurora-postgresql"),
+				DBClusterIdentifier:  aws.String("busy"),
+				DBInstanceClass:      aws.String(ServerlessInstanceClass),
+			},
+		},
+		dbClusters: []*rds.DBCluster{
+			{DBClusterIdentifier: aws.String("idle"), ServerlessV2ScalingConfiguration: &rds.ServerlessV2ScalingConfigurationInfo{
+				MinCapacity: aws.Float64(MinCapacity), MaxCapacity: aws.Float64(MinCapacity)}},
+			{DBClusterIdentifier: aws.String("busy"), ServerlessV2ScalingConfiguration: &rds.ServerlessV2ScalingConfigurationInfo{
+				MinCapacity: aws.Float64(2), MaxCapacity: aws.Float64(16)}},
+		},
+	}
+	client := &Client{RDS: map[string]RDSAPI{"us-east-1": mock}}
+
+	instances, err := client.GetAllInstances(nil)
+
+	require.NoError(t, err)
+	require.Len(t, instances, 2)
+
+	// The cluster scaled down to the minimum capacity is reported as stopped.
+	assert.Equal(t, STOPPED, instances[0].Status)
+	assert.Equal(t, MinCapacity, instances[0].Settings["max_capacity"])
+	assert.Equal(t, RUNNING, instances[1].Status)
+	assert.InDelta(t, 2.0, instances[1].Settings["min_capacity"], 0)
+	assert.InDelta(t, 16.0, instances[1].Settings["max_capacity"], 0)
+}
+
+func Test_ScaleDownCluster(t *testing.T) {
+	mock := &mockRDS{dbClusters: []*rds.DBCluster{
+		{DBClusterIdentifier: aws.String("dev"), ServerlessV2ScalingConfiguration: &rds.ServerlessV2ScalingConfigurationInfo{
+			MinCapacity: aws.Float64(2), MaxCapacity: aws.Float64(16)}},
+	}}
+	client := &Client{RDS: map[string]RDSAPI{"us-east-1": mock}}
+	resource := &models.Resource{Name: "writer", Region: "us-east-1",
+		Settings: map[string]any{"engine": "aurora-postgresql", "cluster_id": "dev"}}
+
+	capacity, err := client.ScaleDownCluster(nil, resource)
+
+	require.NoError(t, err)
+	assert.Equal(t, &Capacity{MinCapacity: 2, MaxCapacity: 16}, capacity)
+	assert.Equal(t, "dev", aws.StringValue(mock.modified.DBClusterIdentifier))
+	assert.True(t, aws.BoolValue(mock.modified.ApplyImmediately))
+	assert.Equal(t, &rds.ServerlessV2ScalingConfiguration{MinCapacity: aws.Float64(MinCapacity),
+		MaxCapacity: aws.Float64(MinCapacity)}, mock.modified.ServerlessV2ScalingConfiguration)
+
+	require.NoError(t, client.RestoreCluster(nil, resource, capacity))
+	assert.Equal(t, &rds.ServerlessV2ScalingConfiguration{MinCapacity: aws.Float64(2),
+		MaxCapacity: aws.Float64(16)}, mock.modified.ServerlessV2ScalingConfiguration)
+}
+
+func Test_ScaleDownCluster_Errors(t *testing.T) {
+	client := &Client{RDS: map[string]RDSAPI{"us-east-1": &mockRDS{}}}
+	resource := &models.Resource{Name: "writer", Region: "us-east-1",
+		Settings: map[string]any{"engine": "aurora-postgresql", "cluster_id": "dev"}}
+
+	// The cluster is not a serverless cluster.
+	capacity, err := client.ScaleDownCluster(nil, resource)
+
+	require.Error(t, err)
+	assert.Nil(t, capacity)
+
+	standalone := &models.Resource{Name: "db", Region: "us-east-1",
+		Settings: map[string]any{"engine": "postgres", "cluster_id": ""}}
+
+	_, err = client.ScaleDownCluster(nil, standalone)
+	require.Error(t, err)
+	require.Error(t, client.RestoreCluster(nil, standalone, &Capacity{}))
+
+	client = &Client{RDS: map[string]RDSAPI{"us-east-1": &mockRDS{shouldErr: true}}}
+
+	_, err = client.ScaleDownCluster(nil, resource)
+	require.Error(t, err)
+	require.Error(t, client.RestoreCluster(nil, resource, &Capacity{MinCapacity: 1, MaxCapacity: 4}))
+}
+
+func Test_DescribeInstance(t *testing.T) {
+	arn := "arn:aws:rds:us-east-1:123:db:writer"
+	mock := &mockRDS{
+		dbInstances: []*rds.DBInstance{{
+			DBInstanceIdentifier: aws.String("writer"),
+			DBInstanceArn:        aws.String(arn),
+			InstanceCreateTime:   aws.Time(time.Now()),
+			DBInstanceStatus:     aws.String("stopping"),
+			Engine:               aws.String("aurora-postgresql"),
+			DBClusterIdentifier:  aws.String("idle"),
+			DBInstanceClass:      aws.String(ServerlessInstanceClass),
+		}},
+		dbClusters: []*rds.DBCluster{
+			{DBClusterIdentifier: aws.String("idle"), ServerlessV2ScalingConfiguration: &rds.ServerlessV2ScalingConfigurationInfo{
+				MinCapacity: aws.Float64(MinCapacity), MaxCapacity: aws.Float64(MinCapacity)}},
+		},
+	}
+	client := &Client{RDS: map[string]RDSAPI{"us-east-1": mock}}
+
+	res, err := client.DescribeInstance(nil, &models.Resource{UID: arn, Region: "us-east-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, arn, aws.StringValue(mock.described.DBInstanceIdentifier))
+	assert.Equal(t, "writer", res.Name)
+	assert.Equal(t, STOPPING, res.Status)
+	assert.Equal(t, MinCapacity, res.Settings["max_capacity"])
+
+	// The instance was deleted.
+	mock.describeErr = awserr.New(rds.ErrCodeDBInstanceNotFoundFault, "not found", nil)
+
+	res, err = client.DescribeInstance(nil, &models.Resource{UID: arn, Region: "us-east-1"})
+
+	assert.Equal(t, gofrService.ErrorEntityNotFound{Name: "DB instance", Value: arn}, err)
+	assert.Nil(t, res)
+
+	mock.describeErr = assert.AnError
+
+	res, err = client.DescribeInstance(nil, &models.Resource{UID: arn, Region: "us-east-1"})
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, res)
+
+	res, err = client.DescribeInstance(nil, &models.Resource{UID: arn, Region: "eu-west-1"})
+
+	assert.Equal(t, gofrService.ErrorInvalidParam{Params: []string{"resource.Region"}}, err)
+	assert.Nil(t, res)
+}
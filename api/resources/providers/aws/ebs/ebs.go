@@ -0,0 +1,254 @@
+package ebs
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/regional"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+)
+
+const (
+	// ATTACHED volume state for zopdev, a volume attached to an instance or a snapshot whose source volume still exists.
+	ATTACHED = "ATTACHED"
+	// UNATTACHED volume state for zopdev, a volume attached to no instance or a snapshot whose source volume was deleted.
+	UNATTACHED = "UNATTACHED"
+
+	// Volume is the resource type used for EBS volumes.
+	Volume = "EBS_VOLUME"
+	// Snapshot is the resource type used for EBS snapshots.
+	Snapshot = "EBS_SNAPSHOT"
+)
+
+// EBSAPI defines the methods used from the AWS EC2 client for EBS volumes and snapshots for easier testing/mocking.
+type EBSAPI interface {
+	DescribeVolumesWithContext(ctx aws.Context, input *ec2.DescribeVolumesInput,
+		opts ...request.Option) (*ec2.DescribeVolumesOutput, error)
+	DescribeSnapshotsWithContext(ctx aws.Context, input *ec2.DescribeSnapshotsInput,
+		opts ...request.Option) (*ec2.DescribeSnapshotsOutput, error)
+	DeleteVolumeWithContext(ctx aws.Context, input *ec2.DeleteVolumeInput,
+		opts ...request.Option) (*ec2.DeleteVolumeOutput, error)
+	DeleteSnapshotWithContext(ctx aws.Context, input *ec2.DeleteSnapshotInput,
+		opts ...request.Option) (*ec2.DeleteSnapshotOutput, error)
+}
+
+// Client lists and deletes EBS volumes and the snapshots owned by the account across regions.
+// EC2 holds one region-scoped EC2 client per AWS region, keyed by the region name.
+type Client struct {
+	EC2 map[string]EBSAPI
+}
+
+// GetAllVolumes lists the EBS volumes of all the regions.
+func (c *Client) GetAllVolumes(ctx *gofr.Context) ([]models.Resource, error) {
+	return regional.List(c.EC2, func(cl EBSAPI, region string) ([]models.Resource, error) {
+		volumes, err := describeVolumes(ctx, cl)
+		if err != nil {
+			return nil, err
+		}
+
+		res := make([]models.Resource, 0, len(volumes))
+
+		for _, v := range volumes {
+			res = append(res, toVolumeResource(v, region))
+		}
+
+		return res, nil
+	})
+}
+
+// GetAllSnapshots lists the EBS snapshots owned by the account in all the regions. A snapshot is orphaned, reported
+// as UNATTACHED, when its source volume no longer exists.
+func (c *Client) GetAllSnapshots(ctx *gofr.Context) ([]models.Resource, error) {
+	return regional.List(c.EC2, func(cl EBSAPI, region string) ([]models.Resource, error) {
+		volumes, err := describeVolumes(ctx, cl)
+		if err != nil {
+			return nil, err
+		}
+
+		exists := make(map[string]bool, len(volumes))
+
+		for _, v := range volumes {
+			exists[aws.StringValue(v.VolumeId)] = true
+		}
+
+		res := make([]models.Resource, 0)
+		input := &ec2.DescribeSnapshotsInput{OwnerIds: aws.StringSlice([]string{"self"})}
+
+		for {
+			result, err := cl.DescribeSnapshotsWithContext(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, s := range result.Snapshots {
+				res = append(res, toSnapshotResource(s, region, exists[aws.StringValue(s.VolumeId)]))
+			}
+
+			if aws.StringValue(result.NextToken) == "" {
+				return res, nil
+			}
+
+			input.NextToken = result.NextToken
+		}
+	})
+}
+
+// describeVolumes describes the volumes of a region, following the pagination tokens.
+func describeVolumes(ctx *gofr.Context, cl EBSAPI) ([]*ec2.Volume, error) {
+	var volumes []*ec2.Volume
+
+	input := &ec2.DescribeVolumesInput{}
+
+	for {
+		result, err := cl.DescribeVolumesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		volumes = append(volumes, result.Volumes...)
+
+		if aws.StringValue(result.NextToken) == "" {
+			return volumes, nil
+		}
+
+		input.NextToken = result.NextToken
+	}
+}
+
+func toVolumeResource(v *ec2.Volume, region string) models.Resource {
+	name, labels := getLabels(v.Tags)
+	id := aws.StringValue(v.VolumeId)
+
+	if name == "" {
+		name = id
+	}
+
+	var creationTime string
+	if v.CreateTime != nil {
+		creationTime = v.CreateTime.Format(time.RFC3339)
+	}
+
+	return models.Resource{
+		Name:         name,
+		Type:         Volume,
+		UID:          id,
+		Region:       region,
+		CreationTime: creationTime,
+		Status:       getVolumeState(aws.StringValue(v.State)),
+		Labels:       labels,
+		Spec:         models.Spec{StorageGB: aws.Int64Value(v.Size), MachineClass: aws.StringValue(v.VolumeType)},
+		Settings: map[string]any{
+			"availability_zone": aws.StringValue(v.AvailabilityZone),
+			"volume_type":       aws.StringValue(v.VolumeType),
+		},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+func toSnapshotResource(s *ec2.Snapshot, region string, sourceExists bool) models.Resource {
+	name, labels := getLabels(s.Tags)
+	id := aws.StringValue(s.SnapshotId)
+
+	if name == "" {
+		name = id
+	}
+
+	var creationTime string
+	if s.StartTime != nil {
+		creationTime = s.StartTime.Format(time.RFC3339)
+	}
+
+	status := UNATTACHED
+	if sourceExists {
+		status = ATTACHED
+	}
+
+	return models.Resource{
+		Name:         name,
+		Type:         Snapshot,
+		UID:          id,
+		Region:       region,
+		CreationTime: creationTime,
+		Status:       status,
+		Labels:       labels,
+		Spec:         models.Spec{StorageGB: aws.Int64Value(s.VolumeSize)},
+		Settings: map[string]any{
+			"volume_id":   aws.StringValue(s.VolumeId),
+			"description": aws.StringValue(s.Description),
+		},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// DeleteVolume deletes an EBS volume, EBS rejects the deletion of a volume attached to an instance.
+func (c *Client) DeleteVolume(ctx *gofr.Context, region, volumeID string) error {
+	cl, err := c.client(region)
+	if err != nil {
+		return err
+	}
+
+	_, err = cl.DeleteVolumeWithContext(ctx, &ec2.DeleteVolumeInput{VolumeId: aws.String(volumeID)})
+
+	return err
+}
+
+// DeleteSnapshot deletes an EBS snapshot, EBS rejects the deletion of a snapshot used by an AMI.
+func (c *Client) DeleteSnapshot(ctx *gofr.Context, region, snapshotID string) error {
+	cl, err := c.client(region)
+	if err != nil {
+		return err
+	}
+
+	_, err = cl.DeleteSnapshotWithContext(ctx, &ec2.DeleteSnapshotInput{SnapshotId: aws.String(snapshotID)})
+
+	return err
+}
+
+// client returns the EC2 client scoped to the given region.
+func (c *Client) client(region string) (EBSAPI, error) {
+	cl, ok := c.EC2[region]
+	if !ok {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Region"}}
+	}
+
+	return cl, nil
+}
+
+// getLabels returns the labels from the tags of a resource and its name from the Name tag.
+func getLabels(tags []*ec2.Tag) (string, models.Labels) {
+	if len(tags) == 0 {
+		return "", nil
+	}
+
+	var name string
+
+	labels := make(models.Labels, len(tags))
+
+	for _, tag := range tags {
+		labels[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+
+		if aws.StringValue(tag.Key) == "Name" {
+			name = aws.StringValue(tag.Value)
+		}
+	}
+
+	return name, labels
+}
+
+// getVolumeState maps the volume state to ATTACHED or UNATTACHED, other states are kept as is.
+func getVolumeState(state string) string {
+	switch state {
+	case ec2.VolumeStateInUse:
+		return ATTACHED
+	case ec2.VolumeStateAvailable:
+		return UNATTACHED
+	default:
+		return state
+	}
+}
@@ -0,0 +1,155 @@
+package ebs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+var errFail = errors.New("fail")
+
+type mockEBS struct {
+	Volumes   []*ec2.Volume
+	Snapshots []*ec2.Snapshot
+	ListErr   error
+	DeleteErr error
+	Deleted   []string
+}
+
+func (m *mockEBS) DescribeVolumesWithContext(_ aws.Context, _ *ec2.DescribeVolumesInput,
+	_ ...request.Option) (*ec2.DescribeVolumesOutput, error) {
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+
+	return &ec2.DescribeVolumesOutput{Volumes: m.Volumes}, nil
+}
+
+func (m *mockEBS) DescribeSnapshotsWithContext(_ aws.Context, input *ec2.DescribeSnapshotsInput,
+	_ ...request.Option) (*ec2.DescribeSnapshotsOutput, error) {
+	// Only the snapshots owned by the account are listed, not the public ones.
+	if aws.StringValueSlice(input.OwnerIds)[0] != "self" {
+		return nil, errFail
+	}
+
+	return &ec2.DescribeSnapshotsOutput{Snapshots: m.Snapshots}, nil
+}
+
+func (m *mockEBS) DeleteVolumeWithContext(_ aws.Context, input *ec2.DeleteVolumeInput,
+	_ ...request.Option) (*ec2.DeleteVolumeOutput, error) {
+	m.Deleted = append(m.Deleted, aws.StringValue(input.VolumeId))
+
+	return &ec2.DeleteVolumeOutput{}, m.DeleteErr
+}
+
+func (m *mockEBS) DeleteSnapshotWithContext(_ aws.Context, input *ec2.DeleteSnapshotInput,
+	_ ...request.Option) (*ec2.DeleteSnapshotOutput, error) {
+	m.Deleted = append(m.Deleted, aws.StringValue(input.SnapshotId))
+
+	return &ec2.DeleteSnapshotOutput{}, m.DeleteErr
+}
+
+func newMock() *mockEBS {
+	created := aws.Time(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+
+	return &mockEBS{
+		Volumes: []*ec2.Volume{
+			{VolumeId: aws.String("vol-1"), State: aws.String(ec2.VolumeStateInUse), Size: aws.Int64(100),
+				VolumeType: aws.String("gp3"), AvailabilityZone: aws.String("us-east-1a"), CreateTime: created,
+				Tags: []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("data")}}},
+			{VolumeId: aws.String("vol-2"), State: aws.String(ec2.VolumeStateAvailable), Size: aws.Int64(20),
+				VolumeType: aws.String("gp2"), AvailabilityZone: aws.String("us-east-1b")},
+		},
+		Snapshots: []*ec2.Snapshot{
+			{SnapshotId: aws.String("snap-1"), VolumeId: aws.String("vol-1"), VolumeSize: aws.Int64(100),
+				StartTime: created},
+			{SnapshotId: aws.String("snap-2"), VolumeId: aws.String("vol-9"), VolumeSize: aws.Int64(8),
+				Description: aws.String("old")},
+		},
+	}
+}
+
+func Test_GetAllVolumes(t *testing.T) {
+	client := &Client{EC2: map[string]EBSAPI{
+		"us-east-1":  newMock(),
+		"af-south-1": &mockEBS{ListErr: awserr.New("OptInRequired", "region not enabled", nil)},
+	}}
+
+	volumes, err := client.GetAllVolumes(nil)
+
+	require.NoError(t, err)
+	require.Len(t, volumes, 2)
+
+	for i := range volumes {
+		volumes[i].CreatedAt, volumes[i].UpdatedAt = time.Time{}, time.Time{}
+	}
+
+	assert.Equal(t, []models.Resource{
+		{Name: "data", Type: Volume, UID: "vol-1", Region: "us-east-1", CreationTime: "2025-06-01T10:00:00Z",
+			Status: ATTACHED, Labels: models.Labels{"Name": "data"}, Spec: models.Spec{StorageGB: 100, MachineClass: "gp3"},
+			Settings: map[string]any{"availability_zone": "us-east-1a", "volume_type": "gp3"}},
+		{Name: "vol-2", Type: Volume, UID: "vol-2", Region: "us-east-1", Status: UNATTACHED,
+			Spec:     models.Spec{StorageGB: 20, MachineClass: "gp2"},
+			Settings: map[string]any{"availability_zone": "us-east-1b", "volume_type": "gp2"}},
+	}, volumes)
+}
+
+func Test_GetAllSnapshots(t *testing.T) {
+	client := &Client{EC2: map[string]EBSAPI{"us-east-1": newMock()}}
+
+	snapshots, err := client.GetAllSnapshots(nil)
+
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+
+	for i := range snapshots {
+		snapshots[i].CreatedAt, snapshots[i].UpdatedAt = time.Time{}, time.Time{}
+	}
+
+	assert.Equal(t, []models.Resource{
+		{Name: "snap-1", Type: Snapshot, UID: "snap-1", Region: "us-east-1", CreationTime: "2025-06-01T10:00:00Z",
+			Status: ATTACHED, Spec: models.Spec{StorageGB: 100},
+			Settings: map[string]any{"volume_id": "vol-1", "description": ""}},
+		{Name: "snap-2", Type: Snapshot, UID: "snap-2", Region: "us-east-1", Status: UNATTACHED,
+			Spec: models.Spec{StorageGB: 8}, Settings: map[string]any{"volume_id": "vol-9", "description": "old"}},
+	}, snapshots)
+}
+
+func Test_GetAll_Error(t *testing.T) {
+	client := &Client{EC2: map[string]EBSAPI{"us-east-1": &mockEBS{ListErr: errFail}, "eu-west-1": newMock()}}
+
+	volumes, err := client.GetAllVolumes(nil)
+
+	require.ErrorIs(t, err, errFail)
+	assert.Nil(t, volumes)
+
+	snapshots, err := client.GetAllSnapshots(nil)
+
+	require.ErrorIs(t, err, errFail)
+	assert.Nil(t, snapshots)
+}
+
+func Test_Delete(t *testing.T) {
+	m := &mockEBS{}
+	client := &Client{EC2: map[string]EBSAPI{"us-east-1": m}}
+
+	require.NoError(t, client.DeleteVolume(nil, "us-east-1", "vol-2"))
+	require.NoError(t, client.DeleteSnapshot(nil, "us-east-1", "snap-2"))
+	assert.Equal(t, []string{"vol-2", "snap-2"}, m.Deleted)
+
+	require.Error(t, client.DeleteVolume(nil, "mars-east-1", "vol-2"))
+	require.Error(t, client.DeleteSnapshot(nil, "mars-east-1", "snap-2"))
+
+	m.DeleteErr = errFail
+
+	require.ErrorIs(t, client.DeleteVolume(nil, "us-east-1", "vol-1"), errFail)
+}
@@ -0,0 +1,248 @@
+package ecsservice
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/regional"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+)
+
+const (
+	// RUNNING service state for zopdev.
+	RUNNING = "RUNNING"
+	// STOPPED service state for zopdev, a service scaled to zero tasks.
+	STOPPED = "STOPPED"
+
+	// Service is the resource type used for ECS services.
+	Service = "ECS_SERVICE"
+
+	// describeBatchSize is the maximum number of services ECS describes in a single call.
+	describeBatchSize = 10
+)
+
+// ECSAPI defines the methods used from the AWS ECS client for easier testing/mocking.
+type ECSAPI interface {
+	ListClustersWithContext(ctx aws.Context, input *ecs.ListClustersInput,
+		opts ...request.Option) (*ecs.ListClustersOutput, error)
+	ListServicesWithContext(ctx aws.Context, input *ecs.ListServicesInput,
+		opts ...request.Option) (*ecs.ListServicesOutput, error)
+	DescribeServicesWithContext(ctx aws.Context, input *ecs.DescribeServicesInput,
+		opts ...request.Option) (*ecs.DescribeServicesOutput, error)
+	UpdateServiceWithContext(ctx aws.Context, input *ecs.UpdateServiceInput,
+		opts ...request.Option) (*ecs.UpdateServiceOutput, error)
+}
+
+// Size is the desired number of tasks of a service, recorded when the service is scaled to zero and restored
+// when it is started again.
+type Size struct {
+	DesiredCount int64 `json:"desired_count"`
+}
+
+// Client lists and scales ECS services across regions.
+// ECS holds one region-scoped ECS client per AWS region, keyed by the region name.
+type Client struct {
+	ECS map[string]ECSAPI
+}
+
+// GetAllServices lists the services of the ECS clusters of all the regions.
+func (c *Client) GetAllServices(ctx *gofr.Context) ([]models.Resource, error) {
+	return regional.List(c.ECS, func(cl ECSAPI, region string) ([]models.Resource, error) {
+		return getRegionServices(ctx, cl, region)
+	})
+}
+
+// getRegionServices lists the services of all the clusters of a single region, following the pagination tokens.
+func getRegionServices(ctx *gofr.Context, cl ECSAPI, region string) ([]models.Resource, error) {
+	services := make([]models.Resource, 0)
+	input := &ecs.ListClustersInput{}
+
+	for {
+		result, err := cl.ListClustersWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cluster := range result.ClusterArns {
+			svcs, err := getClusterServices(ctx, cl, region, aws.StringValue(cluster))
+			if err != nil {
+				return nil, err
+			}
+
+			services = append(services, svcs...)
+		}
+
+		if aws.StringValue(result.NextToken) == "" {
+			return services, nil
+		}
+
+		input.NextToken = result.NextToken
+	}
+}
+
+// getClusterServices describes the services of a cluster, following the pagination tokens.
+func getClusterServices(ctx *gofr.Context, cl ECSAPI, region, cluster string) ([]models.Resource, error) {
+	services := make([]models.Resource, 0)
+	input := &ecs.ListServicesInput{Cluster: aws.String(cluster)}
+
+	for {
+		result, err := cl.ListServicesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		// ECS describes at most ten services per call.
+		for start := 0; start < len(result.ServiceArns); start += describeBatchSize {
+			end := min(start+describeBatchSize, len(result.ServiceArns))
+
+			svcs, err := describe(ctx, cl, cluster, result.ServiceArns[start:end])
+			if err != nil {
+				return nil, err
+			}
+
+			for _, svc := range svcs {
+				services = append(services, toResource(svc, region))
+			}
+		}
+
+		if aws.StringValue(result.NextToken) == "" {
+			return services, nil
+		}
+
+		input.NextToken = result.NextToken
+	}
+}
+
+func toResource(svc *ecs.Service, region string) models.Resource {
+	var (
+		creationTime string
+		labels       models.Labels
+	)
+
+	if svc.CreatedAt != nil {
+		creationTime = svc.CreatedAt.Format(time.RFC3339)
+	}
+
+	if len(svc.Tags) > 0 {
+		labels = make(models.Labels, len(svc.Tags))
+
+		for _, tag := range svc.Tags {
+			labels[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+
+	cluster := clusterName(aws.StringValue(svc.ClusterArn))
+	name := aws.StringValue(svc.ServiceName)
+	desiredCount := aws.Int64Value(svc.DesiredCount)
+
+	return models.Resource{
+		Name:         cluster + "/" + name,
+		Type:         Service,
+		UID:          aws.StringValue(svc.ServiceArn),
+		Region:       region,
+		CreationTime: creationTime,
+		Status:       getState(aws.StringValue(svc.Status), desiredCount),
+		Labels:       labels,
+		Spec:         models.Spec{MachineClass: aws.StringValue(svc.LaunchType)},
+		Settings: map[string]any{
+			"cluster":       cluster,
+			"service":       name,
+			"desired_count": desiredCount,
+		},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// StopService scales a service to zero tasks by setting its desired count to zero. The desired count of the service
+// before it was scaled down is returned.
+func (c *Client) StopService(ctx *gofr.Context, region, cluster, name string) (*Size, error) {
+	cl, err := c.client(region)
+	if err != nil {
+		return nil, err
+	}
+
+	svcs, err := describe(ctx, cl, cluster, []*string{aws.String(name)})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(svcs) == 0 {
+		return nil, gofrHttp.ErrorEntityNotFound{Name: "service", Value: name}
+	}
+
+	size := Size{DesiredCount: aws.Int64Value(svcs[0].DesiredCount)}
+
+	err = update(ctx, cl, cluster, name, &Size{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &size, nil
+}
+
+// StartService restores the desired count of a service that was scaled to zero.
+func (c *Client) StartService(ctx *gofr.Context, region, cluster, name string, size *Size) error {
+	cl, err := c.client(region)
+	if err != nil {
+		return err
+	}
+
+	return update(ctx, cl, cluster, name, size)
+}
+
+func describe(ctx *gofr.Context, cl ECSAPI, cluster string, names []*string) ([]*ecs.Service, error) {
+	result, err := cl.DescribeServicesWithContext(ctx, &ecs.DescribeServicesInput{
+		Cluster:  aws.String(cluster),
+		Services: names,
+		Include:  aws.StringSlice([]string{ecs.ServiceFieldTags}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Services, nil
+}
+
+func update(ctx *gofr.Context, cl ECSAPI, cluster, name string, size *Size) error {
+	_, err := cl.UpdateServiceWithContext(ctx, &ecs.UpdateServiceInput{
+		Cluster:      aws.String(cluster),
+		Service:      aws.String(name),
+		DesiredCount: aws.Int64(size.DesiredCount),
+	})
+
+	return err
+}
+
+// client returns the ECS client scoped to the given region.
+func (c *Client) client(region string) (ECSAPI, error) {
+	cl, ok := c.ECS[region]
+	if !ok {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Region"}}
+	}
+
+	return cl, nil
+}
+
+// clusterName returns the name of a cluster from its ARN, arn:aws:ecs:<region>:<account>:cluster/<name>.
+func clusterName(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+// getState maps the service status to RUNNING or STOPPED by its desired count, other statuses are kept as is.
+func getState(status string, desiredCount int64) string {
+	if status != "ACTIVE" {
+		return status
+	}
+
+	if desiredCount == 0 {
+		return STOPPED
+	}
+
+	return RUNNING
+}
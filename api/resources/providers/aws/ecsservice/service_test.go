@@ -0,0 +1,184 @@
+package ecsservice
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+var errFail = errors.New("fail")
+
+type mockECS struct {
+	Clusters      []string
+	Services      map[string]*ecs.Service
+	ListErr       error
+	DescribeErr   error
+	UpdateErr     error
+	Described     [][]string
+	DesiredCounts []int64
+}
+
+func (m *mockECS) ListClustersWithContext(_ aws.Context, _ *ecs.ListClustersInput,
+	_ ...request.Option) (*ecs.ListClustersOutput, error) {
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+
+	return &ecs.ListClustersOutput{ClusterArns: aws.StringSlice(m.Clusters)}, nil
+}
+
+func (m *mockECS) ListServicesWithContext(_ aws.Context, input *ecs.ListServicesInput,
+	_ ...request.Option) (*ecs.ListServicesOutput, error) {
+	var arns []*string
+
+	for _, svc := range m.Services {
+		if aws.StringValue(svc.ClusterArn) == aws.StringValue(input.Cluster) {
+			arns = append(arns, svc.ServiceName)
+		}
+	}
+
+	return &ecs.ListServicesOutput{ServiceArns: arns}, nil
+}
+
+func (m *mockECS) DescribeServicesWithContext(_ aws.Context, input *ecs.DescribeServicesInput,
+	_ ...request.Option) (*ecs.DescribeServicesOutput, error) {
+	if m.DescribeErr != nil {
+		return nil, m.DescribeErr
+	}
+
+	m.Described = append(m.Described, aws.StringValueSlice(input.Services))
+
+	var services []*ecs.Service
+
+	for _, name := range input.Services {
+		if svc, ok := m.Services[aws.StringValue(name)]; ok {
+			services = append(services, svc)
+		}
+	}
+
+	return &ecs.DescribeServicesOutput{Services: services}, nil
+}
+
+func (m *mockECS) UpdateServiceWithContext(_ aws.Context, input *ecs.UpdateServiceInput,
+	_ ...request.Option) (*ecs.UpdateServiceOutput, error) {
+	m.DesiredCounts = append(m.DesiredCounts, aws.Int64Value(input.DesiredCount))
+
+	return &ecs.UpdateServiceOutput{}, m.UpdateErr
+}
+
+func newService(name string) *ecs.Service {
+	return &ecs.Service{
+		ClusterArn:   aws.String("arn:aws:ecs:us-east-1:123:cluster/dev"),
+		ServiceName:  aws.String(name),
+		ServiceArn:   aws.String("arn:aws:ecs:us-east-1:123:service/dev/" + name),
+		Status:       aws.String("ACTIVE"),
+		DesiredCount: aws.Int64(2),
+		LaunchType:   aws.String(ecs.LaunchTypeFargate),
+		CreatedAt:    aws.Time(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)),
+		Tags:         []*ecs.Tag{{Key: aws.String("env"), Value: aws.String("dev")}},
+	}
+}
+
+func Test_GetAllServices(t *testing.T) {
+	client := &Client{ECS: map[string]ECSAPI{
+		"us-east-1": &mockECS{Clusters: []string{"arn:aws:ecs:us-east-1:123:cluster/dev"},
+			Services: map[string]*ecs.Service{"api": newService("api")}},
+		"af-south-1": &mockECS{ListErr: awserr.New("UnrecognizedClientException", "region not enabled", nil)},
+	}}
+
+	services, err := client.GetAllServices(nil)
+
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+
+	services[0].CreatedAt, services[0].UpdatedAt = time.Time{}, time.Time{}
+
+	assert.Equal(t, models.Resource{
+		Name: "dev/api", Type: Service, UID: "arn:aws:ecs:us-east-1:123:service/dev/api", Region: "us-east-1",
+		CreationTime: "2025-06-01T10:00:00Z", Status: RUNNING, Labels: models.Labels{"env": "dev"},
+		Spec:     models.Spec{MachineClass: "FARGATE"},
+		Settings: map[string]any{"cluster": "dev", "service": "api", "desired_count": int64(2)},
+	}, services[0])
+}
+
+func Test_GetAllServices_Batches(t *testing.T) {
+	m := &mockECS{Clusters: []string{"arn:aws:ecs:us-east-1:123:cluster/dev"}, Services: map[string]*ecs.Service{}}
+
+	for i := 0; i < 12; i++ {
+		name := fmt.Sprintf("svc-%d", i)
+		m.Services[name] = newService(name)
+	}
+
+	client := &Client{ECS: map[string]ECSAPI{"us-east-1": m}}
+
+	services, err := client.GetAllServices(nil)
+
+	require.NoError(t, err)
+	assert.Len(t, services, 12)
+
+	// The services are described ten at a time.
+	require.Len(t, m.Described, 2)
+	assert.Len(t, m.Described[0], 10)
+	assert.Len(t, m.Described[1], 2)
+}
+
+func Test_GetAllServices_Error(t *testing.T) {
+	client := &Client{ECS: map[string]ECSAPI{
+		"us-east-1": &mockECS{Clusters: []string{"arn:aws:ecs:us-east-1:123:cluster/dev"},
+			Services: map[string]*ecs.Service{"api": newService("api")}, DescribeErr: errFail},
+		"eu-west-1": &mockECS{},
+	}}
+
+	services, err := client.GetAllServices(nil)
+
+	require.ErrorIs(t, err, errFail)
+	assert.Nil(t, services)
+}
+
+func Test_StopStartService(t *testing.T) {
+	m := &mockECS{Services: map[string]*ecs.Service{"api": newService("api")}}
+	client := &Client{ECS: map[string]ECSAPI{"us-east-1": m}}
+
+	size, err := client.StopService(nil, "us-east-1", "dev", "api")
+
+	require.NoError(t, err)
+	assert.Equal(t, &Size{DesiredCount: 2}, size)
+	require.NoError(t, client.StartService(nil, "us-east-1", "dev", "api", size))
+	assert.Equal(t, []int64{0, 2}, m.DesiredCounts)
+}
+
+func Test_StopService_Errors(t *testing.T) {
+	client := &Client{ECS: map[string]ECSAPI{"us-east-1": &mockECS{}}}
+
+	_, err := client.StopService(nil, "mars-east-1", "dev", "api")
+	require.Error(t, err)
+
+	// The service does not exist.
+	_, err = client.StopService(nil, "us-east-1", "dev", "api")
+	require.Error(t, err)
+
+	client = &Client{ECS: map[string]ECSAPI{"us-east-1": &mockECS{
+		Services: map[string]*ecs.Service{"api": newService("api")}, UpdateErr: errFail}}}
+
+	size, err := client.StopService(nil, "us-east-1", "dev", "api")
+
+	require.ErrorIs(t, err, errFail)
+	assert.Nil(t, size)
+	require.Error(t, client.StartService(nil, "mars-east-1", "dev", "api", &Size{}))
+}
+
+func Test_getState(t *testing.T) {
+	assert.Equal(t, RUNNING, getState("ACTIVE", 1))
+	assert.Equal(t, STOPPED, getState("ACTIVE", 0))
+	assert.Equal(t, "DRAINING", getState("DRAINING", 1))
+}
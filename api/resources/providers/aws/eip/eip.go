@@ -0,0 +1,108 @@
+package eip
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/regional"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+)
+
+const (
+	// ATTACHED address state for zopdev, an Elastic IP associated with an instance or a network interface.
+	ATTACHED = "ATTACHED"
+	// UNATTACHED address state for zopdev, an Elastic IP that is allocated but not associated.
+	UNATTACHED = "UNATTACHED"
+
+	// Address is the resource type used for Elastic IP addresses.
+	Address = "ELASTIC_IP"
+)
+
+// EIPAPI defines the methods used from the AWS EC2 client for Elastic IPs for easier testing/mocking.
+type EIPAPI interface {
+	DescribeAddressesWithContext(ctx aws.Context, input *ec2.DescribeAddressesInput,
+		opts ...request.Option) (*ec2.DescribeAddressesOutput, error)
+	ReleaseAddressWithContext(ctx aws.Context, input *ec2.ReleaseAddressInput,
+		opts ...request.Option) (*ec2.ReleaseAddressOutput, error)
+}
+
+// Client lists and releases Elastic IP addresses across regions.
+// EC2 holds one region-scoped EC2 client per AWS region, keyed by the region name.
+type Client struct {
+	EC2 map[string]EIPAPI
+}
+
+// GetAllAddresses lists the Elastic IP addresses of all the regions.
+func (c *Client) GetAllAddresses(ctx *gofr.Context) ([]models.Resource, error) {
+	return regional.List(c.EC2, func(cl EIPAPI, region string) ([]models.Resource, error) {
+		return getRegionAddresses(ctx, cl, region)
+	})
+}
+
+// getRegionAddresses lists the Elastic IP addresses of a single region, the addresses are not paginated.
+func getRegionAddresses(ctx *gofr.Context, cl EIPAPI, region string) ([]models.Resource, error) {
+	result, err := cl.DescribeAddressesWithContext(ctx, &ec2.DescribeAddressesInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]models.Resource, 0, len(result.Addresses))
+
+	for _, a := range result.Addresses {
+		addresses = append(addresses, toResource(a, region))
+	}
+
+	return addresses, nil
+}
+
+func toResource(a *ec2.Address, region string) models.Resource {
+	var labels models.Labels
+
+	ip := aws.StringValue(a.PublicIp)
+	name := ip
+
+	for _, tag := range a.Tags {
+		if labels == nil {
+			labels = make(models.Labels, len(a.Tags))
+		}
+
+		labels[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+
+		if aws.StringValue(tag.Key) == "Name" {
+			name = aws.StringValue(tag.Value)
+		}
+	}
+
+	status := UNATTACHED
+	if aws.StringValue(a.AssociationId) != "" {
+		status = ATTACHED
+	}
+
+	return models.Resource{
+		Name:      name,
+		Type:      Address,
+		UID:       aws.StringValue(a.AllocationId),
+		Region:    region,
+		Status:    status,
+		Labels:    labels,
+		Settings:  map[string]any{"address": ip, "instance_id": aws.StringValue(a.InstanceId)},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// ReleaseAddress releases an Elastic IP address, EC2 rejects the release of an associated address.
+func (c *Client) ReleaseAddress(ctx *gofr.Context, region, allocationID string) error {
+	cl, ok := c.EC2[region]
+	if !ok {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"resource.Region"}}
+	}
+
+	_, err := cl.ReleaseAddressWithContext(ctx, &ec2.ReleaseAddressInput{AllocationId: aws.String(allocationID)})
+
+	return err
+}
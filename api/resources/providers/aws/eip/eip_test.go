@@ -0,0 +1,90 @@
+package eip
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+var errFail = errors.New("fail")
+
+type mockEIP struct {
+	Addresses  []*ec2.Address
+	ListErr    error
+	ReleaseErr error
+	Released   []string
+}
+
+func (m *mockEIP) DescribeAddressesWithContext(_ aws.Context, _ *ec2.DescribeAddressesInput,
+	_ ...request.Option) (*ec2.DescribeAddressesOutput, error) {
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+
+	return &ec2.DescribeAddressesOutput{Addresses: m.Addresses}, nil
+}
+
+func (m *mockEIP) ReleaseAddressWithContext(_ aws.Context, input *ec2.ReleaseAddressInput,
+	_ ...request.Option) (*ec2.ReleaseAddressOutput, error) {
+	m.Released = append(m.Released, aws.StringValue(input.AllocationId))
+
+	return &ec2.ReleaseAddressOutput{}, m.ReleaseErr
+}
+
+func Test_GetAllAddresses(t *testing.T) {
+	client := &Client{EC2: map[string]EIPAPI{
+		"us-east-1": &mockEIP{Addresses: []*ec2.Address{
+			{AllocationId: aws.String("eipalloc-1"), PublicIp: aws.String("3.1.2.3"), AssociationId: aws.String("eipassoc-1"),
+				InstanceId: aws.String("i-1"), Tags: []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("nat")}}},
+			{AllocationId: aws.String("eipalloc-2"), PublicIp: aws.String("3.1.2.4")},
+		}},
+		"af-south-1": &mockEIP{ListErr: awserr.New("OptInRequired", "region not enabled", nil)},
+	}}
+
+	addresses, err := client.GetAllAddresses(nil)
+
+	require.NoError(t, err)
+	require.Len(t, addresses, 2)
+
+	for i := range addresses {
+		addresses[i].CreatedAt, addresses[i].UpdatedAt = time.Time{}, time.Time{}
+	}
+
+	assert.Equal(t, []models.Resource{
+		{Name: "nat", Type: Address, UID: "eipalloc-1", Region: "us-east-1", Status: ATTACHED,
+			Labels: models.Labels{"Name": "nat"}, Settings: map[string]any{"address": "3.1.2.3", "instance_id": "i-1"}},
+		{Name: "3.1.2.4", Type: Address, UID: "eipalloc-2", Region: "us-east-1", Status: UNATTACHED,
+			Settings: map[string]any{"address": "3.1.2.4", "instance_id": ""}},
+	}, addresses)
+}
+
+func Test_GetAllAddresses_Error(t *testing.T) {
+	client := &Client{EC2: map[string]EIPAPI{"us-east-1": &mockEIP{ListErr: errFail}, "eu-west-1": &mockEIP{}}}
+
+	addresses, err := client.GetAllAddresses(nil)
+
+	require.ErrorIs(t, err, errFail)
+	assert.Nil(t, addresses)
+}
+
+func Test_ReleaseAddress(t *testing.T) {
+	m := &mockEIP{}
+	client := &Client{EC2: map[string]EIPAPI{"us-east-1": m}}
+
+	require.NoError(t, client.ReleaseAddress(nil, "us-east-1", "eipalloc-2"))
+	assert.Equal(t, []string{"eipalloc-2"}, m.Released)
+	require.Error(t, client.ReleaseAddress(nil, "mars-east-1", "eipalloc-2"))
+
+	m.ReleaseErr = errFail
+
+	require.ErrorIs(t, client.ReleaseAddress(nil, "us-east-1", "eipalloc-1"), errFail)
+}
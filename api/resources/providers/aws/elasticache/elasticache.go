@@ -0,0 +1,213 @@
+package elasticache
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/regional"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+)
+
+const (
+	// AVAILABLE cache state for zopdev. It is not reported as RUNNING since the caches are not started or stopped.
+	AVAILABLE = "AVAILABLE"
+
+	// Cache is the resource type used for ElastiCache replication groups and standalone cache clusters.
+	Cache = "ELASTICACHE"
+
+	available        = "available"
+	failoverEnabled  = "enabled"
+	namespace        = "AWS/ElastiCache"
+	connectionMetric = "CurrConnections"
+	clusterDimension = "CacheClusterId"
+)
+
+// ErrNoDatapoints is returned when a cache cluster reported no connection metric over the window, its usage is unknown.
+var ErrNoDatapoints = errors.New("no connection datapoints reported over the window")
+
+// ElastiCacheAPI defines the methods used from the AWS ElastiCache client for easier testing/mocking.
+type ElastiCacheAPI interface {
+	DescribeReplicationGroupsWithContext(ctx aws.Context, input *elasticache.DescribeReplicationGroupsInput,
+		opts ...request.Option) (*elasticache.DescribeReplicationGroupsOutput, error)
+	DescribeCacheClustersWithContext(ctx aws.Context, input *elasticache.DescribeCacheClustersInput,
+		opts ...request.Option) (*elasticache.DescribeCacheClustersOutput, error)
+}
+
+// CloudWatchAPI defines the methods used from the AWS CloudWatch client for easier testing/mocking.
+type CloudWatchAPI interface {
+	GetMetricStatisticsWithContext(ctx aws.Context, input *cloudwatch.GetMetricStatisticsInput,
+		opts ...request.Option) (*cloudwatch.GetMetricStatisticsOutput, error)
+}
+
+// Client lists the ElastiCache caches across regions and reads their connection metrics.
+// ElastiCache and CloudWatch hold one region-scoped client per AWS region, keyed by the region name.
+type Client struct {
+	ElastiCache map[string]ElastiCacheAPI
+	CloudWatch  map[string]CloudWatchAPI
+}
+
+// GetAllInstances lists the caches of all the regions. A replication group is listed as one cache with its member
+// clusters as nodes, a cache cluster is only listed on its own when it is not a member of a replication group.
+func (c *Client) GetAllInstances(ctx *gofr.Context) ([]models.Resource, error) {
+	return regional.List(c.ElastiCache, func(cl ElastiCacheAPI, region string) ([]models.Resource, error) {
+		return getRegionCaches(ctx, cl, region)
+	})
+}
+
+func getRegionCaches(ctx *gofr.Context, cl ElastiCacheAPI, region string) ([]models.Resource, error) {
+	res := make([]models.Resource, 0)
+	groupsInput := &elasticache.DescribeReplicationGroupsInput{}
+
+	for {
+		result, err := cl.DescribeReplicationGroupsWithContext(ctx, groupsInput)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, g := range result.ReplicationGroups {
+			res = append(res, toGroupResource(g, region))
+		}
+
+		if aws.StringValue(result.Marker) == "" {
+			break
+		}
+
+		groupsInput.Marker = result.Marker
+	}
+
+	clustersInput := &elasticache.DescribeCacheClustersInput{}
+
+	for {
+		result, err := cl.DescribeCacheClustersWithContext(ctx, clustersInput)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cc := range result.CacheClusters {
+			if aws.StringValue(cc.ReplicationGroupId) != "" {
+				continue
+			}
+
+			res = append(res, toClusterResource(cc, region))
+		}
+
+		if aws.StringValue(result.Marker) == "" {
+			return res, nil
+		}
+
+		clustersInput.Marker = result.Marker
+	}
+}
+
+func toGroupResource(g *elasticache.ReplicationGroup, region string) models.Resource {
+	var creationTime string
+	if g.ReplicationGroupCreateTime != nil {
+		creationTime = g.ReplicationGroupCreateTime.Format(time.RFC3339)
+	}
+
+	members := aws.StringValueSlice(g.MemberClusters)
+
+	return models.Resource{
+		Name:         aws.StringValue(g.ReplicationGroupId),
+		Type:         Cache,
+		UID:          aws.StringValue(g.ARN),
+		Region:       region,
+		CreationTime: creationTime,
+		Status:       getStatus(aws.StringValue(g.Status)),
+		Spec: models.Spec{
+			MachineClass:     aws.StringValue(g.CacheNodeType),
+			HighAvailability: aws.StringValue(g.AutomaticFailover) == failoverEnabled,
+		},
+		Settings: map[string]any{
+			"node_type":       aws.StringValue(g.CacheNodeType),
+			"node_count":      len(members),
+			"shards":          len(g.NodeGroups),
+			"member_clusters": members,
+		},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+func toClusterResource(cc *elasticache.CacheCluster, region string) models.Resource {
+	var creationTime string
+	if cc.CacheClusterCreateTime != nil {
+		creationTime = cc.CacheClusterCreateTime.Format(time.RFC3339)
+	}
+
+	id := aws.StringValue(cc.CacheClusterId)
+
+	return models.Resource{
+		Name:         id,
+		Type:         Cache,
+		UID:          aws.StringValue(cc.ARN),
+		Region:       region,
+		CreationTime: creationTime,
+		Status:       getStatus(aws.StringValue(cc.CacheClusterStatus)),
+		Spec:         models.Spec{MachineClass: aws.StringValue(cc.CacheNodeType)},
+		Settings: map[string]any{
+			"engine":            aws.StringValue(cc.Engine),
+			"engine_version":    aws.StringValue(cc.EngineVersion),
+			"node_type":         aws.StringValue(cc.CacheNodeType),
+			"node_count":        aws.Int64Value(cc.NumCacheNodes),
+			"availability_zone": aws.StringValue(cc.PreferredAvailabilityZone),
+			"member_clusters":   []string{id},
+		},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// Idle reports whether none of the cache clusters of a cache had a client connected over the window, based on the
+// peak of the CurrConnections metric of each cluster.
+func (c *Client) Idle(ctx *gofr.Context, region string, clusterIDs []string, window time.Duration) (bool, error) {
+	cl, ok := c.CloudWatch[region]
+	if !ok {
+		return false, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Region"}}
+	}
+
+	end := time.Now()
+	start := end.Add(-window)
+
+	for _, id := range clusterIDs {
+		result, err := cl.GetMetricStatisticsWithContext(ctx, &cloudwatch.GetMetricStatisticsInput{
+			Namespace:  aws.String(namespace),
+			MetricName: aws.String(connectionMetric),
+			Dimensions: []*cloudwatch.Dimension{{Name: aws.String(clusterDimension), Value: aws.String(id)}},
+			StartTime:  aws.Time(start),
+			EndTime:    aws.Time(end),
+			Period:     aws.Int64(int64(window.Seconds())),
+			Statistics: aws.StringSlice([]string{cloudwatch.StatisticMaximum}),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		if len(result.Datapoints) == 0 {
+			return false, ErrNoDatapoints
+		}
+
+		for _, dp := range result.Datapoints {
+			if aws.Float64Value(dp.Maximum) > 0 {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// getStatus maps the available status to AVAILABLE, other statuses are kept as is.
+func getStatus(status string) string {
+	if status == available {
+		return AVAILABLE
+	}
+
+	return status
+}
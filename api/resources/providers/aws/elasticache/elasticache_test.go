@@ -0,0 +1,162 @@
+package elasticache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+var errFail = errors.New("fail")
+
+type mockElastiCache struct {
+	Groups   []*elasticache.ReplicationGroup
+	Clusters []*elasticache.CacheCluster
+	ListErr  error
+}
+
+func (m *mockElastiCache) DescribeReplicationGroupsWithContext(_ aws.Context,
+	input *elasticache.DescribeReplicationGroupsInput, _ ...request.Option) (*elasticache.DescribeReplicationGroupsOutput,
+	error) {
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+
+	// The groups are returned over two pages.
+	if input.Marker == nil {
+		return &elasticache.DescribeReplicationGroupsOutput{ReplicationGroups: m.Groups[:1],
+			Marker: aws.String("next")}, nil
+	}
+
+	return &elasticache.DescribeReplicationGroupsOutput{ReplicationGroups: m.Groups[1:]}, nil
+}
+
+func (m *mockElastiCache) DescribeCacheClustersWithContext(_ aws.Context, _ *elasticache.DescribeCacheClustersInput,
+	_ ...request.Option) (*elasticache.DescribeCacheClustersOutput, error) {
+	return &elasticache.DescribeCacheClustersOutput{CacheClusters: m.Clusters}, nil
+}
+
+type mockCloudWatch struct {
+	Peaks map[string]float64
+	Err   error
+}
+
+func (m *mockCloudWatch) GetMetricStatisticsWithContext(_ aws.Context, input *cloudwatch.GetMetricStatisticsInput,
+	_ ...request.Option) (*cloudwatch.GetMetricStatisticsOutput, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+
+	peak, ok := m.Peaks[aws.StringValue(input.Dimensions[0].Value)]
+	if !ok {
+		return &cloudwatch.GetMetricStatisticsOutput{}, nil
+	}
+
+	return &cloudwatch.GetMetricStatisticsOutput{Datapoints: []*cloudwatch.Datapoint{{Maximum: aws.Float64(peak)}}}, nil
+}
+
+func newMock() *mockElastiCache {
+	created := aws.Time(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+
+	return &mockElastiCache{
+		Groups: []*elasticache.ReplicationGroup{
+			{ReplicationGroupId: aws.String("sessions"), ARN: aws.String("arn:sessions"),
+				Status: aws.String("available"), CacheNodeType: aws.String("cache.r6g.large"),
+				AutomaticFailover: aws.String("enabled"), ReplicationGroupCreateTime: created,
+				MemberClusters: aws.StringSlice([]string{"sessions-001", "sessions-002"}),
+				NodeGroups:     []*elasticache.NodeGroup{{NodeGroupId: aws.String("0001")}}},
+			{ReplicationGroupId: aws.String("queue"), ARN: aws.String("arn:queue"), Status: aws.String("modifying"),
+				CacheNodeType: aws.String("cache.t4g.micro"), AutomaticFailover: aws.String("disabled"),
+				MemberClusters: aws.StringSlice([]string{"queue-001"})},
+		},
+		Clusters: []*elasticache.CacheCluster{
+			{CacheClusterId: aws.String("sessions-001"), ReplicationGroupId: aws.String("sessions")},
+			{CacheClusterId: aws.String("memcached"), ARN: aws.String("arn:memcached"),
+				CacheClusterStatus: aws.String("available"), CacheNodeType: aws.String("cache.t3.small"),
+				Engine: aws.String("memcached"), EngineVersion: aws.String("1.6.22"), NumCacheNodes: aws.Int64(3),
+				PreferredAvailabilityZone: aws.String("us-east-1a"), CacheClusterCreateTime: created},
+		},
+	}
+}
+
+func Test_GetAllInstances(t *testing.T) {
+	client := &Client{ElastiCache: map[string]ElastiCacheAPI{
+		"us-east-1":  newMock(),
+		"af-south-1": &mockElastiCache{ListErr: awserr.New("OptInRequired", "region not enabled", nil)},
+	}}
+
+	caches, err := client.GetAllInstances(nil)
+
+	require.NoError(t, err)
+	require.Len(t, caches, 3)
+
+	for i := range caches {
+		caches[i].CreatedAt, caches[i].UpdatedAt = time.Time{}, time.Time{}
+	}
+
+	assert.Equal(t, []models.Resource{
+		{Name: "sessions", Type: Cache, UID: "arn:sessions", Region: "us-east-1", CreationTime: "2025-06-01T10:00:00Z",
+			Status: AVAILABLE, Spec: models.Spec{MachineClass: "cache.r6g.large", HighAvailability: true},
+			Settings: map[string]any{"node_type": "cache.r6g.large", "node_count": 2, "shards": 1,
+				"member_clusters": []string{"sessions-001", "sessions-002"}}},
+		{Name: "queue", Type: Cache, UID: "arn:queue", Region: "us-east-1", Status: "modifying",
+			Spec: models.Spec{MachineClass: "cache.t4g.micro"},
+			Settings: map[string]any{"node_type": "cache.t4g.micro", "node_count": 1, "shards": 0,
+				"member_clusters": []string{"queue-001"}}},
+		{Name: "memcached", Type: Cache, UID: "arn:memcached", Region: "us-east-1",
+			CreationTime: "2025-06-01T10:00:00Z", Status: AVAILABLE, Spec: models.Spec{MachineClass: "cache.t3.small"},
+			Settings: map[string]any{"engine": "memcached", "engine_version": "1.6.22", "node_type": "cache.t3.small",
+				"node_count": int64(3), "availability_zone": "us-east-1a", "member_clusters": []string{"memcached"}}},
+	}, caches)
+}
+
+func Test_GetAllInstances_Error(t *testing.T) {
+	client := &Client{ElastiCache: map[string]ElastiCacheAPI{
+		"us-east-1": &mockElastiCache{ListErr: errFail},
+		"eu-west-1": newMock(),
+	}}
+
+	caches, err := client.GetAllInstances(nil)
+
+	require.ErrorIs(t, err, errFail)
+	assert.Nil(t, caches)
+}
+
+func Test_Idle(t *testing.T) {
+	cw := &mockCloudWatch{Peaks: map[string]float64{"sessions-001": 0, "sessions-002": 4}}
+	client := &Client{CloudWatch: map[string]CloudWatchAPI{"us-east-1": cw}}
+
+	idle, err := client.Idle(nil, "us-east-1", []string{"sessions-001", "sessions-002"}, 24*time.Hour)
+
+	require.NoError(t, err)
+	assert.False(t, idle)
+
+	idle, err = client.Idle(nil, "us-east-1", []string{"sessions-001"}, 24*time.Hour)
+
+	require.NoError(t, err)
+	assert.True(t, idle)
+
+	// The usage of a cluster without datapoints is unknown.
+	_, err = client.Idle(nil, "us-east-1", []string{"sessions-001", "queue-001"}, 24*time.Hour)
+
+	require.ErrorIs(t, err, ErrNoDatapoints)
+
+	_, err = client.Idle(nil, "mars-east-1", []string{"sessions-001"}, time.Hour)
+
+	require.Error(t, err)
+
+	cw.Err = errFail
+
+	_, err = client.Idle(nil, "us-east-1", []string{"sessions-001"}, time.Hour)
+
+	require.ErrorIs(t, err, errFail)
+}
@@ -17,8 +17,8 @@ type InstanceLister interface {
 }
 
 type Idler interface {
-	StartInstance(ctx *gofr.Context, instanceName string) error
-	StopInstance(ctx *gofr.Context, instanceName string) error
+	StartInstance(ctx *gofr.Context, region, instanceID string) error
+	StopInstance(ctx *gofr.Context, region, instanceID string) error
 }
 
 // TODO start and stop instance should be defined here, which would be same for AWS, GCP etc - GCP not being here.
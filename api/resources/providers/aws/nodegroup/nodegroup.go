@@ -0,0 +1,255 @@
+package nodegroup
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/regional"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+)
+
+const (
+	// RUNNING node group state for zopdev.
+	RUNNING = "RUNNING"
+	// STOPPED node group state for zopdev, a node group scaled to zero nodes.
+	STOPPED = "STOPPED"
+
+	// NodeGroup is the resource type used for EKS managed node groups.
+	NodeGroup = "EKS_NODEGROUP"
+)
+
+// EKSAPI defines the methods used from the AWS EKS client for easier testing/mocking.
+type EKSAPI interface {
+	ListClustersWithContext(ctx aws.Context, input *eks.ListClustersInput,
+		opts ...request.Option) (*eks.ListClustersOutput, error)
+	ListNodegroupsWithContext(ctx aws.Context, input *eks.ListNodegroupsInput,
+		opts ...request.Option) (*eks.ListNodegroupsOutput, error)
+	DescribeNodegroupWithContext(ctx aws.Context, input *eks.DescribeNodegroupInput,
+		opts ...request.Option) (*eks.DescribeNodegroupOutput, error)
+	UpdateNodegroupConfigWithContext(ctx aws.Context, input *eks.UpdateNodegroupConfigInput,
+		opts ...request.Option) (*eks.UpdateNodegroupConfigOutput, error)
+}
+
+// Size is the scaling configuration of a node group, recorded when the node group is scaled to zero and restored
+// when it is started again.
+type Size struct {
+	MinSize     int64 `json:"min_size"`
+	DesiredSize int64 `json:"desired_size"`
+}
+
+// Client lists and scales EKS managed node groups across regions.
+// EKS holds one region-scoped EKS client per AWS region, keyed by the region name.
+type Client struct {
+	EKS map[string]EKSAPI
+}
+
+// GetAllNodeGroups lists the managed node groups of the EKS clusters of all the regions.
+func (c *Client) GetAllNodeGroups(ctx *gofr.Context) ([]models.Resource, error) {
+	return regional.List(c.EKS, func(cl EKSAPI, region string) ([]models.Resource, error) {
+		return getRegionNodeGroups(ctx, cl, region)
+	})
+}
+
+// getRegionNodeGroups lists the node groups of all the clusters of a single region, following the pagination tokens.
+func getRegionNodeGroups(ctx *gofr.Context, cl EKSAPI, region string) ([]models.Resource, error) {
+	nodeGroups := make([]models.Resource, 0)
+	input := &eks.ListClustersInput{}
+
+	for {
+		result, err := cl.ListClustersWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cluster := range result.Clusters {
+			ngs, err := getClusterNodeGroups(ctx, cl, region, aws.StringValue(cluster))
+			if err != nil {
+				return nil, err
+			}
+
+			nodeGroups = append(nodeGroups, ngs...)
+		}
+
+		if aws.StringValue(result.NextToken) == "" {
+			return nodeGroups, nil
+		}
+
+		input.NextToken = result.NextToken
+	}
+}
+
+// getClusterNodeGroups describes the node groups of a cluster, following the pagination tokens.
+func getClusterNodeGroups(ctx *gofr.Context, cl EKSAPI, region, cluster string) ([]models.Resource, error) {
+	nodeGroups := make([]models.Resource, 0)
+	input := &eks.ListNodegroupsInput{ClusterName: aws.String(cluster)}
+
+	for {
+		result, err := cl.ListNodegroupsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range result.Nodegroups {
+			ng, err := describe(ctx, cl, cluster, aws.StringValue(name))
+			if err != nil {
+				return nil, err
+			}
+
+			nodeGroups = append(nodeGroups, toResource(ng, region))
+		}
+
+		if aws.StringValue(result.NextToken) == "" {
+			return nodeGroups, nil
+		}
+
+		input.NextToken = result.NextToken
+	}
+}
+
+func toResource(ng *eks.Nodegroup, region string) models.Resource {
+	var (
+		creationTime string
+		machineClass string
+		labels       models.Labels
+	)
+
+	if ng.CreatedAt != nil {
+		creationTime = ng.CreatedAt.Format(time.RFC3339)
+	}
+
+	if len(ng.InstanceTypes) > 0 {
+		machineClass = aws.StringValue(ng.InstanceTypes[0])
+	}
+
+	if len(ng.Tags) > 0 {
+		labels = make(models.Labels, len(ng.Tags))
+
+		for k, v := range ng.Tags {
+			labels[k] = aws.StringValue(v)
+		}
+	}
+
+	cluster := aws.StringValue(ng.ClusterName)
+	name := aws.StringValue(ng.NodegroupName)
+	scaling := ng.ScalingConfig
+
+	if scaling == nil {
+		scaling = &eks.NodegroupScalingConfig{}
+	}
+
+	return models.Resource{
+		Name:         cluster + "/" + name,
+		Type:         NodeGroup,
+		UID:          aws.StringValue(ng.NodegroupArn),
+		Region:       region,
+		CreationTime: creationTime,
+		Status:       getState(aws.StringValue(ng.Status), aws.Int64Value(scaling.DesiredSize)),
+		Labels:       labels,
+		Spec:         models.Spec{MachineClass: machineClass},
+		Settings: map[string]any{
+			"cluster":      cluster,
+			"node_group":   name,
+			"min_size":     aws.Int64Value(scaling.MinSize),
+			"max_size":     aws.Int64Value(scaling.MaxSize),
+			"desired_size": aws.Int64Value(scaling.DesiredSize),
+		},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// StopNodeGroup scales a node group to zero nodes by setting its minimum and desired sizes to zero, the maximum size
+// is kept as EKS requires it to be at least one. The scaling configuration of the node group before it was scaled
+// down is returned.
+func (c *Client) StopNodeGroup(ctx *gofr.Context, region, cluster, name string) (*Size, error) {
+	cl, err := c.client(region)
+	if err != nil {
+		return nil, err
+	}
+
+	ng, err := describe(ctx, cl, cluster, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var size Size
+
+	if ng.ScalingConfig != nil {
+		size = Size{MinSize: aws.Int64Value(ng.ScalingConfig.MinSize),
+			DesiredSize: aws.Int64Value(ng.ScalingConfig.DesiredSize)}
+	}
+
+	err = update(ctx, cl, cluster, name, &Size{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &size, nil
+}
+
+// StartNodeGroup restores the scaling configuration of a node group that was scaled to zero.
+func (c *Client) StartNodeGroup(ctx *gofr.Context, region, cluster, name string, size *Size) error {
+	cl, err := c.client(region)
+	if err != nil {
+		return err
+	}
+
+	return update(ctx, cl, cluster, name, size)
+}
+
+func describe(ctx *gofr.Context, cl EKSAPI, cluster, name string) (*eks.Nodegroup, error) {
+	result, err := cl.DescribeNodegroupWithContext(ctx, &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(cluster),
+		NodegroupName: aws.String(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Nodegroup == nil {
+		return nil, gofrHttp.ErrorEntityNotFound{Name: "node group", Value: name}
+	}
+
+	return result.Nodegroup, nil
+}
+
+func update(ctx *gofr.Context, cl EKSAPI, cluster, name string, size *Size) error {
+	_, err := cl.UpdateNodegroupConfigWithContext(ctx, &eks.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String(cluster),
+		NodegroupName: aws.String(name),
+		ScalingConfig: &eks.NodegroupScalingConfig{
+			MinSize:     aws.Int64(size.MinSize),
+			DesiredSize: aws.Int64(size.DesiredSize),
+		},
+	})
+
+	return err
+}
+
+// client returns the EKS client scoped to the given region.
+func (c *Client) client(region string) (EKSAPI, error) {
+	cl, ok := c.EKS[region]
+	if !ok {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Region"}}
+	}
+
+	return cl, nil
+}
+
+// getState maps the node group status to RUNNING or STOPPED by its desired size, other statuses are kept as is.
+func getState(status string, desiredSize int64) string {
+	switch status {
+	case eks.NodegroupStatusActive, eks.NodegroupStatusDegraded:
+		if desiredSize == 0 {
+			return STOPPED
+		}
+
+		return RUNNING
+	default:
+		return status
+	}
+}
@@ -0,0 +1,158 @@
+package nodegroup
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+var errFail = errors.New("fail")
+
+type mockEKS struct {
+	Clusters      []string
+	NodeGroups    map[string]*eks.Nodegroup
+	ListErr       error
+	DescribeErr   error
+	UpdateErr     error
+	ScalingConfig []*eks.NodegroupScalingConfig
+}
+
+func (m *mockEKS) ListClustersWithContext(_ aws.Context, _ *eks.ListClustersInput,
+	_ ...request.Option) (*eks.ListClustersOutput, error) {
+	if m.ListErr != nil {
+		return nil, m.ListErr
+	}
+
+	return &eks.ListClustersOutput{Clusters: aws.StringSlice(m.Clusters)}, nil
+}
+
+func (m *mockEKS) ListNodegroupsWithContext(_ aws.Context, input *eks.ListNodegroupsInput,
+	_ ...request.Option) (*eks.ListNodegroupsOutput, error) {
+	var names []*string
+
+	for _, ng := range m.NodeGroups {
+		if aws.StringValue(ng.ClusterName) == aws.StringValue(input.ClusterName) {
+			names = append(names, ng.NodegroupName)
+		}
+	}
+
+	return &eks.ListNodegroupsOutput{Nodegroups: names}, nil
+}
+
+func (m *mockEKS) DescribeNodegroupWithContext(_ aws.Context, input *eks.DescribeNodegroupInput,
+	_ ...request.Option) (*eks.DescribeNodegroupOutput, error) {
+	if m.DescribeErr != nil {
+		return nil, m.DescribeErr
+	}
+
+	return &eks.DescribeNodegroupOutput{Nodegroup: m.NodeGroups[aws.StringValue(input.NodegroupName)]}, nil
+}
+
+func (m *mockEKS) UpdateNodegroupConfigWithContext(_ aws.Context, input *eks.UpdateNodegroupConfigInput,
+	_ ...request.Option) (*eks.UpdateNodegroupConfigOutput, error) {
+	m.ScalingConfig = append(m.ScalingConfig, input.ScalingConfig)
+
+	return &eks.UpdateNodegroupConfigOutput{}, m.UpdateErr
+}
+
+func newNodeGroup() *eks.Nodegroup {
+	return &eks.Nodegroup{
+		ClusterName:   aws.String("dev"),
+		NodegroupName: aws.String("pool"),
+		NodegroupArn:  aws.String("arn:aws:eks:us-east-1:123:nodegroup/dev/pool/abc"),
+		Status:        aws.String(eks.NodegroupStatusActive),
+		CreatedAt:     aws.Time(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)),
+		InstanceTypes: aws.StringSlice([]string{"m5.large"}),
+		ScalingConfig: &eks.NodegroupScalingConfig{MinSize: aws.Int64(1), MaxSize: aws.Int64(3),
+			DesiredSize: aws.Int64(2)},
+		Tags: map[string]*string{"env": aws.String("dev")},
+	}
+}
+
+func Test_GetAllNodeGroups(t *testing.T) {
+	client := &Client{EKS: map[string]EKSAPI{
+		"us-east-1":  &mockEKS{Clusters: []string{"dev"}, NodeGroups: map[string]*eks.Nodegroup{"pool": newNodeGroup()}},
+		"af-south-1": &mockEKS{ListErr: awserr.New("UnrecognizedClientException", "region not enabled", nil)},
+	}}
+
+	nodeGroups, err := client.GetAllNodeGroups(nil)
+
+	require.NoError(t, err)
+	require.Len(t, nodeGroups, 1)
+
+	nodeGroups[0].CreatedAt, nodeGroups[0].UpdatedAt = time.Time{}, time.Time{}
+
+	assert.Equal(t, models.Resource{
+		Name: "dev/pool", Type: NodeGroup, UID: "arn:aws:eks:us-east-1:123:nodegroup/dev/pool/abc",
+		Region: "us-east-1", CreationTime: "2025-06-01T10:00:00Z", Status: RUNNING, Labels: models.Labels{"env": "dev"},
+		Spec: models.Spec{MachineClass: "m5.large"},
+		Settings: map[string]any{"cluster": "dev", "node_group": "pool", "min_size": int64(1), "max_size": int64(3),
+			"desired_size": int64(2)},
+	}, nodeGroups[0])
+}
+
+func Test_GetAllNodeGroups_Error(t *testing.T) {
+	client := &Client{EKS: map[string]EKSAPI{
+		"us-east-1": &mockEKS{Clusters: []string{"dev"}, NodeGroups: map[string]*eks.Nodegroup{"pool": newNodeGroup()},
+			DescribeErr: errFail},
+		"eu-west-1": &mockEKS{},
+	}}
+
+	nodeGroups, err := client.GetAllNodeGroups(nil)
+
+	require.ErrorIs(t, err, errFail)
+	assert.Nil(t, nodeGroups)
+}
+
+func Test_StopStartNodeGroup(t *testing.T) {
+	m := &mockEKS{NodeGroups: map[string]*eks.Nodegroup{"pool": newNodeGroup()}}
+	client := &Client{EKS: map[string]EKSAPI{"us-east-1": m}}
+
+	size, err := client.StopNodeGroup(nil, "us-east-1", "dev", "pool")
+
+	require.NoError(t, err)
+	assert.Equal(t, &Size{MinSize: 1, DesiredSize: 2}, size)
+	require.NoError(t, client.StartNodeGroup(nil, "us-east-1", "dev", "pool", size))
+
+	// The maximum size is left unchanged.
+	assert.Equal(t, []*eks.NodegroupScalingConfig{
+		{MinSize: aws.Int64(0), DesiredSize: aws.Int64(0)},
+		{MinSize: aws.Int64(1), DesiredSize: aws.Int64(2)},
+	}, m.ScalingConfig)
+}
+
+func Test_StopNodeGroup_Errors(t *testing.T) {
+	client := &Client{EKS: map[string]EKSAPI{"us-east-1": &mockEKS{}}}
+
+	_, err := client.StopNodeGroup(nil, "mars-east-1", "dev", "pool")
+	require.Error(t, err)
+
+	// The node group does not exist.
+	_, err = client.StopNodeGroup(nil, "us-east-1", "dev", "pool")
+	require.Error(t, err)
+
+	client = &Client{EKS: map[string]EKSAPI{"us-east-1": &mockEKS{
+		NodeGroups: map[string]*eks.Nodegroup{"pool": newNodeGroup()}, UpdateErr: errFail}}}
+
+	size, err := client.StopNodeGroup(nil, "us-east-1", "dev", "pool")
+
+	require.ErrorIs(t, err, errFail)
+	assert.Nil(t, size)
+	require.Error(t, client.StartNodeGroup(nil, "mars-east-1", "dev", "pool", &Size{}))
+}
+
+func Test_getState(t *testing.T) {
+	assert.Equal(t, RUNNING, getState("ACTIVE", 2))
+	assert.Equal(t, RUNNING, getState("DEGRADED", 1))
+	assert.Equal(t, STOPPED, getState("ACTIVE", 0))
+	assert.Equal(t, "UPDATING", getState("UPDATING", 0))
+}
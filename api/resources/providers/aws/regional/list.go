@@ -0,0 +1,52 @@
+package regional
+
+import (
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// List lists the resources of every region concurrently with the region-scoped clients, keyed by the region name.
+// Regions that are not enabled for the account reject the credentials, these are skipped as long as the resources
+// could be listed in at least one region. Any other error fails the listing.
+func List[T any](clients map[string]T, list func(cl T, region string) ([]models.Resource, error)) ([]models.Resource,
+	error) {
+	type result struct {
+		resources []models.Resource
+		err       error
+	}
+
+	resultsCh := make(chan result, len(clients))
+
+	for region, cl := range clients {
+		go func() {
+			resources, err := list(cl, region)
+
+			resultsCh <- result{resources, err}
+		}()
+	}
+
+	var (
+		resources = make([]models.Resource, 0)
+		errs      []error
+		succeeded int
+	)
+
+	for range clients {
+		r := <-resultsCh
+		if r.err != nil {
+			errs = append(errs, r.err)
+			continue
+		}
+
+		succeeded++
+
+		resources = append(resources, r.resources...)
+	}
+
+	for _, err := range errs {
+		if succeeded == 0 || !IsDisabled(err) {
+			return nil, err
+		}
+	}
+
+	return resources, nil
+}
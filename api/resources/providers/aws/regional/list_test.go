@@ -0,0 +1,48 @@
+package regional
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+var errFail = errors.New("fail")
+
+func Test_List(t *testing.T) {
+	optIn := awserr.New("OptInRequired", "region not enabled", nil)
+	list := func(err error, region string) ([]models.Resource, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		return []models.Resource{{Name: "res-" + region, Region: region}}, nil
+	}
+
+	res, err := List(map[string]error{"us-east-1": nil, "eu-west-1": nil}, list)
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []models.Resource{{Name: "res-us-east-1", Region: "us-east-1"},
+		{Name: "res-eu-west-1", Region: "eu-west-1"}}, res)
+
+	// The regions that are not enabled for the account are skipped.
+	res, err = List(map[string]error{"us-east-1": nil, "af-south-1": optIn}, list)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{{Name: "res-us-east-1", Region: "us-east-1"}}, res)
+
+	// When no region can be listed, the error is returned even for disabled regions.
+	res, err = List(map[string]error{"af-south-1": optIn}, list)
+
+	require.ErrorIs(t, err, optIn)
+	assert.Nil(t, res)
+
+	res, err = List(map[string]error{"us-east-1": nil, "eu-west-1": errFail}, list)
+
+	require.ErrorIs(t, err, errFail)
+	assert.Nil(t, res)
+}
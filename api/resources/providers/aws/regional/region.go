@@ -0,0 +1,25 @@
+// Package regional holds the helpers shared by the regional AWS clients.
+package regional
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// IsDisabled reports whether the error is returned because the region is not enabled for the account. Permission
+// errors such as UnauthorizedOperation are not, the resources of such a region are unknown rather than absent.
+func IsDisabled(err error) bool {
+	var aErr awserr.Error
+
+	if !errors.As(err, &aErr) {
+		return false
+	}
+
+	switch aErr.Code() {
+	case "OptInRequired", "InvalidClientTokenId", "UnrecognizedClientException":
+		return true
+	default:
+		return false
+	}
+}
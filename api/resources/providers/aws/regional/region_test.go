@@ -0,0 +1,32 @@
+package regional
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsDisabled(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"opt-in required", awserr.New("OptInRequired", "region not enabled", nil), true},
+		{"invalid token", awserr.New("InvalidClientTokenId", "region not enabled", nil), true},
+		{"unrecognized client", awserr.New("UnrecognizedClientException", "region not enabled", nil), true},
+		{"wrapped", fmt.Errorf("listing: %w", awserr.New("OptInRequired", "region not enabled", nil)), true},
+		{"unauthorized operation", awserr.New("UnauthorizedOperation", "not authorized", nil), false},
+		{"auth failure", awserr.New("AuthFailure", "invalid credentials", nil), false},
+		{"other error", errors.New("connection reset"), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsDisabled(tc.err))
+		})
+	}
+}
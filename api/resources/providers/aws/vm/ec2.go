@@ -1,14 +1,33 @@
 package vm
 
 import (
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/regional"
 	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+)
+
+const (
+	// RUNNING instance state for zopdev.
+	RUNNING = "RUNNING"
+	// STOPPED instance state for zopdev.
+	STOPPED = "STOPPED"
+	// STARTING instance state for zopdev.
+	STARTING = "STARTING"
+	// STOPPING instance state for zopdev.
+	STOPPING = "STOPPING"
+
+	// maxInstanceTypes is the maximum number of instance types that can be described in a single request.
+	maxInstanceTypes = 100
+	mibPerGB         = 1024
 )
 
 // EC2API defines the methods used from the AWS EC2 client for easier testing/mocking.
@@ -17,101 +36,224 @@ type EC2API interface {
 		opts ...request.Option) (*ec2.DescribeInstancesOutput, error)
 	StartInstancesWithContext(ctx aws.Context, input *ec2.StartInstancesInput, opts ...request.Option) (*ec2.StartInstancesOutput, error)
 	StopInstancesWithContext(ctx aws.Context, input *ec2.StopInstancesInput, opts ...request.Option) (*ec2.StopInstancesOutput, error)
+	DescribeInstanceTypesWithContext(ctx aws.Context, input *ec2.DescribeInstanceTypesInput,
+		opts ...request.Option) (*ec2.DescribeInstanceTypesOutput, error)
 }
 
+// Client lists and idles EC2 instances across regions.
+// EC2 holds one region-scoped EC2 client per AWS region, keyed by the region name.
 type Client struct {
-	EC2 EC2API
+	EC2 map[string]EC2API
 }
 
 func (c *Client) GetAllInstances(ctx *gofr.Context) ([]models.Resource, error) {
-	regions := GetAWSRegions()
+	return regional.List(c.EC2, func(cl EC2API, region string) ([]models.Resource, error) {
+		return getRegionInstances(ctx, cl, region)
+	})
+}
 
-	type result struct {
-		instances []models.Resource
-		err       error
+// getRegionInstances lists all the instances of a single region, following the pagination tokens.
+func getRegionInstances(ctx *gofr.Context, cl EC2API, region string) ([]models.Resource, error) {
+	instances := make([]models.Resource, 0)
+	input := &ec2.DescribeInstancesInput{}
+
+	for {
+		ec2Result, err := cl.DescribeInstancesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, reservation := range ec2Result.Reservations {
+			for _, inst := range reservation.Instances {
+				instances = append(instances, toResource(inst, region))
+			}
+		}
+
+		if awsStringValue(ec2Result.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = ec2Result.NextToken
 	}
 
-	resultsCh := make(chan result, len(regions))
+	setInstanceTypeSpecs(ctx, cl, region, instances)
 
-	for _, region := range regions {
-		currentRegion := region // capture range variable
-		go func(region string) {
-			input := &ec2.DescribeInstancesInput{}
+	return instances, nil
+}
 
-			ec2Result, err := c.EC2.DescribeInstancesWithContext(ctx, input)
-			if err != nil {
-				resultsCh <- result{nil, err}
-				return
-			}
+// toResource converts an EC2 instance of a region to a resource, named after its Name tag.
+func toResource(inst *ec2.Instance, region string) models.Resource {
+	var (
+		instanceName string
+		labels       models.Labels
+	)
 
-			instances := make([]models.Resource, 0)
-
-			for _, reservation := range ec2Result.Reservations {
-				for _, inst := range reservation.Instances {
-					var instanceName string
-
-					for _, tag := range inst.Tags {
-						if *tag.Key == "Name" {
-							instanceName = awsStringValue(tag.Value)
-							break
-						}
-					}
-
-					instance := models.Resource{
-						Name:         instanceName,
-						Type:         "EC2",
-						UID:          awsStringValue(inst.InstanceId),
-						Region:       region,
-						CreationTime: inst.LaunchTime.Format(time.RFC3339),
-						Status:       awsStringValue(inst.State.Name),
-						Settings:     map[string]any{"InstanceType": awsStringValue(inst.InstanceType)},
-						CreatedAt:    time.Now(),
-						UpdatedAt:    time.Now(),
-					}
-					instances = append(instances, instance)
-				}
-			}
+	for _, tag := range inst.Tags {
+		if labels == nil {
+			labels = make(models.Labels, len(inst.Tags))
+		}
+
+		labels[awsStringValue(tag.Key)] = awsStringValue(tag.Value)
 
-			resultsCh <- result{instances, nil}
-		}(currentRegion)
+		if *tag.Key == "Name" {
+			instanceName = awsStringValue(tag.Value)
+		}
 	}
 
-	allInstances := make([]models.Resource, 0)
+	return models.Resource{
+		Name:         instanceName,
+		Type:         "EC2",
+		UID:          awsStringValue(inst.InstanceId),
+		Region:       region,
+		CreationTime: inst.LaunchTime.Format(time.RFC3339),
+		Status:       getState(awsStringValue(inst.State.Name)),
+		Labels:       labels,
+		Settings:     map[string]any{"InstanceType": awsStringValue(inst.InstanceType)},
+		Spec:         models.Spec{MachineClass: awsStringValue(inst.InstanceType)},
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
 
-	var firstErr error
+// DescribeInstance fetches the current state of an instance of a region by its ID, without listing the instances
+// of the account.
+func (c *Client) DescribeInstance(ctx *gofr.Context, region, instanceID string) (*models.Resource, error) {
+	cl, err := c.client(region)
+	if err != nil {
+		return nil, err
+	}
 
-	for i := 0; i < len(regions); i++ {
-		r := <-resultsCh
-		if r.err != nil && firstErr == nil {
-			firstErr = r.err
+	result, err := cl.DescribeInstancesWithContext(ctx, &ec2.DescribeInstancesInput{
+		InstanceIds: []*string{aws.String(instanceID)},
+	})
+	if err != nil {
+		var aErr awserr.Error
+		if errors.As(err, &aErr) && aErr.Code() == "InvalidInstanceID.NotFound" {
+			return nil, gofrHttp.ErrorEntityNotFound{Name: "instance", Value: instanceID}
 		}
 
-		allInstances = append(allInstances, r.instances...)
+		return nil, err
 	}
 
-	close(resultsCh)
+	for _, reservation := range result.Reservations {
+		for _, inst := range reservation.Instances {
+			instances := []models.Resource{toResource(inst, region)}
 
-	return allInstances, firstErr
+			setInstanceTypeSpecs(ctx, cl, region, instances)
+
+			return &instances[0], nil
+		}
+	}
+
+	return nil, gofrHttp.ErrorEntityNotFound{Name: "instance", Value: instanceID}
 }
 
-func (c *Client) StartInstance(ctx *gofr.Context, instanceID string) error {
+// setInstanceTypeSpecs fills the vCPUs and memory of the instances from the instance types they run on. The specs
+// are informational, the instances of the types that could not be described are kept with an empty spec.
+func setInstanceTypeSpecs(ctx *gofr.Context, cl EC2API, region string, instances []models.Resource) {
+	var types []*string
+
+	seen := make(map[string]bool)
+
+	for i := range instances {
+		t := instances[i].Spec.MachineClass
+		if t == "" || seen[t] {
+			continue
+		}
+
+		seen[t] = true
+
+		types = append(types, aws.String(t))
+	}
+
+	specs := make(map[string]models.Spec, len(types))
+
+	for start := 0; start < len(types); start += maxInstanceTypes {
+		end := min(start+maxInstanceTypes, len(types))
+
+		result, err := cl.DescribeInstanceTypesWithContext(ctx, &ec2.DescribeInstanceTypesInput{InstanceTypes: types[start:end]})
+		if err != nil {
+			ctx.Errorf("failed to describe the instance types of region %s: %v", region, err)
+			continue
+		}
+
+		for _, info := range result.InstanceTypes {
+			var spec models.Spec
+
+			if info.VCpuInfo != nil {
+				spec.VCPU = float64(aws.Int64Value(info.VCpuInfo.DefaultVCpus))
+			}
+
+			if info.MemoryInfo != nil {
+				spec.MemoryGB = float64(aws.Int64Value(info.MemoryInfo.SizeInMiB)) / mibPerGB
+			}
+
+			specs[awsStringValue(info.InstanceType)] = spec
+		}
+	}
+
+	for i := range instances {
+		spec := specs[instances[i].Spec.MachineClass]
+
+		instances[i].Spec.VCPU = spec.VCPU
+		instances[i].Spec.MemoryGB = spec.MemoryGB
+	}
+}
+
+func (c *Client) StartInstance(ctx *gofr.Context, region, instanceID string) error {
+	cl, err := c.client(region)
+	if err != nil {
+		return err
+	}
+
 	input := &ec2.StartInstancesInput{
 		InstanceIds: []*string{&instanceID},
 	}
-	_, err := c.EC2.StartInstancesWithContext(ctx, input)
+	_, err = cl.StartInstancesWithContext(ctx, input)
 
 	return err
 }
 
-func (c *Client) StopInstance(ctx *gofr.Context, instanceID string) error {
+func (c *Client) StopInstance(ctx *gofr.Context, region, instanceID string) error {
+	cl, err := c.client(region)
+	if err != nil {
+		return err
+	}
+
 	input := &ec2.StopInstancesInput{
 		InstanceIds: []*string{&instanceID},
 	}
-	_, err := c.EC2.StopInstancesWithContext(ctx, input)
+	_, err = cl.StopInstancesWithContext(ctx, input)
 
 	return err
 }
 
+// client returns the EC2 client scoped to the given region.
+func (c *Client) client(region string) (EC2API, error) {
+	cl, ok := c.EC2[region]
+	if !ok {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"region"}}
+	}
+
+	return cl, nil
+}
+
+// getState maps the EC2 instance state to the zopdev state, terminated and shutting-down states are kept as is.
+func getState(state string) string {
+	switch state {
+	case ec2.InstanceStateNameRunning:
+		return RUNNING
+	case ec2.InstanceStateNameStopped:
+		return STOPPED
+	case ec2.InstanceStateNamePending:
+		return STARTING
+	case ec2.InstanceStateNameStopping:
+		return STOPPING
+	default:
+		return state
+	}
+}
+
 func awsStringValue(s *string) string {
 	if s == nil {
 		return ""
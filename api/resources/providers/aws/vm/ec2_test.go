@@ -1,45 +1,58 @@
 package vm
 
 import (
+	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
 )
 
 var errFail = errors.New("fail")
 
 type mockEC2 struct {
-	DescribeInstancesResp map[string]*ec2.DescribeInstancesOutput
+	DescribeInstancesResp *ec2.DescribeInstancesOutput
 	DescribeInstancesErr  error
+	InstanceTypesResp     *ec2.DescribeInstanceTypesOutput
+	InstanceTypesErr      error
 	StartErr              error
 	StopErr               error
-	Region                string
-	CurrentRegion         string
+	StartedIDs            []string
+	DescribedIDs          []string
 }
 
-func (m *mockEC2) DescribeInstancesWithContext(_ aws.Context, _ *ec2.DescribeInstancesInput,
+func (m *mockEC2) DescribeInstancesWithContext(_ aws.Context, input *ec2.DescribeInstancesInput,
 	_ ...request.Option) (*ec2.DescribeInstancesOutput, error) {
+	m.DescribedIDs = append(m.DescribedIDs, aws.StringValueSlice(input.InstanceIds)...)
+
 	if m.DescribeInstancesErr != nil {
 		return nil, m.DescribeInstancesErr
 	}
 
-	resp, ok := m.DescribeInstancesResp[m.CurrentRegion]
-	if !ok {
+	if m.DescribeInstancesResp == nil {
 		return &ec2.DescribeInstancesOutput{}, nil
 	}
 
-	return resp, nil
+	return m.DescribeInstancesResp, nil
 }
 
-func (m *mockEC2) StartInstancesWithContext(_ aws.Context, _ *ec2.StartInstancesInput,
+func (m *mockEC2) StartInstancesWithContext(_ aws.Context, input *ec2.StartInstancesInput,
 	_ ...request.Option) (*ec2.StartInstancesOutput, error) {
+	m.StartedIDs = append(m.StartedIDs, aws.StringValue(input.InstanceIds[0]))
+
 	return &ec2.StartInstancesOutput{}, m.StartErr
 }
 
@@ -48,67 +61,129 @@ func (m *mockEC2) StopInstancesWithContext(_ aws.Context, _ *ec2.StopInstancesIn
 	return &ec2.StopInstancesOutput{}, m.StopErr
 }
 
+func (m *mockEC2) DescribeInstanceTypesWithContext(_ aws.Context, _ *ec2.DescribeInstanceTypesInput,
+	_ ...request.Option) (*ec2.DescribeInstanceTypesOutput, error) {
+	if m.InstanceTypesErr != nil {
+		return nil, m.InstanceTypesErr
+	}
+
+	if m.InstanceTypesResp == nil {
+		return &ec2.DescribeInstanceTypesOutput{}, nil
+	}
+
+	return m.InstanceTypesResp, nil
+}
+
 func Test_GetAllInstances_Success(t *testing.T) {
 	regions := GetAWSRegions()
-	mock := &mockEC2{
-		DescribeInstancesResp: map[string]*ec2.DescribeInstancesOutput{},
-	}
+	clients := make(map[string]EC2API, len(regions))
 
 	for _, region := range regions {
-		mock.DescribeInstancesResp[region] = &ec2.DescribeInstancesOutput{
-			Reservations: []*ec2.Reservation{{
-				Instances: []*ec2.Instance{{
-					InstanceId:   aws.String("i-123"),
+		clients[region] = &mockEC2{
+			DescribeInstancesResp: &ec2.DescribeInstancesOutput{
+				Reservations: []*ec2.Reservation{{
+					Instances: []*ec2.Instance{{
+						InstanceId:   aws.String("i-" + region),
+						InstanceType: aws.String("t2.micro"),
+						LaunchTime:   aws.Time(time.Now()),
+						State:        &ec2.InstanceState{Name: aws.String("running")},
+						Tags: []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("test-instance")},
+							{Key: aws.String("team"), Value: aws.String("payments")}},
+					}},
+				}},
+			},
+			InstanceTypesResp: &ec2.DescribeInstanceTypesOutput{
+				InstanceTypes: []*ec2.InstanceTypeInfo{{
 					InstanceType: aws.String("t2.micro"),
-					LaunchTime:   aws.Time(time.Now()),
-					State:        &ec2.InstanceState{Name: aws.String("running")},
-					Tags:         []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("test-instance")}},
+					VCpuInfo:     &ec2.VCpuInfo{DefaultVCpus: aws.Int64(1)},
+					MemoryInfo:   &ec2.MemoryInfo{SizeInMiB: aws.Int64(1024)},
 				}},
-			}},
+			},
 		}
 	}
 
-	mock.CurrentRegion = "us-east-1" // Not used in this test, but set for completeness
-	client := &Client{EC2: mock}
+	client := &Client{EC2: clients}
 	instances, err := client.GetAllInstances(nil)
 	require.NoError(t, err)
 	require.Len(t, instances, len(regions))
 
-	regionSet := make(map[string]struct{})
-
 	for _, inst := range instances {
 		assert.Equal(t, "test-instance", inst.Name)
 		assert.Equal(t, "EC2", inst.Type)
-		assert.Equal(t, "i-123", inst.UID)
-		assert.Equal(t, "running", inst.Status)
-
-		regionSet[inst.Region] = struct{}{}
-	}
-	// Ensure all regions are present in the results
-	for _, region := range regions {
-		_, ok := regionSet[region]
-		assert.True(t, ok, "region %s not found in results", region)
+		assert.Equal(t, RUNNING, inst.Status)
+		assert.Equal(t, models.Labels{"Name": "test-instance", "team": "payments"}, inst.Labels)
+		assert.Equal(t, models.Spec{VCPU: 1, MemoryGB: 1, MachineClass: "t2.micro"}, inst.Spec)
+		// Every instance is tagged with the region of the client that listed it.
+		assert.Equal(t, "i-"+inst.Region, inst.UID)
 	}
 }
 
 func Test_GetAllInstances_Error(t *testing.T) {
-	mock := &mockEC2{DescribeInstancesErr: errFail, Region: "us-east-1"}
-	mock.CurrentRegion = "us-east-1"
-	client := &Client{EC2: mock}
+	client := &Client{EC2: map[string]EC2API{
+		"us-east-1": &mockEC2{DescribeInstancesErr: errFail},
+		"eu-west-1": &mockEC2{},
+	}}
 	instances, err := client.GetAllInstances(nil)
-	require.Error(t, err)
+	require.ErrorIs(t, err, errFail)
 	require.Empty(t, instances)
 }
 
-func Test_GetAllInstances_NoReservations(t *testing.T) {
-	mock := &mockEC2{
-		DescribeInstancesResp: map[string]*ec2.DescribeInstancesOutput{
-			"us-east-1": {Reservations: []*ec2.Reservation{}},
+func Test_GetAllInstances_InstanceTypesError(t *testing.T) {
+	client := &Client{EC2: map[string]EC2API{
+		"us-east-1": &mockEC2{
+			DescribeInstancesResp: &ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{
+				Instances: []*ec2.Instance{{
+					InstanceId:   aws.String("i-123"),
+					InstanceType: aws.String("t2.micro"),
+					LaunchTime:   aws.Time(time.Now()),
+					State:        &ec2.InstanceState{Name: aws.String("running")},
+				}},
+			}}},
+			InstanceTypesErr: errFail,
 		},
-		Region: "us-east-1",
+	}}
+
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+
+	// The instances are listed without the specs of their instance type.
+	instances, err := client.GetAllInstances(ctx)
+
+	require.NoError(t, err)
+	require.Len(t, instances, 1)
+	assert.Equal(t, "i-123", instances[0].UID)
+	assert.Equal(t, models.Spec{MachineClass: "t2.micro"}, instances[0].Spec)
+}
+
+func Test_GetAllInstances_RegionDisabled(t *testing.T) {
+	optIn := awserr.New("OptInRequired", "region not enabled", nil)
+	instance := &ec2.Instance{
+		InstanceId: aws.String("i-123"),
+		LaunchTime: aws.Time(time.Now()),
+		State:      &ec2.InstanceState{Name: aws.String("stopped")},
 	}
-	mock.CurrentRegion = "us-east-1"
-	client := &Client{EC2: mock}
+
+	client := &Client{EC2: map[string]EC2API{
+		"us-east-1":  &mockEC2{DescribeInstancesResp: &ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{instance}}}}},
+		"af-south-1": &mockEC2{DescribeInstancesErr: optIn},
+	}}
+
+	instances, err := client.GetAllInstances(nil)
+	require.NoError(t, err)
+	require.Len(t, instances, 1)
+	assert.Equal(t, "us-east-1", instances[0].Region)
+
+	// When no region can be listed, the error is returned even for disabled regions.
+	client = &Client{EC2: map[string]EC2API{"af-south-1": &mockEC2{DescribeInstancesErr: optIn}}}
+
+	_, err = client.GetAllInstances(nil)
+	require.Error(t, err)
+}
+
+func Test_GetAllInstances_NoReservations(t *testing.T) {
+	client := &Client{EC2: map[string]EC2API{
+		"us-east-1": &mockEC2{DescribeInstancesResp: &ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{}}},
+	}}
 	instances, err := client.GetAllInstances(nil)
 	require.NoError(t, err)
 	require.Empty(t, instances)
@@ -117,21 +192,25 @@ func Test_GetAllInstances_NoReservations(t *testing.T) {
 func Test_StartInstance(t *testing.T) {
 	cases := []struct {
 		name     string
+		region   string
 		err      error
 		expectOK bool
 	}{
-		{"Success", nil, true},
-		{"Error", errFail, false},
+		{"Success", "eu-west-1", nil, true},
+		{"Error", "eu-west-1", errFail, false},
+		{"Unknown region", "mars-east-1", nil, false},
 	}
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			mock := &mockEC2{StartErr: c.err}
-			mock.CurrentRegion = "us-east-1"
-			client := &Client{EC2: mock}
+			usEast := &mockEC2{StartErr: c.err}
+			euWest := &mockEC2{StartErr: c.err}
+			client := &Client{EC2: map[string]EC2API{"us-east-1": usEast, "eu-west-1": euWest}}
 
-			err := client.StartInstance(nil, "i-123")
+			err := client.StartInstance(nil, c.region, "i-123")
 			if c.expectOK {
 				assert.NoError(t, err)
+				assert.Equal(t, []string{"i-123"}, euWest.StartedIDs)
+				assert.Empty(t, usEast.StartedIDs)
 			} else {
 				require.Error(t, err)
 			}
@@ -142,19 +221,19 @@ func Test_StartInstance(t *testing.T) {
 func Test_StopInstance(t *testing.T) {
 	cases := []struct {
 		name     string
+		region   string
 		err      error
 		expectOK bool
 	}{
-		{"Success", nil, true},
-		{"Error", errFail, false},
+		{"Success", "us-east-1", nil, true},
+		{"Error", "us-east-1", errFail, false},
+		{"Unknown region", "", nil, false},
 	}
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			mock := &mockEC2{StopErr: c.err}
-			mock.CurrentRegion = "us-east-1"
-			client := &Client{EC2: mock}
+			client := &Client{EC2: map[string]EC2API{"us-east-1": &mockEC2{StopErr: c.err}}}
 
-			err := client.StopInstance(nil, "i-123")
+			err := client.StopInstance(nil, c.region, "i-123")
 			if c.expectOK {
 				assert.NoError(t, err)
 			} else {
@@ -164,6 +243,68 @@ func Test_StopInstance(t *testing.T) {
 	}
 }
 
+func Test_DescribeInstance(t *testing.T) {
+	mock := &mockEC2{
+		DescribeInstancesResp: &ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{
+			Instances: []*ec2.Instance{{
+				InstanceId:   aws.String("i-123"),
+				InstanceType: aws.String("t2.micro"),
+				LaunchTime:   aws.Time(time.Now()),
+				State:        &ec2.InstanceState{Name: aws.String("stopping")},
+				Tags:         []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("web")}},
+			}},
+		}}},
+		InstanceTypesResp: &ec2.DescribeInstanceTypesOutput{InstanceTypes: []*ec2.InstanceTypeInfo{{
+			InstanceType: aws.String("t2.micro"),
+			VCpuInfo:     &ec2.VCpuInfo{DefaultVCpus: aws.Int64(1)},
+			MemoryInfo:   &ec2.MemoryInfo{SizeInMiB: aws.Int64(1024)},
+		}}},
+	}
+	client := &Client{EC2: map[string]EC2API{"us-east-1": mock}}
+
+	res, err := client.DescribeInstance(nil, "us-east-1", "i-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"i-123"}, mock.DescribedIDs)
+	assert.Equal(t, "web", res.Name)
+	assert.Equal(t, STOPPING, res.Status)
+	assert.Equal(t, models.Spec{VCPU: 1, MemoryGB: 1, MachineClass: "t2.micro"}, res.Spec)
+
+	// The instance was terminated and is no longer described.
+	mock.DescribeInstancesErr = awserr.New("InvalidInstanceID.NotFound", "not found", nil)
+
+	res, err = client.DescribeInstance(nil, "us-east-1", "i-123")
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "instance", Value: "i-123"}, err)
+	assert.Nil(t, res)
+
+	mock.DescribeInstancesErr = errFail
+
+	res, err = client.DescribeInstance(nil, "us-east-1", "i-123")
+
+	require.ErrorIs(t, err, errFail)
+	assert.Nil(t, res)
+
+	mock.DescribeInstancesErr, mock.DescribeInstancesResp = nil, nil
+
+	res, err = client.DescribeInstance(nil, "us-east-1", "i-123")
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "instance", Value: "i-123"}, err)
+	assert.Nil(t, res)
+
+	res, err = client.DescribeInstance(nil, "eu-west-1", "i-123")
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"region"}}, err)
+	assert.Nil(t, res)
+}
+
+func Test_GetAWSRegions(t *testing.T) {
+	for _, region := range GetAWSRegions() {
+		assert.Equal(t, strings.TrimSpace(region), region)
+		assert.NotEmpty(t, region)
+	}
+}
+
 func Test_awsStringValue(t *testing.T) {
 	var nilStr *string
 
@@ -172,3 +313,11 @@ func Test_awsStringValue(t *testing.T) {
 	val := "hello"
 	assert.Equal(t, "hello", awsStringValue(&val))
 }
+
+func Test_getState(t *testing.T) {
+	assert.Equal(t, RUNNING, getState("running"))
+	assert.Equal(t, STOPPED, getState("stopped"))
+	assert.Equal(t, STARTING, getState("pending"))
+	assert.Equal(t, STOPPING, getState("stopping"))
+	assert.Equal(t, "terminated", getState("terminated"))
+}
@@ -11,5 +11,11 @@ eu-south-1,eu-south-2,me-south-1,me-central-1,sa-east-1`
 
 // GetAWSRegions parses the awsRegionsCSV constant and returns a slice of region strings.
 func GetAWSRegions() []string {
-	return strings.Split(awsRegionsCSV, ",")
+	regions := strings.Split(awsRegionsCSV, ",")
+
+	for i := range regions {
+		regions[i] = strings.TrimSpace(regions[i])
+	}
+
+	return regions
 }
@@ -0,0 +1,112 @@
+package address
+
+import (
+	"path"
+
+	"gofr.dev/pkg/gofr"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const (
+	// ATTACHED address state for zopdev, an address used by a resource.
+	ATTACHED = "ATTACHED"
+	// UNATTACHED address state for zopdev, an address that is reserved but used by no resource.
+	UNATTACHED = "UNATTACHED"
+
+	// Address is the resource type used for reserved static external IP addresses.
+	Address = "GCP_STATIC_IP"
+
+	// global is the region of the global addresses, e.g. the addresses of external load balancers.
+	global = "global"
+)
+
+// Client lists and releases the reserved static external IP addresses of a project, both regional and global.
+// Internal addresses are not billed and are not listed.
+type Client struct {
+	Addresses       *compute.AddressesService
+	GlobalAddresses *compute.GlobalAddressesService
+}
+
+// GetAllAddresses lists the regional addresses of every region using the aggregated list API and the global addresses.
+func (c *Client) GetAllAddresses(ctx *gofr.Context, projectID string) ([]models.Resource, error) {
+	addresses := make([]models.Resource, 0)
+
+	err := c.Addresses.AggregatedList(projectID).Pages(ctx, func(list *compute.AddressAggregatedList) error {
+		for _, scope := range list.Items {
+			for _, item := range scope.Addresses {
+				// The global addresses are listed separately.
+				if item.Region == "" || item.AddressType != "EXTERNAL" {
+					continue
+				}
+
+				addresses = append(addresses, toResource(projectID, path.Base(item.Region), item))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.GlobalAddresses.List(projectID).Pages(ctx, func(list *compute.AddressList) error {
+		for _, item := range list.Items {
+			if item.AddressType != "EXTERNAL" {
+				continue
+			}
+
+			addresses = append(addresses, toResource(projectID, global, item))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
+func toResource(projectID, region string, a *compute.Address) models.Resource {
+	return models.Resource{
+		Name:         a.Name,
+		Type:         Address,
+		UID:          projectID + "/" + region + "/" + a.Name,
+		Region:       region,
+		CreationTime: a.CreationTimestamp,
+		Status:       getState(a.Status),
+		Labels:       a.Labels,
+		Spec:         models.Spec{MachineClass: a.NetworkTier},
+		Settings: models.Settings{
+			"address": a.Address,
+			"region":  region,
+		},
+	}
+}
+
+// DeleteAddress releases a regional address, or a global address when the region is global.
+func (c *Client) DeleteAddress(ctx *gofr.Context, projectID, region, name string) error {
+	var err error
+
+	if region == global {
+		_, err = c.GlobalAddresses.Delete(projectID, name).Context(ctx).Do()
+	} else {
+		_, err = c.Addresses.Delete(projectID, region, name).Context(ctx).Do()
+	}
+
+	return err
+}
+
+// getState maps the address status to ATTACHED or UNATTACHED, other statuses are kept as is.
+func getState(status string) string {
+	switch status {
+	case "IN_USE":
+		return ATTACHED
+	case "RESERVED":
+		return UNATTACHED
+	default:
+		return status
+	}
+}
@@ -0,0 +1,103 @@
+package address
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/internal/gcptest"
+)
+
+func newClient(t *testing.T, url string) *Client {
+	t.Helper()
+
+	svc := gcptest.NewService(t, compute.NewService, url)
+
+	return &Client{Addresses: svc.Addresses, GlobalAddresses: svc.GlobalAddresses}
+}
+
+func TestClient_GetAllAddresses(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /projects/test-project/aggregated/addresses", func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &compute.AddressAggregatedList{Items: map[string]compute.AddressesScopedList{
+			"regions/us-central1": {Addresses: []*compute.Address{
+				{Name: "nat", Address: "34.1.2.3", AddressType: "EXTERNAL", Status: "IN_USE", NetworkTier: "PREMIUM",
+					Region:            "https://www.googleapis.com/compute/v1/projects/test-project/regions/us-central1",
+					CreationTimestamp: "2025-06-01T10:00:00Z"},
+				{Name: "spare", Address: "34.1.2.4", AddressType: "EXTERNAL", Status: "RESERVED",
+					Region: "https://www.googleapis.com/compute/v1/projects/test-project/regions/us-central1"},
+				{Name: "internal", Address: "10.0.0.5", AddressType: "INTERNAL", Status: "RESERVED",
+					Region: "https://www.googleapis.com/compute/v1/projects/test-project/regions/us-central1"},
+			}},
+		}})
+	})
+	mux.HandleFunc("GET /projects/test-project/global/addresses", func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &compute.AddressList{Items: []*compute.Address{
+			{Name: "lb", Address: "35.1.2.3", AddressType: "EXTERNAL", Status: "RESERVED"},
+		}})
+	})
+
+	srv := gcptest.NewServer(t, mux)
+
+	addresses, err := newClient(t, srv.URL).GetAllAddresses(ctx, "test-project")
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{
+		{Name: "nat", Type: Address, UID: "test-project/us-central1/nat", Region: "us-central1",
+			CreationTime: "2025-06-01T10:00:00Z", Status: ATTACHED, Spec: models.Spec{MachineClass: "PREMIUM"},
+			Settings: models.Settings{"address": "34.1.2.3", "region": "us-central1"}},
+		{Name: "spare", Type: Address, UID: "test-project/us-central1/spare", Region: "us-central1", Status: UNATTACHED,
+			Settings: models.Settings{"address": "34.1.2.4", "region": "us-central1"}},
+		{Name: "lb", Type: Address, UID: "test-project/global/lb", Region: "global", Status: UNATTACHED,
+			Settings: models.Settings{"address": "35.1.2.3", "region": "global"}},
+	}, addresses)
+}
+
+func TestClient_GetAllAddresses_Error(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /projects/test-project/aggregated/addresses", func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &compute.AddressAggregatedList{})
+	})
+
+	srv := gcptest.NewServer(t, mux)
+
+	addresses, err := newClient(t, srv.URL).GetAllAddresses(ctx, "test-project")
+
+	require.Error(t, err)
+	assert.Nil(t, addresses)
+}
+
+func TestClient_DeleteAddress(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	mux := http.NewServeMux()
+
+	var deleted []string
+
+	for _, p := range []string{"/projects/test-project/regions/us-central1/addresses/spare",
+		"/projects/test-project/global/addresses/lb"} {
+		mux.HandleFunc("DELETE "+p, func(w http.ResponseWriter, r *http.Request) {
+			deleted = append(deleted, r.URL.Path)
+
+			gcptest.WriteJSON(w, &compute.Operation{Name: "op"})
+		})
+	}
+
+	srv := gcptest.NewServer(t, mux)
+
+	c := newClient(t, srv.URL)
+
+	require.NoError(t, c.DeleteAddress(ctx, "test-project", "us-central1", "spare"))
+	require.NoError(t, c.DeleteAddress(ctx, "test-project", "global", "lb"))
+	require.Error(t, c.DeleteAddress(ctx, "test-project", "us-east1", "spare"))
+
+	assert.Equal(t, []string{"/projects/test-project/regions/us-central1/addresses/spare",
+		"/projects/test-project/global/addresses/lb"}, deleted)
+}
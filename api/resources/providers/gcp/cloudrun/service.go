@@ -0,0 +1,183 @@
+package cloudrun
+
+import (
+	"fmt"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+	runv1 "google.golang.org/api/run/v1"
+	"google.golang.org/api/run/v2"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const (
+	// RUNNING service state for zopdev, a service that keeps instances warm.
+	RUNNING = "RUNNING"
+	// STOPPED service state for zopdev, a service that scales to zero when it is not serving requests.
+	STOPPED = "STOPPED"
+
+	// Service is the resource type used for Cloud Run services.
+	Service = "CLOUD_RUN"
+
+	// managedByLabel is set on the Cloud Run services of 2nd gen Cloud Functions, these are managed through their
+	// function.
+	managedByLabel     = "goog-managed-by"
+	managedByFunctions = "cloudfunctions"
+)
+
+// Size is the minimum number of instances of a Cloud Run service, recorded when the service is scaled to zero and
+// restored when it is started again. The minimum can be set on the service and on its revision template.
+type Size struct {
+	ServiceMinInstances  int64 `json:"service_min_instances"`
+	RevisionMinInstances int64 `json:"revision_min_instances"`
+}
+
+// Client lists the Cloud Run services of a project and updates their minimum number of instances. The services are
+// listed by location, the locations of Cloud Run are listed with the v1 API.
+type Client struct {
+	Services  *run.ProjectsLocationsServicesService
+	Locations *runv1.ProjectsLocationsService
+}
+
+// GetAllServices lists the Cloud Run services of every location of the project. The services of 2nd gen Cloud
+// Functions are skipped as they are managed through their function.
+func (c *Client) GetAllServices(ctx *gofr.Context, projectID string) ([]models.Resource, error) {
+	var locations []string
+
+	err := c.Locations.List("projects/"+projectID).Pages(ctx, func(resp *runv1.ListLocationsResponse) error {
+		for _, l := range resp.Locations {
+			locations = append(locations, l.LocationId)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	services := make([]models.Resource, 0)
+
+	for _, location := range locations {
+		err = c.Services.List(fmt.Sprintf("projects/%s/locations/%s", projectID, location)).
+			Pages(ctx, func(resp *run.GoogleCloudRunV2ListServicesResponse) error {
+				for _, svc := range resp.Services {
+					if svc.Labels[managedByLabel] == managedByFunctions {
+						continue
+					}
+
+					services = append(services, toResource(svc, projectID, location))
+				}
+
+				return nil
+			})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return services, nil
+}
+
+func toResource(svc *run.GoogleCloudRunV2Service, projectID, location string) models.Resource {
+	name := svc.Name[strings.LastIndex(svc.Name, "/")+1:]
+	size := getSize(svc)
+	minInstances := max(size.ServiceMinInstances, size.RevisionMinInstances)
+
+	return models.Resource{
+		Name:         name,
+		Type:         Service,
+		UID:          projectID + "/" + location + "/" + name,
+		Region:       location,
+		CreationTime: svc.CreateTime,
+		Status:       getState(minInstances),
+		Labels:       svc.Labels,
+		Settings: models.Settings{
+			"location":      location,
+			"min_instances": minInstances,
+		},
+	}
+}
+
+// StopService scales a Cloud Run service to zero by setting its minimum number of instances to zero, on the service
+// and on its revision template. The minimum number of instances before it was scaled down is returned.
+func (c *Client) StopService(ctx *gofr.Context, projectID, location, name string) (*Size, error) {
+	svc, err := c.Services.Get(serviceName(projectID, location, name)).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	size := getSize(svc)
+
+	err = c.update(ctx, svc, &Size{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &size, nil
+}
+
+// StartService restores the minimum number of instances of a Cloud Run service that was scaled to zero.
+func (c *Client) StartService(ctx *gofr.Context, projectID, location, name string, size *Size) error {
+	svc, err := c.Services.Get(serviceName(projectID, location, name)).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	return c.update(ctx, svc, size)
+}
+
+// update sets the minimum number of instances of a service. The service read from the API is written back, with its
+// etag, so that concurrent updates are rejected. Changing the revision template deploys a new revision, the update
+// is not waited for.
+func (c *Client) update(ctx *gofr.Context, svc *run.GoogleCloudRunV2Service, size *Size) error {
+	if svc.Scaling == nil {
+		svc.Scaling = &run.GoogleCloudRunV2ServiceScaling{}
+	}
+
+	svc.Scaling.MinInstanceCount = size.ServiceMinInstances
+	svc.Scaling.ForceSendFields = append(svc.Scaling.ForceSendFields, "MinInstanceCount")
+
+	if svc.Template == nil {
+		svc.Template = &run.GoogleCloudRunV2RevisionTemplate{}
+	}
+
+	if svc.Template.Scaling == nil {
+		svc.Template.Scaling = &run.GoogleCloudRunV2RevisionScaling{}
+	}
+
+	svc.Template.Scaling.MinInstanceCount = size.RevisionMinInstances
+	svc.Template.Scaling.ForceSendFields = append(svc.Template.Scaling.ForceSendFields, "MinInstanceCount")
+
+	_, err := c.Services.Patch(svc.Name, svc).Context(ctx).Do()
+
+	return err
+}
+
+func getSize(svc *run.GoogleCloudRunV2Service) Size {
+	var size Size
+
+	if svc.Scaling != nil {
+		size.ServiceMinInstances = svc.Scaling.MinInstanceCount
+	}
+
+	if svc.Template != nil && svc.Template.Scaling != nil {
+		size.RevisionMinInstances = svc.Template.Scaling.MinInstanceCount
+	}
+
+	return size
+}
+
+func serviceName(projectID, location, name string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/services/%s", projectID, location, name)
+}
+
+// getState maps the minimum number of instances of a service to RUNNING or STOPPED, services without a minimum
+// scale to zero when they are not serving requests.
+func getState(minInstances int64) string {
+	if minInstances == 0 {
+		return STOPPED
+	}
+
+	return RUNNING
+}
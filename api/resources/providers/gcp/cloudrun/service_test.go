@@ -0,0 +1,150 @@
+package cloudrun
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	runv1 "google.golang.org/api/run/v1"
+	"google.golang.org/api/run/v2"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/internal/gcptest"
+)
+
+const servicePath = "/v2/projects/test-project/locations/us-central1/services/api"
+
+func newClient(t *testing.T, url string) *Client {
+	t.Helper()
+
+	v1 := gcptest.NewService(t, runv1.NewService, url)
+	v2 := gcptest.NewService(t, run.NewService, url)
+
+	return &Client{Services: v2.Projects.Locations.Services, Locations: v1.Projects.Locations}
+}
+
+func TestClient_GetAllServices(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/projects/test-project/locations", func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &runv1.ListLocationsResponse{Locations: []*runv1.Location{
+			{LocationId: "us-central1"}, {LocationId: "europe-west1"},
+		}})
+	})
+	mux.HandleFunc("GET /v2/projects/test-project/locations/us-central1/services",
+		func(w http.ResponseWriter, _ *http.Request) {
+			gcptest.WriteJSON(w, &run.GoogleCloudRunV2ListServicesResponse{Services: []*run.GoogleCloudRunV2Service{
+				{Name: "projects/test-project/locations/us-central1/services/api", CreateTime: "2025-06-01T10:00:00Z",
+					Labels: map[string]string{"env": "dev"},
+					Template: &run.GoogleCloudRunV2RevisionTemplate{
+						Scaling: &run.GoogleCloudRunV2RevisionScaling{MinInstanceCount: 1}}},
+				{Name: "projects/test-project/locations/us-central1/services/hello",
+					Labels: map[string]string{"goog-managed-by": "cloudfunctions"}},
+			}})
+		})
+	mux.HandleFunc("GET /v2/projects/test-project/locations/europe-west1/services",
+		func(w http.ResponseWriter, _ *http.Request) {
+			gcptest.WriteJSON(w, &run.GoogleCloudRunV2ListServicesResponse{Services: []*run.GoogleCloudRunV2Service{
+				{Name: "projects/test-project/locations/europe-west1/services/web"},
+			}})
+		})
+
+	srv := gcptest.NewServer(t, mux)
+
+	services, err := newClient(t, srv.URL).GetAllServices(ctx, "test-project")
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{
+		{Name: "api", Type: Service, UID: "test-project/us-central1/api", Region: "us-central1",
+			CreationTime: "2025-06-01T10:00:00Z", Status: RUNNING, Labels: models.Labels{"env": "dev"},
+			Settings: models.Settings{"location": "us-central1", "min_instances": int64(1)}},
+		{Name: "web", Type: Service, UID: "test-project/europe-west1/web", Region: "europe-west1", Status: STOPPED,
+			Settings: models.Settings{"location": "europe-west1", "min_instances": int64(0)}},
+	}, services)
+}
+
+func TestClient_GetAllServices_Error(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/projects/test-project/locations", func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &runv1.ListLocationsResponse{Locations: []*runv1.Location{{LocationId: "us-central1"}}})
+	})
+
+	srv := gcptest.NewServer(t, mux)
+
+	// The services of the location cannot be listed.
+	services, err := newClient(t, srv.URL).GetAllServices(ctx, "test-project")
+
+	require.Error(t, err)
+	assert.Nil(t, services)
+
+	errSrv := gcptest.NewServer(t, gcptest.Error(http.StatusNotFound))
+
+	services, err = newClient(t, errSrv.URL).GetAllServices(ctx, "test-project")
+
+	require.Error(t, err)
+	assert.Nil(t, services)
+}
+
+func TestClient_StopStartService(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+
+	var patched []*run.GoogleCloudRunV2Service
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+servicePath, func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &run.GoogleCloudRunV2Service{Name: "projects/test-project/locations/us-central1/services/api",
+			Etag:    "etag-1",
+			Scaling: &run.GoogleCloudRunV2ServiceScaling{MinInstanceCount: 1},
+			Template: &run.GoogleCloudRunV2RevisionTemplate{
+				Scaling: &run.GoogleCloudRunV2RevisionScaling{MinInstanceCount: 2, MaxInstanceCount: 10}}})
+	})
+	mux.HandleFunc("PATCH "+servicePath, func(w http.ResponseWriter, r *http.Request) {
+		var svc run.GoogleCloudRunV2Service
+
+		_ = json.NewDecoder(r.Body).Decode(&svc)
+		patched = append(patched, &svc)
+
+		gcptest.WriteJSON(w, &run.GoogleLongrunningOperation{Name: "operation-1"})
+	})
+
+	srv := gcptest.NewServer(t, mux)
+
+	c := newClient(t, srv.URL)
+
+	size, err := c.StopService(ctx, "test-project", "us-central1", "api")
+
+	require.NoError(t, err)
+	assert.Equal(t, &Size{ServiceMinInstances: 1, RevisionMinInstances: 2}, size)
+	require.NoError(t, c.StartService(ctx, "test-project", "us-central1", "api", size))
+
+	require.Len(t, patched, 2)
+	// The service is written back with its etag and the other settings of its revision template.
+	assert.Equal(t, "etag-1", patched[0].Etag)
+	assert.Equal(t, &run.GoogleCloudRunV2ServiceScaling{}, patched[0].Scaling)
+	assert.Equal(t, &run.GoogleCloudRunV2RevisionScaling{MaxInstanceCount: 10}, patched[0].Template.Scaling)
+	assert.Equal(t, int64(1), patched[1].Scaling.MinInstanceCount)
+	assert.Equal(t, int64(2), patched[1].Template.Scaling.MinInstanceCount)
+}
+
+func TestClient_StopStartService_Error(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	srv := gcptest.NewServer(t, gcptest.Error(http.StatusInternalServerError))
+
+	c := newClient(t, srv.URL)
+
+	size, err := c.StopService(ctx, "test-project", "us-central1", "api")
+
+	require.Error(t, err)
+	assert.Nil(t, size)
+	require.Error(t, c.StartService(ctx, "test-project", "us-central1", "api", &Size{ServiceMinInstances: 1}))
+}
+
+func Test_getState(t *testing.T) {
+	assert.Equal(t, RUNNING, getState(1))
+	assert.Equal(t, STOPPED, getState(0))
+}
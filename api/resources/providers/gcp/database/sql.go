@@ -3,6 +3,8 @@ package sql
 import (
 	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"gofr.dev/pkg/gofr"
 	"google.golang.org/api/googleapi"
@@ -23,8 +25,25 @@ const (
 	ALWAYS = "ALWAYS"
 	// NEVER - The instance is never running.
 	NEVER = "NEVER"
+
+	// REGIONAL - The instance has a standby in another zone of the region.
+	REGIONAL = "REGIONAL"
+
+	mbPerGB = 1024
 )
 
+// sharedCoreTiers are the Cloud SQL tiers whose size is not encoded in the tier name.
+var sharedCoreTiers = map[string]models.Spec{
+	"db-f1-micro": {VCPU: 0.2, MemoryGB: 0.6},
+	"db-g1-small": {VCPU: 0.5, MemoryGB: 1.7},
+}
+
+// n1MemoryPerVCPU is the memory in GB per vCPU of the predefined db-n1 tiers, keyed by the machine class.
+var n1MemoryPerVCPU = map[string]float64{
+	"standard": 3.75,
+	"highmem":  6.5,
+}
+
 type Client struct {
 	SQL *sqladmin.InstancesService
 }
@@ -45,6 +64,8 @@ func (c *Client) GetAllInstances(_ *gofr.Context, projectID string) ([]models.Re
 			CreationTime: item.CreateTime,
 			UID:          projectID + "/" + item.Name,
 			Status:       getState(item.Settings.ActivationPolicy),
+			Labels:       item.Settings.UserLabels,
+			Spec:         getSpec(item.Settings),
 		})
 	}
 
@@ -62,6 +83,53 @@ func getState(state string) string {
 	}
 }
 
+// getSpec normalizes the tier, data disk size and availability type of a Cloud SQL instance.
+func getSpec(settings *sqladmin.Settings) models.Spec {
+	vcpu, memory := getTierSize(settings.Tier)
+
+	return models.Spec{
+		VCPU:             vcpu,
+		MemoryGB:         memory,
+		StorageGB:        settings.DataDiskSizeGb,
+		MachineClass:     settings.Tier,
+		HighAvailability: settings.AvailabilityType == REGIONAL,
+	}
+}
+
+// getTierSize returns the vCPUs and memory in GB of a Cloud SQL tier, e.g. db-custom-2-7680 or db-n1-standard-4.
+// Zero is returned for tiers whose size is not known.
+func getTierSize(tier string) (vcpu, memoryGB float64) {
+	if spec, ok := sharedCoreTiers[tier]; ok {
+		return spec.VCPU, spec.MemoryGB
+	}
+
+	parts := strings.Split(tier, "-")
+
+	switch {
+	case len(parts) == 4 && parts[1] == "custom":
+		cpus, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return 0, 0
+		}
+
+		memoryMB, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return 0, 0
+		}
+
+		return float64(cpus), float64(memoryMB) / mbPerGB
+	case len(parts) == 4 && parts[1] == "n1":
+		cpus, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return 0, 0
+		}
+
+		return float64(cpus), float64(cpus) * n1MemoryPerVCPU[parts[2]]
+	default:
+		return 0, 0
+	}
+}
+
 func (c *Client) StartInstance(_ *gofr.Context, projectID, instanceName string) error {
 	patchReq := &sqladmin.DatabaseInstance{
 		Settings: &sqladmin.Settings{
@@ -40,12 +40,16 @@ func getServer(t *testing.T, resp any, isError bool) *httptest.Server {
 func Test_GetAllInstances(t *testing.T) {
 	resp := &sqladmin.InstancesListResponse{
 		Items: []*sqladmin.DatabaseInstance{
-			{Name: "test-instance1", Project: "test-project", Settings: &sqladmin.Settings{ActivationPolicy: ALWAYS}},
+			{Name: "test-instance1", Project: "test-project", Settings: &sqladmin.Settings{ActivationPolicy: ALWAYS,
+				UserLabels: map[string]string{"team": "payments"}, Tier: "db-custom-2-7680", DataDiskSizeGb: 10,
+				AvailabilityType: REGIONAL}},
 			{Name: "test-instance2", Project: "test-project", Settings: &sqladmin.Settings{ActivationPolicy: NEVER}},
 			{Name: "test-instance3", Project: "test-project", Settings: &sqladmin.Settings{ActivationPolicy: "ON_DEMAND"}},
 		}}
 	result := []models.Resource{
-		{Name: "test-instance1", UID: "test-project/test-instance1", Type: "SQL", Status: RUNNING},
+		{Name: "test-instance1", UID: "test-project/test-instance1", Type: "SQL", Status: RUNNING,
+			Labels: models.Labels{"team": "payments"}, Spec: models.Spec{VCPU: 2, MemoryGB: 7.5, StorageGB: 10,
+				MachineClass: "db-custom-2-7680", HighAvailability: true}},
 		{Name: "test-instance2", UID: "test-project/test-instance2", Type: "SQL", Status: STOPPED},
 		{Name: "test-instance3", UID: "test-project/test-instance3", Type: "SQL", Status: STOPPED},
 	}
@@ -139,6 +143,30 @@ func TestClient_StopInstance(t *testing.T) {
 	require.Error(t, err)
 }
 
+func Test_getTierSize(t *testing.T) {
+	tests := []struct {
+		tier   string
+		vcpu   float64
+		memory float64
+	}{
+		{"db-custom-4-16384", 4, 16},
+		{"db-n1-standard-2", 2, 7.5},
+		{"db-n1-highmem-4", 4, 26},
+		{"db-f1-micro", 0.2, 0.6},
+		{"db-g1-small", 0.5, 1.7},
+		{"db-custom-x-1024", 0, 0},
+		{"db-perf-optimized-N-8", 0, 0},
+		{"", 0, 0},
+	}
+
+	for _, tc := range tests {
+		vcpu, memory := getTierSize(tc.tier)
+
+		assert.InDelta(t, tc.vcpu, vcpu, 0.001, tc.tier)
+		assert.InDelta(t, tc.memory, memory, 0.001, tc.tier)
+	}
+}
+
 func Test_getError(t *testing.T) {
 	err := &googleapi.Error{
 		Code:    http.StatusConflict,
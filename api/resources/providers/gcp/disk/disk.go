@@ -0,0 +1,178 @@
+package disk
+
+import (
+	"path"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const (
+	// ATTACHED disk state for zopdev, a disk used by an instance or a snapshot whose source disk still exists.
+	ATTACHED = "ATTACHED"
+	// UNATTACHED disk state for zopdev, a disk used by no instance or a snapshot whose source disk was deleted.
+	UNATTACHED = "UNATTACHED"
+
+	// Disk is the resource type used for Compute Engine persistent disks.
+	Disk = "GCP_DISK"
+	// Snapshot is the resource type used for Compute Engine disk snapshots.
+	Snapshot = "GCP_SNAPSHOT"
+
+	bytesPerGB = 1 << 30
+)
+
+// Client lists and deletes the persistent disks and the disk snapshots of a project. Zonal and regional disks are
+// listed together, they are deleted through the service of their scope.
+type Client struct {
+	Disks       *compute.DisksService
+	RegionDisks *compute.RegionDisksService
+	Snapshots   *compute.SnapshotsService
+}
+
+// GetAllDisks lists the zonal and regional persistent disks of the project using the aggregated list API.
+func (c *Client) GetAllDisks(ctx *gofr.Context, projectID string) ([]models.Resource, error) {
+	disks := make([]models.Resource, 0)
+
+	err := c.Disks.AggregatedList(projectID).Pages(ctx, func(list *compute.DiskAggregatedList) error {
+		for _, scope := range list.Items {
+			for _, item := range scope.Disks {
+				disks = append(disks, toResource(projectID, item))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return disks, nil
+}
+
+func toResource(projectID string, d *compute.Disk) models.Resource {
+	diskType := path.Base(d.Type)
+	settings := models.Settings{"disk_type": diskType}
+
+	var region, location string
+
+	if d.Zone != "" {
+		location = path.Base(d.Zone)
+		region = getRegion(location)
+		settings["zone"] = location
+	} else {
+		location = path.Base(d.Region)
+		region = location
+		settings["region"] = location
+	}
+
+	status := UNATTACHED
+	if len(d.Users) > 0 {
+		status = ATTACHED
+	}
+
+	if d.LastDetachTimestamp != "" {
+		settings["last_detached"] = d.LastDetachTimestamp
+	}
+
+	return models.Resource{
+		Name:         d.Name,
+		Type:         Disk,
+		UID:          projectID + "/" + location + "/" + d.Name,
+		Region:       region,
+		CreationTime: d.CreationTimestamp,
+		Status:       status,
+		Labels:       d.Labels,
+		Spec:         models.Spec{StorageGB: d.SizeGb, MachineClass: diskType},
+		Settings:     settings,
+	}
+}
+
+// GetAllSnapshots lists the disk snapshots of the project. A snapshot is orphaned, reported as UNATTACHED,
+// when its source disk no longer exists.
+func (c *Client) GetAllSnapshots(ctx *gofr.Context, projectID string) ([]models.Resource, error) {
+	disks := make(map[string]bool)
+
+	err := c.Disks.AggregatedList(projectID).Pages(ctx, func(list *compute.DiskAggregatedList) error {
+		for _, scope := range list.Items {
+			for _, item := range scope.Disks {
+				disks[item.SelfLink] = true
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]models.Resource, 0)
+
+	err = c.Snapshots.List(projectID).Pages(ctx, func(list *compute.SnapshotList) error {
+		for _, item := range list.Items {
+			status := UNATTACHED
+			if disks[item.SourceDisk] {
+				status = ATTACHED
+			}
+
+			var region string
+			if len(item.StorageLocations) > 0 {
+				region = item.StorageLocations[0]
+			}
+
+			snapshots = append(snapshots, models.Resource{
+				Name:         item.Name,
+				Type:         Snapshot,
+				UID:          projectID + "/" + item.Name,
+				Region:       region,
+				CreationTime: item.CreationTimestamp,
+				Status:       status,
+				Labels:       item.Labels,
+				Spec:         models.Spec{StorageGB: (item.StorageBytes + bytesPerGB - 1) / bytesPerGB},
+				Settings: models.Settings{
+					"source_disk":  strings.TrimPrefix(item.SourceDisk, "https://www.googleapis.com/compute/v1/"),
+					"disk_size_gb": item.DiskSizeGb,
+				},
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// DeleteDisk deletes a zonal disk, or a regional disk when the zone is empty.
+func (c *Client) DeleteDisk(ctx *gofr.Context, projectID, zone, region, name string) error {
+	var err error
+
+	if zone != "" {
+		_, err = c.Disks.Delete(projectID, zone, name).Context(ctx).Do()
+	} else {
+		_, err = c.RegionDisks.Delete(projectID, region, name).Context(ctx).Do()
+	}
+
+	return err
+}
+
+// DeleteSnapshot deletes a disk snapshot.
+func (c *Client) DeleteSnapshot(ctx *gofr.Context, projectID, name string) error {
+	_, err := c.Snapshots.Delete(projectID, name).Context(ctx).Do()
+
+	return err
+}
+
+// getRegion derives the region from a zone name, e.g. us-central1-a -> us-central1.
+func getRegion(zone string) string {
+	idx := strings.LastIndex(zone, "-")
+	if idx <= 0 {
+		return zone
+	}
+
+	return zone[:idx]
+}
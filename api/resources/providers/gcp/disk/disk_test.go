@@ -0,0 +1,134 @@
+package disk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/internal/gcptest"
+)
+
+const diskURL = "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-a/disks/data"
+
+func newClient(t *testing.T, url string) *Client {
+	t.Helper()
+
+	svc := gcptest.NewService(t, compute.NewService, url)
+
+	return &Client{Disks: svc.Disks, RegionDisks: svc.RegionDisks, Snapshots: svc.Snapshots}
+}
+
+func disksHandler(w http.ResponseWriter, _ *http.Request) {
+	gcptest.WriteJSON(w, &compute.DiskAggregatedList{Items: map[string]compute.DisksScopedList{
+		"zones/us-central1-a": {Disks: []*compute.Disk{
+			{Name: "data", SelfLink: diskURL, SizeGb: 100, CreationTimestamp: "2025-06-01T10:00:00Z",
+				Zone:  "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-a",
+				Type:  "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-a/diskTypes/pd-ssd",
+				Users: []string{"https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-a/instances/vm"}},
+		}},
+		"regions/us-east1": {Disks: []*compute.Disk{
+			{Name: "backup", SizeGb: 50, LastDetachTimestamp: "2025-07-01T10:00:00Z",
+				Region: "https://www.googleapis.com/compute/v1/projects/test-project/regions/us-east1",
+				Type:   "https://www.googleapis.com/compute/v1/projects/test-project/regions/us-east1/diskTypes/pd-balanced"},
+		}},
+	}})
+}
+
+func TestClient_GetAllDisks(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /projects/test-project/aggregated/disks", disksHandler)
+
+	srv := gcptest.NewServer(t, mux)
+
+	disks, err := newClient(t, srv.URL).GetAllDisks(ctx, "test-project")
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []models.Resource{
+		{Name: "data", Type: Disk, UID: "test-project/us-central1-a/data", Region: "us-central1",
+			CreationTime: "2025-06-01T10:00:00Z", Status: ATTACHED, Spec: models.Spec{StorageGB: 100, MachineClass: "pd-ssd"},
+			Settings: models.Settings{"disk_type": "pd-ssd", "zone": "us-central1-a"}},
+		{Name: "backup", Type: Disk, UID: "test-project/us-east1/backup", Region: "us-east1", Status: UNATTACHED,
+			Spec: models.Spec{StorageGB: 50, MachineClass: "pd-balanced"},
+			Settings: models.Settings{"disk_type": "pd-balanced", "region": "us-east1",
+				"last_detached": "2025-07-01T10:00:00Z"}},
+	}, disks)
+}
+
+func TestClient_GetAllSnapshots(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /projects/test-project/aggregated/disks", disksHandler)
+	mux.HandleFunc("GET /projects/test-project/global/snapshots", func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &compute.SnapshotList{Items: []*compute.Snapshot{
+			{Name: "data-daily", SourceDisk: diskURL, StorageBytes: 3 << 30, DiskSizeGb: 100,
+				StorageLocations: []string{"us"}, CreationTimestamp: "2025-06-02T10:00:00Z"},
+			{Name: "old", SourceDisk: "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-a/disks/gone",
+				StorageBytes: 1 << 20, DiskSizeGb: 10},
+		}})
+	})
+
+	srv := gcptest.NewServer(t, mux)
+
+	snapshots, err := newClient(t, srv.URL).GetAllSnapshots(ctx, "test-project")
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{
+		{Name: "data-daily", Type: Snapshot, UID: "test-project/data-daily", Region: "us",
+			CreationTime: "2025-06-02T10:00:00Z", Status: ATTACHED, Spec: models.Spec{StorageGB: 3},
+			Settings: models.Settings{"source_disk": "projects/test-project/zones/us-central1-a/disks/data",
+				"disk_size_gb": int64(100)}},
+		{Name: "old", Type: Snapshot, UID: "test-project/old", Status: UNATTACHED, Spec: models.Spec{StorageGB: 1},
+			Settings: models.Settings{"source_disk": "projects/test-project/zones/us-central1-a/disks/gone",
+				"disk_size_gb": int64(10)}},
+	}, snapshots)
+}
+
+func TestClient_GetAllSnapshots_Error(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	srv := gcptest.NewServer(t, gcptest.Error(http.StatusNotFound))
+
+	snapshots, err := newClient(t, srv.URL).GetAllSnapshots(ctx, "test-project")
+
+	require.Error(t, err)
+	assert.Nil(t, snapshots)
+
+	disks, err := newClient(t, srv.URL).GetAllDisks(ctx, "test-project")
+
+	require.Error(t, err)
+	assert.Nil(t, disks)
+}
+
+func TestClient_Delete(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	mux := http.NewServeMux()
+
+	var deleted []string
+
+	for _, p := range []string{"/projects/test-project/zones/us-central1-a/disks/data",
+		"/projects/test-project/regions/us-east1/disks/backup", "/projects/test-project/global/snapshots/old"} {
+		mux.HandleFunc("DELETE "+p, func(w http.ResponseWriter, r *http.Request) {
+			deleted = append(deleted, r.URL.Path)
+
+			gcptest.WriteJSON(w, &compute.Operation{Name: "op"})
+		})
+	}
+
+	srv := gcptest.NewServer(t, mux)
+
+	c := newClient(t, srv.URL)
+
+	require.NoError(t, c.DeleteDisk(ctx, "test-project", "us-central1-a", "", "data"))
+	require.NoError(t, c.DeleteDisk(ctx, "test-project", "", "us-east1", "backup"))
+	require.NoError(t, c.DeleteSnapshot(ctx, "test-project", "old"))
+	require.Error(t, c.DeleteSnapshot(ctx, "test-project", "missing"))
+
+	assert.Equal(t, []string{"/projects/test-project/zones/us-central1-a/disks/data",
+		"/projects/test-project/regions/us-east1/disks/backup", "/projects/test-project/global/snapshots/old"}, deleted)
+}
@@ -0,0 +1,144 @@
+package functions
+
+import (
+	"fmt"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+	"google.golang.org/api/cloudfunctions/v2"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const (
+	// RUNNING function state for zopdev, a function that keeps instances warm.
+	RUNNING = "RUNNING"
+	// STOPPED function state for zopdev, a function that scales to zero when it is not invoked.
+	STOPPED = "STOPPED"
+
+	// Function is the resource type used for 2nd gen Cloud Functions.
+	Function = "CLOUD_FUNCTION"
+
+	gen2            = "GEN_2"
+	active          = "ACTIVE"
+	minInstanceMask = "serviceConfig.minInstanceCount"
+)
+
+// Size is the minimum number of instances of a function, recorded when the function is scaled to zero and restored
+// when it is started again.
+type Size struct {
+	MinInstances int64 `json:"min_instances"`
+}
+
+// Client lists the 2nd gen Cloud Functions of a project and updates their minimum number of instances.
+type Client struct {
+	Functions *cloudfunctions.ProjectsLocationsFunctionsService
+}
+
+// GetAllFunctions lists the 2nd gen Cloud Functions of every location of the project, 1st gen functions are skipped.
+func (c *Client) GetAllFunctions(ctx *gofr.Context, projectID string) ([]models.Resource, error) {
+	functions := make([]models.Resource, 0)
+
+	err := c.Functions.List(fmt.Sprintf("projects/%s/locations/-", projectID)).
+		Pages(ctx, func(resp *cloudfunctions.ListFunctionsResponse) error {
+			for _, fn := range resp.Functions {
+				if fn.Environment != gen2 {
+					continue
+				}
+
+				functions = append(functions, toResource(fn, projectID))
+			}
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return functions, nil
+}
+
+// toResource converts a function, whose name is projects/{project}/locations/{location}/functions/{function}.
+func toResource(fn *cloudfunctions.Function, projectID string) models.Resource {
+	parts := strings.Split(fn.Name, "/")
+	location, name := parts[len(parts)-3], parts[len(parts)-1]
+
+	var (
+		minInstances int64
+		memory       string
+	)
+
+	if fn.ServiceConfig != nil {
+		minInstances = fn.ServiceConfig.MinInstanceCount
+		memory = fn.ServiceConfig.AvailableMemory
+	}
+
+	return models.Resource{
+		Name:         name,
+		Type:         Function,
+		UID:          projectID + "/" + location + "/" + name,
+		Region:       location,
+		CreationTime: fn.CreateTime,
+		Status:       getState(fn.State, minInstances),
+		Labels:       fn.Labels,
+		Settings: models.Settings{
+			"location":         location,
+			"min_instances":    minInstances,
+			"available_memory": memory,
+		},
+	}
+}
+
+// StopFunction scales a function to zero by setting its minimum number of instances to zero. The minimum number of
+// instances before it was scaled down is returned.
+func (c *Client) StopFunction(ctx *gofr.Context, projectID, location, name string) (*Size, error) {
+	fn, err := c.Functions.Get(functionName(projectID, location, name)).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	var size Size
+
+	if fn.ServiceConfig != nil {
+		size.MinInstances = fn.ServiceConfig.MinInstanceCount
+	}
+
+	err = c.update(ctx, fn.Name, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &size, nil
+}
+
+// StartFunction restores the minimum number of instances of a function that was scaled to zero.
+func (c *Client) StartFunction(ctx *gofr.Context, projectID, location, name string, size *Size) error {
+	return c.update(ctx, functionName(projectID, location, name), size.MinInstances)
+}
+
+// update sets the minimum number of instances of a function, the update is not waited for.
+func (c *Client) update(ctx *gofr.Context, name string, minInstances int64) error {
+	_, err := c.Functions.Patch(name, &cloudfunctions.Function{ServiceConfig: &cloudfunctions.ServiceConfig{
+		MinInstanceCount: minInstances, ForceSendFields: []string{"MinInstanceCount"},
+	}}).UpdateMask(minInstanceMask).Context(ctx).Do()
+
+	return err
+}
+
+func functionName(projectID, location, name string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/functions/%s", projectID, location, name)
+}
+
+// getState maps an active function to RUNNING or STOPPED by its minimum number of instances, other states are kept
+// as is.
+func getState(state string, minInstances int64) string {
+	if state != active {
+		return state
+	}
+
+	if minInstances == 0 {
+		return STOPPED
+	}
+
+	return RUNNING
+}
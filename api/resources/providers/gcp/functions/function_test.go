@@ -0,0 +1,114 @@
+package functions
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"google.golang.org/api/cloudfunctions/v2"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/internal/gcptest"
+)
+
+const functionPath = "/v2/projects/test-project/locations/us-central1/functions/hello"
+
+func newClient(t *testing.T, url string) *Client {
+	t.Helper()
+
+	svc := gcptest.NewService(t, cloudfunctions.NewService, url)
+
+	return &Client{Functions: svc.Projects.Locations.Functions}
+}
+
+func TestClient_GetAllFunctions(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/projects/test-project/locations/-/functions", func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &cloudfunctions.ListFunctionsResponse{Functions: []*cloudfunctions.Function{
+			{Name: "projects/test-project/locations/us-central1/functions/hello", Environment: "GEN_2",
+				State: "ACTIVE", CreateTime: "2025-06-01T10:00:00Z", Labels: map[string]string{"env": "dev"},
+				ServiceConfig: &cloudfunctions.ServiceConfig{MinInstanceCount: 2, AvailableMemory: "256M"}},
+			{Name: "projects/test-project/locations/us-central1/functions/legacy", Environment: "GEN_1",
+				State: "ACTIVE"},
+		}})
+	})
+
+	srv := gcptest.NewServer(t, mux)
+
+	functions, err := newClient(t, srv.URL).GetAllFunctions(ctx, "test-project")
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{
+		{Name: "hello", Type: Function, UID: "test-project/us-central1/hello", Region: "us-central1",
+			CreationTime: "2025-06-01T10:00:00Z", Status: RUNNING, Labels: models.Labels{"env": "dev"},
+			Settings: models.Settings{"location": "us-central1", "min_instances": int64(2), "available_memory": "256M"}},
+	}, functions)
+}
+
+func TestClient_GetAllFunctions_Error(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	srv := gcptest.NewServer(t, gcptest.Error(http.StatusInternalServerError))
+
+	functions, err := newClient(t, srv.URL).GetAllFunctions(ctx, "test-project")
+
+	require.Error(t, err)
+	assert.Nil(t, functions)
+}
+
+func TestClient_StopStartFunction(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+
+	var patches []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+functionPath, func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &cloudfunctions.Function{Name: "projects/test-project/locations/us-central1/functions/hello",
+			ServiceConfig: &cloudfunctions.ServiceConfig{MinInstanceCount: 2}})
+	})
+	mux.HandleFunc("PATCH "+functionPath, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		patches = append(patches, r.URL.Query().Get("updateMask")+" "+strings.TrimSpace(string(body)))
+
+		gcptest.WriteJSON(w, &cloudfunctions.Operation{Name: "operation-1"})
+	})
+
+	srv := gcptest.NewServer(t, mux)
+
+	c := newClient(t, srv.URL)
+
+	size, err := c.StopFunction(ctx, "test-project", "us-central1", "hello")
+
+	require.NoError(t, err)
+	assert.Equal(t, &Size{MinInstances: 2}, size)
+	require.NoError(t, c.StartFunction(ctx, "test-project", "us-central1", "hello", size))
+
+	assert.Equal(t, []string{
+		`serviceConfig.minInstanceCount {"serviceConfig":{"minInstanceCount":0}}`,
+		`serviceConfig.minInstanceCount {"serviceConfig":{"minInstanceCount":2}}`,
+	}, patches)
+}
+
+func TestClient_StopFunction_Error(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	srv := gcptest.NewServer(t, gcptest.Error(http.StatusInternalServerError))
+
+	c := newClient(t, srv.URL)
+
+	size, err := c.StopFunction(ctx, "test-project", "us-central1", "hello")
+
+	require.Error(t, err)
+	assert.Nil(t, size)
+	require.Error(t, c.StartFunction(ctx, "test-project", "us-central1", "hello", &Size{MinInstances: 1}))
+}
+
+func Test_getState(t *testing.T) {
+	assert.Equal(t, RUNNING, getState("ACTIVE", 1))
+	assert.Equal(t, STOPPED, getState("ACTIVE", 0))
+	assert.Equal(t, "DEPLOYING", getState("DEPLOYING", 1))
+}
@@ -6,12 +6,25 @@ import (
 	"errors"
 
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/cloudfunctions/v2"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/container/v1"
 	"google.golang.org/api/option"
+	"google.golang.org/api/redis/v1"
+	runv1 "google.golang.org/api/run/v1"
+	"google.golang.org/api/run/v2"
 	"google.golang.org/api/sqladmin/v1"
 
 	gmonitoring "cloud.google.com/go/monitoring/apiv3/v2"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/address"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/cloudrun"
 	sql "github.com/zopdev/zopdev/api/resources/providers/gcp/database"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/disk"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/functions"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/gke"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/memorystore"
 	metric "github.com/zopdev/zopdev/api/resources/providers/gcp/monitoring"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/vm"
 )
 
 var (
@@ -48,6 +61,83 @@ func (*Client) NewSQLClient(ctx context.Context, opts ...option.ClientOption) (S
 	return &sql.Client{SQL: admin.Instances}, nil
 }
 
+func (*Client) NewComputeClient(ctx context.Context, opts ...option.ClientOption) (ComputeClient, error) {
+	svc, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, ErrInitializingClient
+	}
+
+	return &vm.Client{Instances: svc.Instances}, nil
+}
+
+func (*Client) NewGKEClient(ctx context.Context, opts ...option.ClientOption) (GKEClient, error) {
+	ctr, err := container.NewService(ctx, opts...)
+	if err != nil {
+		return nil, ErrInitializingClient
+	}
+
+	svc, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, ErrInitializingClient
+	}
+
+	return &gke.Client{
+		Clusters:              ctr.Projects.Locations.Clusters,
+		Operations:            ctr.Projects.Locations.Operations,
+		InstanceGroupManagers: svc.InstanceGroupManagers,
+	}, nil
+}
+
+func (*Client) NewCloudRunClient(ctx context.Context, opts ...option.ClientOption) (CloudRunClient, error) {
+	v1, err := runv1.NewService(ctx, opts...)
+	if err != nil {
+		return nil, ErrInitializingClient
+	}
+
+	v2, err := run.NewService(ctx, opts...)
+	if err != nil {
+		return nil, ErrInitializingClient
+	}
+
+	return &cloudrun.Client{Services: v2.Projects.Locations.Services, Locations: v1.Projects.Locations}, nil
+}
+
+func (*Client) NewCloudFunctionsClient(ctx context.Context, opts ...option.ClientOption) (CloudFunctionsClient, error) {
+	svc, err := cloudfunctions.NewService(ctx, opts...)
+	if err != nil {
+		return nil, ErrInitializingClient
+	}
+
+	return &functions.Client{Functions: svc.Projects.Locations.Functions}, nil
+}
+
+func (*Client) NewDiskClient(ctx context.Context, opts ...option.ClientOption) (DiskClient, error) {
+	svc, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, ErrInitializingClient
+	}
+
+	return &disk.Client{Disks: svc.Disks, RegionDisks: svc.RegionDisks, Snapshots: svc.Snapshots}, nil
+}
+
+func (*Client) NewAddressClient(ctx context.Context, opts ...option.ClientOption) (AddressClient, error) {
+	svc, err := compute.NewService(ctx, opts...)
+	if err != nil {
+		return nil, ErrInitializingClient
+	}
+
+	return &address.Client{Addresses: svc.Addresses, GlobalAddresses: svc.GlobalAddresses}, nil
+}
+
+func (*Client) NewMemorystoreClient(ctx context.Context, opts ...option.ClientOption) (MemorystoreClient, error) {
+	svc, err := redis.NewService(ctx, opts...)
+	if err != nil {
+		return nil, ErrInitializingClient
+	}
+
+	return &memorystore.Client{Instances: svc.Projects.Locations.Instances}, nil
+}
+
 func (*Client) NewMetricsClient(ctx context.Context, opts ...option.ClientOption) (MetricsClient, error) {
 	mCl, err := gmonitoring.NewMetricClient(ctx, opts...)
 	if err != nil {
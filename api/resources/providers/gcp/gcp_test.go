@@ -53,6 +53,139 @@ func TestClient_NewSQLInstanceLister(t *testing.T) {
 	assert.Equal(t, ErrInitializingClient, err)
 }
 
+func TestClient_NewComputeClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+	}))
+
+	ctx := context.Background()
+	c := New()
+	gce, err := c.NewComputeClient(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+
+	require.NoError(t, err)
+	assert.NotNil(t, gce)
+
+	gce, err = c.NewComputeClient(ctx, option.WithoutAuthentication(), option.WithCredentialsFile("test.json"))
+
+	assert.Nil(t, gce)
+	require.Error(t, err)
+	assert.Equal(t, ErrInitializingClient, err)
+}
+
+func TestClient_NewGKEClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+	}))
+
+	ctx := context.Background()
+	c := New()
+	gkeCl, err := c.NewGKEClient(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+
+	require.NoError(t, err)
+	assert.NotNil(t, gkeCl)
+
+	gkeCl, err = c.NewGKEClient(ctx, option.WithoutAuthentication(), option.WithCredentialsFile("test.json"))
+
+	assert.Nil(t, gkeCl)
+	require.Error(t, err)
+	assert.Equal(t, ErrInitializingClient, err)
+}
+
+func TestClient_NewCloudRunClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+	}))
+
+	ctx := context.Background()
+	c := New()
+	run, err := c.NewCloudRunClient(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+
+	require.NoError(t, err)
+	assert.NotNil(t, run)
+
+	run, err = c.NewCloudRunClient(ctx, option.WithoutAuthentication(), option.WithCredentialsFile("test.json"))
+
+	assert.Nil(t, run)
+	require.Error(t, err)
+	assert.Equal(t, ErrInitializingClient, err)
+}
+
+func TestClient_NewCloudFunctionsClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+	}))
+
+	ctx := context.Background()
+	c := New()
+	fns, err := c.NewCloudFunctionsClient(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+
+	require.NoError(t, err)
+	assert.NotNil(t, fns)
+
+	fns, err = c.NewCloudFunctionsClient(ctx, option.WithoutAuthentication(), option.WithCredentialsFile("test.json"))
+
+	assert.Nil(t, fns)
+	require.Error(t, err)
+	assert.Equal(t, ErrInitializingClient, err)
+}
+
+func TestClient_NewDiskClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+	}))
+
+	ctx := context.Background()
+	c := New()
+	disks, err := c.NewDiskClient(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+
+	require.NoError(t, err)
+	assert.NotNil(t, disks)
+
+	disks, err = c.NewDiskClient(ctx, option.WithoutAuthentication(), option.WithCredentialsFile("test.json"))
+
+	assert.Nil(t, disks)
+	require.Error(t, err)
+	assert.Equal(t, ErrInitializingClient, err)
+}
+
+func TestClient_NewAddressClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+	}))
+
+	ctx := context.Background()
+	c := New()
+	addresses, err := c.NewAddressClient(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+
+	require.NoError(t, err)
+	assert.NotNil(t, addresses)
+
+	addresses, err = c.NewAddressClient(ctx, option.WithoutAuthentication(), option.WithCredentialsFile("test.json"))
+
+	assert.Nil(t, addresses)
+	require.Error(t, err)
+	assert.Equal(t, ErrInitializingClient, err)
+}
+
+func TestClient_NewMemorystoreClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+	}))
+
+	ctx := context.Background()
+	c := New()
+	caches, err := c.NewMemorystoreClient(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+
+	require.NoError(t, err)
+	assert.NotNil(t, caches)
+
+	caches, err = c.NewMemorystoreClient(ctx, option.WithoutAuthentication(), option.WithCredentialsFile("test.json"))
+
+	assert.Nil(t, caches)
+	require.Error(t, err)
+	assert.Equal(t, ErrInitializingClient, err)
+}
+
 func TestClient_NewMetricsClient(t *testing.T) {
 	ctx := context.Background()
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
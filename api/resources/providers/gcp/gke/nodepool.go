@@ -0,0 +1,268 @@
+package gke
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/container/v1"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const (
+	// RUNNING node pool state for zopdev.
+	RUNNING = "RUNNING"
+	// STOPPED node pool state for zopdev, a node pool scaled to zero nodes.
+	STOPPED = "STOPPED"
+
+	// NodePool is the resource type used for GKE node pools.
+	NodePool = "GKE_NODEPOOL"
+
+	operationDone         = "DONE"
+	operationPollInterval = 5 * time.Second
+	operationTimeout      = 2 * time.Minute
+)
+
+var errOperationTimeout = errors.New("timed out waiting for the node pool operation to complete")
+
+// Size is the node count and the autoscaling bounds of a node pool, recorded when the node pool is scaled to zero
+// and restored when it is started again. The node counts are per zone of the node pool, as in the GKE API.
+type Size struct {
+	NodeCount         int64  `json:"node_count"`
+	Autoscaling       bool   `json:"autoscaling"`
+	MinNodeCount      int64  `json:"min_node_count,omitempty"`
+	MaxNodeCount      int64  `json:"max_node_count,omitempty"`
+	TotalMinNodeCount int64  `json:"total_min_node_count,omitempty"`
+	TotalMaxNodeCount int64  `json:"total_max_node_count,omitempty"`
+	LocationPolicy    string `json:"location_policy,omitempty"`
+}
+
+// Client lists and scales the node pools of the GKE clusters of a project. The node counts of the node pools are
+// read from their instance group managers.
+type Client struct {
+	Clusters              *container.ProjectsLocationsClustersService
+	Operations            *container.ProjectsLocationsOperationsService
+	InstanceGroupManagers *compute.InstanceGroupManagersService
+}
+
+// GetAllNodePools lists the node pools of the standard GKE clusters of every location in the project. Autopilot
+// clusters are skipped as their nodes are managed by GKE.
+func (c *Client) GetAllNodePools(ctx *gofr.Context, projectID string) ([]models.Resource, error) {
+	resp, err := c.Clusters.List(fmt.Sprintf("projects/%s/locations/-", projectID)).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	nodePools := make([]models.Resource, 0)
+
+	for _, cl := range resp.Clusters {
+		if cl.Autopilot != nil && cl.Autopilot.Enabled {
+			continue
+		}
+
+		for _, np := range cl.NodePools {
+			count, err := c.nodeCount(ctx, np)
+			if err != nil {
+				return nil, err
+			}
+
+			var machineType string
+			if np.Config != nil {
+				machineType = np.Config.MachineType
+			}
+
+			nodePools = append(nodePools, models.Resource{
+				Name:         cl.Name + "/" + np.Name,
+				Type:         NodePool,
+				UID:          projectID + "/" + cl.Location + "/" + cl.Name + "/" + np.Name,
+				Region:       getRegion(cl.Location),
+				CreationTime: cl.CreateTime,
+				Status:       getState(np.Status, count),
+				Labels:       cl.ResourceLabels,
+				Spec:         models.Spec{MachineClass: machineType},
+				Settings: models.Settings{
+					"cluster":   cl.Name,
+					"location":  cl.Location,
+					"node_pool": np.Name,
+				},
+			})
+		}
+	}
+
+	return nodePools, nil
+}
+
+// StopNodePool scales a node pool to zero nodes, disabling its autoscaling first so that it is not scaled back up.
+// The size of the node pool before it was scaled down is returned, also when scaling it down fails after its
+// autoscaling was disabled.
+func (c *Client) StopNodePool(ctx *gofr.Context, projectID, location, cluster, nodePool string) (*Size, error) {
+	name := nodePoolName(projectID, location, cluster, nodePool)
+
+	np, err := c.Clusters.NodePools.Get(name).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := c.nodeCount(ctx, np)
+	if err != nil {
+		return nil, err
+	}
+
+	size := &Size{NodeCount: count}
+
+	if as := np.Autoscaling; as != nil && as.Enabled {
+		size.Autoscaling = true
+		size.MinNodeCount = as.MinNodeCount
+		size.MaxNodeCount = as.MaxNodeCount
+		size.TotalMinNodeCount = as.TotalMinNodeCount
+		size.TotalMaxNodeCount = as.TotalMaxNodeCount
+		size.LocationPolicy = as.LocationPolicy
+
+		err = c.setAutoscaling(ctx, projectID, location, name, &container.NodePoolAutoscaling{
+			Enabled: false, ForceSendFields: []string{"Enabled"}})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return size, c.setSize(ctx, name, 0)
+}
+
+// StartNodePool restores the size of a node pool that was scaled to zero, re-enabling its autoscaling before it is
+// scaled back up.
+func (c *Client) StartNodePool(ctx *gofr.Context, projectID, location, cluster, nodePool string, size *Size) error {
+	name := nodePoolName(projectID, location, cluster, nodePool)
+
+	if size.Autoscaling {
+		err := c.setAutoscaling(ctx, projectID, location, name, &container.NodePoolAutoscaling{
+			Enabled:           true,
+			MinNodeCount:      size.MinNodeCount,
+			MaxNodeCount:      size.MaxNodeCount,
+			TotalMinNodeCount: size.TotalMinNodeCount,
+			TotalMaxNodeCount: size.TotalMaxNodeCount,
+			LocationPolicy:    size.LocationPolicy,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.setSize(ctx, name, size.NodeCount)
+}
+
+// setAutoscaling updates the autoscaling of a node pool and waits for the update to complete, GKE rejects other
+// operations on the node pool while it is in progress.
+func (c *Client) setAutoscaling(ctx *gofr.Context, projectID, location, name string,
+	autoscaling *container.NodePoolAutoscaling) error {
+	op, err := c.Clusters.NodePools.SetAutoscaling(name, &container.SetNodePoolAutoscalingRequest{
+		Autoscaling: autoscaling}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	return c.waitOperation(ctx, fmt.Sprintf("projects/%s/locations/%s/operations/%s", projectID, location, op.Name), op)
+}
+
+// setSize resizes a node pool, the resize is not waited for and is tracked as an operation of the resource.
+func (c *Client) setSize(ctx *gofr.Context, name string, nodeCount int64) error {
+	_, err := c.Clusters.NodePools.SetSize(name, &container.SetNodePoolSizeRequest{
+		NodeCount: nodeCount, ForceSendFields: []string{"NodeCount"}}).Context(ctx).Do()
+
+	return err
+}
+
+func (c *Client) waitOperation(ctx *gofr.Context, name string, op *container.Operation) error {
+	deadline := time.Now().Add(operationTimeout)
+
+	for op.Status != operationDone {
+		if time.Now().After(deadline) {
+			return errOperationTimeout
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(operationPollInterval):
+		}
+
+		var err error
+
+		op, err = c.Operations.Get(name).Context(ctx).Do()
+		if err != nil {
+			return err
+		}
+	}
+
+	if op.Error != nil {
+		return errors.New(op.Error.Message)
+	}
+
+	return nil
+}
+
+// nodeCount returns the number of nodes per zone of a node pool, the largest target size of its instance group
+// managers.
+func (c *Client) nodeCount(ctx *gofr.Context, np *container.NodePool) (int64, error) {
+	var count int64
+
+	for _, url := range np.InstanceGroupUrls {
+		project, zone, name, ok := parseInstanceGroupURL(url)
+		if !ok {
+			continue
+		}
+
+		igm, err := c.InstanceGroupManagers.Get(project, zone, name).Context(ctx).Do()
+		if err != nil {
+			return 0, err
+		}
+
+		count = max(count, igm.TargetSize)
+	}
+
+	return count, nil
+}
+
+// parseInstanceGroupURL extracts the project, zone and name of an instance group manager from its URL, e.g.
+// https://www.googleapis.com/compute/v1/projects/p/zones/us-central1-a/instanceGroupManagers/gke-dev-pool-1234-grp.
+func parseInstanceGroupURL(url string) (project, zone, name string, ok bool) {
+	parts := strings.Split(url, "/")
+
+	for i := 0; i+5 < len(parts); i++ {
+		if parts[i] == "projects" && parts[i+2] == "zones" && parts[i+4] == "instanceGroupManagers" {
+			return parts[i+1], parts[i+3], parts[i+5], true
+		}
+	}
+
+	return "", "", "", false
+}
+
+func nodePoolName(projectID, location, cluster, nodePool string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/clusters/%s/nodePools/%s", projectID, location, cluster, nodePool)
+}
+
+// getState maps the node pool status to RUNNING or STOPPED by its node count, other statuses are kept as is.
+func getState(status string, nodeCount int64) string {
+	switch status {
+	case "RUNNING", "RUNNING_WITH_ERROR":
+		if nodeCount == 0 {
+			return STOPPED
+		}
+
+		return RUNNING
+	default:
+		return status
+	}
+}
+
+// getRegion returns the region of a cluster location, which is either a region or a zone, e.g. us-central1-a.
+func getRegion(location string) string {
+	if strings.Count(location, "-") < 2 {
+		return location
+	}
+
+	return location[:strings.LastIndex(location, "-")]
+}
@@ -0,0 +1,190 @@
+package gke
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/container/v1"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/internal/gcptest"
+)
+
+const (
+	poolPath = "/v1/projects/test-project/locations/us-central1-a/clusters/dev/nodePools/pool-1"
+	igmURL   = "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-a/" +
+		"instanceGroupManagers/gke-dev-pool-1-grp"
+)
+
+func newClient(t *testing.T, url string) *Client {
+	t.Helper()
+
+	ctr := gcptest.NewService(t, container.NewService, url)
+	svc := gcptest.NewService(t, compute.NewService, url)
+
+	return &Client{
+		Clusters:              ctr.Projects.Locations.Clusters,
+		Operations:            ctr.Projects.Locations.Operations,
+		InstanceGroupManagers: svc.InstanceGroupManagers,
+	}
+}
+
+func igmHandler(targetSize int64) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &compute.InstanceGroupManager{Name: "gke-dev-pool-1-grp", TargetSize: targetSize})
+	}
+}
+
+func TestClient_GetAllNodePools(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/projects/test-project/locations/-/clusters", func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &container.ListClustersResponse{Clusters: []*container.Cluster{
+			{
+				Name: "dev", Location: "us-central1-a", CreateTime: "2025-06-01T10:00:00+00:00",
+				ResourceLabels: map[string]string{"env": "dev"},
+				NodePools: []*container.NodePool{
+					{Name: "pool-1", Status: "RUNNING", Config: &container.NodeConfig{MachineType: "e2-medium"},
+						InstanceGroupUrls: []string{igmURL}},
+					{Name: "pool-2", Status: "PROVISIONING"},
+				},
+			},
+			{Name: "auto", Location: "us-central1", Autopilot: &container.Autopilot{Enabled: true},
+				NodePools: []*container.NodePool{{Name: "default-pool", Status: "RUNNING"}}},
+		}})
+	})
+	mux.HandleFunc("/projects/test-project/zones/us-central1-a/instanceGroupManagers/gke-dev-pool-1-grp", igmHandler(3))
+
+	srv := gcptest.NewServer(t, mux)
+
+	expected := []models.Resource{
+		{Name: "dev/pool-1", Type: NodePool, UID: "test-project/us-central1-a/dev/pool-1", Region: "us-central1",
+			CreationTime: "2025-06-01T10:00:00+00:00", Status: RUNNING, Labels: models.Labels{"env": "dev"},
+			Spec:     models.Spec{MachineClass: "e2-medium"},
+			Settings: models.Settings{"cluster": "dev", "location": "us-central1-a", "node_pool": "pool-1"}},
+		{Name: "dev/pool-2", Type: NodePool, UID: "test-project/us-central1-a/dev/pool-2", Region: "us-central1",
+			CreationTime: "2025-06-01T10:00:00+00:00", Status: "PROVISIONING", Labels: models.Labels{"env": "dev"},
+			Settings: models.Settings{"cluster": "dev", "location": "us-central1-a", "node_pool": "pool-2"}},
+	}
+
+	nodePools, err := newClient(t, srv.URL).GetAllNodePools(ctx, "test-project")
+
+	require.NoError(t, err)
+	assert.Equal(t, expected, nodePools)
+}
+
+func TestClient_GetAllNodePools_Error(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+
+	srv := gcptest.NewServer(t, gcptest.Error(http.StatusInternalServerError))
+
+	nodePools, err := newClient(t, srv.URL).GetAllNodePools(ctx, "test-project")
+
+	require.Error(t, err)
+	assert.Nil(t, nodePools)
+}
+
+func TestClient_StopStartNodePool(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+
+	var calls []string
+
+	record := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		calls = append(calls, r.URL.Path+" "+strings.TrimSpace(string(body)))
+
+		gcptest.WriteJSON(w, &container.Operation{Name: "operation-1", Status: operationDone})
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(poolPath, func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &container.NodePool{Name: "pool-1", InstanceGroupUrls: []string{igmURL},
+			Autoscaling: &container.NodePoolAutoscaling{Enabled: true, MinNodeCount: 1, MaxNodeCount: 5}})
+	})
+	mux.HandleFunc(poolPath+":setAutoscaling", record)
+	mux.HandleFunc(poolPath+":setSize", record)
+	mux.HandleFunc("/projects/test-project/zones/us-central1-a/instanceGroupManagers/gke-dev-pool-1-grp", igmHandler(2))
+
+	srv := gcptest.NewServer(t, mux)
+
+	c := newClient(t, srv.URL)
+
+	size, err := c.StopNodePool(ctx, "test-project", "us-central1-a", "dev", "pool-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, &Size{NodeCount: 2, Autoscaling: true, MinNodeCount: 1, MaxNodeCount: 5}, size)
+
+	require.NoError(t, c.StartNodePool(ctx, "test-project", "us-central1-a", "dev", "pool-1", size))
+
+	assert.Equal(t, []string{
+		poolPath + `:setAutoscaling {"autoscaling":{"enabled":false}}`,
+		poolPath + `:setSize {"nodeCount":0}`,
+		poolPath + `:setAutoscaling {"autoscaling":{"enabled":true,"maxNodeCount":5,"minNodeCount":1}}`,
+		poolPath + `:setSize {"nodeCount":2}`,
+	}, calls)
+}
+
+func TestClient_StopNodePool_OperationError(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(poolPath, func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &container.NodePool{Name: "pool-1",
+			Autoscaling: &container.NodePoolAutoscaling{Enabled: true, MaxNodeCount: 3}})
+	})
+	mux.HandleFunc(poolPath+":setAutoscaling", func(w http.ResponseWriter, _ *http.Request) {
+		gcptest.WriteJSON(w, &container.Operation{Name: "operation-1", Status: operationDone,
+			Error: &container.Status{Message: "node pool is being updated"}})
+	})
+
+	srv := gcptest.NewServer(t, mux)
+
+	size, err := newClient(t, srv.URL).StopNodePool(ctx, "test-project", "us-central1-a", "dev", "pool-1")
+
+	require.EqualError(t, err, "node pool is being updated")
+	assert.Nil(t, size)
+}
+
+func TestClient_StartNodePool_Error(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+
+	srv := gcptest.NewServer(t, gcptest.Error(http.StatusInternalServerError))
+
+	c := newClient(t, srv.URL)
+
+	require.Error(t, c.StartNodePool(ctx, "test-project", "us-central1-a", "dev", "pool-1", &Size{NodeCount: 1}))
+
+	size, err := c.StopNodePool(ctx, "test-project", "us-central1-a", "dev", "pool-1")
+
+	require.Error(t, err)
+	assert.Nil(t, size)
+}
+
+func Test_parseInstanceGroupURL(t *testing.T) {
+	project, zone, name, ok := parseInstanceGroupURL(igmURL)
+
+	assert.True(t, ok)
+	assert.Equal(t, []string{"test-project", "us-central1-a", "gke-dev-pool-1-grp"}, []string{project, zone, name})
+
+	_, _, _, ok = parseInstanceGroupURL("https://www.googleapis.com/compute/v1/projects/test-project")
+	assert.False(t, ok)
+}
+
+func Test_getState(t *testing.T) {
+	assert.Equal(t, RUNNING, getState("RUNNING", 2))
+	assert.Equal(t, RUNNING, getState("RUNNING_WITH_ERROR", 1))
+	assert.Equal(t, STOPPED, getState("RUNNING", 0))
+	assert.Equal(t, "RECONCILING", getState("RECONCILING", 2))
+}
+
+func Test_getRegion(t *testing.T) {
+	assert.Equal(t, "us-central1", getRegion("us-central1-a"))
+	assert.Equal(t, "us-central1", getRegion("us-central1"))
+}
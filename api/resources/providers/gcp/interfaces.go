@@ -6,6 +6,9 @@ import (
 	"gofr.dev/pkg/gofr"
 
 	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/cloudrun"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/functions"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/gke"
 )
 
 type SQLClient interface {
@@ -13,8 +16,49 @@ type SQLClient interface {
 	Idler
 }
 
+type ComputeClient interface {
+	InstanceLister
+	ZonalIdler
+	GetInstance(ctx *gofr.Context, projectID, zone, instanceName string) (*models.Resource, error)
+}
+
+type GKEClient interface {
+	GetAllNodePools(ctx *gofr.Context, projectID string) ([]models.Resource, error)
+	StopNodePool(ctx *gofr.Context, projectID, location, cluster, nodePool string) (*gke.Size, error)
+	StartNodePool(ctx *gofr.Context, projectID, location, cluster, nodePool string, size *gke.Size) error
+}
+
+type CloudRunClient interface {
+	GetAllServices(ctx *gofr.Context, projectID string) ([]models.Resource, error)
+	StopService(ctx *gofr.Context, projectID, location, name string) (*cloudrun.Size, error)
+	StartService(ctx *gofr.Context, projectID, location, name string, size *cloudrun.Size) error
+}
+
+type CloudFunctionsClient interface {
+	GetAllFunctions(ctx *gofr.Context, projectID string) ([]models.Resource, error)
+	StopFunction(ctx *gofr.Context, projectID, location, name string) (*functions.Size, error)
+	StartFunction(ctx *gofr.Context, projectID, location, name string, size *functions.Size) error
+}
+
+type DiskClient interface {
+	GetAllDisks(ctx *gofr.Context, projectID string) ([]models.Resource, error)
+	GetAllSnapshots(ctx *gofr.Context, projectID string) ([]models.Resource, error)
+	DeleteDisk(ctx *gofr.Context, projectID, zone, region, name string) error
+	DeleteSnapshot(ctx *gofr.Context, projectID, name string) error
+}
+
+type AddressClient interface {
+	GetAllAddresses(ctx *gofr.Context, projectID string) ([]models.Resource, error)
+	DeleteAddress(ctx *gofr.Context, projectID, region, name string) error
+}
+
+type MemorystoreClient interface {
+	InstanceLister
+}
+
 type MetricsClient interface {
 	TimeSeriesLister
+	GetPeakValues(ctx *gofr.Context, start, end time.Time, projectID, filter string) ([]models.Metric, error)
 }
 
 type InstanceLister interface {
@@ -29,3 +73,8 @@ type Idler interface {
 	StartInstance(ctx *gofr.Context, projectID, instanceName string) error
 	StopInstance(ctx *gofr.Context, projectID, instanceName string) error
 }
+
+type ZonalIdler interface {
+	StartInstance(ctx *gofr.Context, projectID, zone, instanceName string) error
+	StopInstance(ctx *gofr.Context, projectID, zone, instanceName string) error
+}
@@ -0,0 +1,56 @@
+// Package gcptest serves fake Google Cloud APIs to the tests of the GCP providers.
+package gcptest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/api/option"
+)
+
+// NewServer starts a fake Google Cloud API served by h, the server is closed when the test ends.
+func NewServer(t *testing.T, h http.Handler) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// NewService creates a client of a Google Cloud API, e.g. compute.NewService, calling the fake server at url without
+// authentication.
+func NewService[T any](t *testing.T, newService func(context.Context, ...option.ClientOption) (T, error),
+	url string) T {
+	t.Helper()
+
+	svc, err := newService(context.Background(), option.WithoutAuthentication(), option.WithEndpoint(url))
+	if err != nil {
+		t.Fatalf("failed to create the client of the fake server: %v", err)
+	}
+
+	return svc
+}
+
+// JSON returns a handler replying to every request with resp.
+func JSON(resp any) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		WriteJSON(w, resp)
+	}
+}
+
+// Error returns a handler replying to every request with an error status.
+func Error(status int) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, http.StatusText(status), status)
+	}
+}
+
+// WriteJSON writes resp as the JSON body of a response.
+func WriteJSON(w http.ResponseWriter, resp any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,87 @@
+package memorystore
+
+import (
+	"fmt"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+	"google.golang.org/api/redis/v1"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const (
+	// AVAILABLE instance state for zopdev, an instance that is ready to serve requests. It is not reported as
+	// RUNNING since the instances are not started or stopped.
+	AVAILABLE = "AVAILABLE"
+
+	// Instance is the resource type used for Memorystore for Redis instances.
+	Instance = "MEMORYSTORE"
+
+	ready          = "READY"
+	standardHATier = "STANDARD_HA"
+)
+
+// Client lists the Memorystore for Redis instances of a project. The instances cannot be stopped, they are only
+// inventoried.
+type Client struct {
+	Instances *redis.ProjectsLocationsInstancesService
+}
+
+// GetAllInstances lists the Memorystore for Redis instances of every location of the project.
+func (c *Client) GetAllInstances(ctx *gofr.Context, projectID string) ([]models.Resource, error) {
+	instances := make([]models.Resource, 0)
+
+	err := c.Instances.List(fmt.Sprintf("projects/%s/locations/-", projectID)).
+		Pages(ctx, func(resp *redis.ListInstancesResponse) error {
+			for _, ins := range resp.Instances {
+				instances = append(instances, toResource(ins, projectID))
+			}
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return instances, nil
+}
+
+// toResource converts an instance, whose name is projects/{project}/locations/{region}/instances/{instance}.
+func toResource(ins *redis.Instance, projectID string) models.Resource {
+	parts := strings.Split(ins.Name, "/")
+	region, name := parts[len(parts)-3], parts[len(parts)-1]
+
+	// A Basic Tier instance has a single node, a Standard Tier instance a primary node and its replicas.
+	nodes := int64(len(ins.Nodes))
+	if nodes == 0 {
+		nodes = 1 + ins.ReplicaCount
+	}
+
+	status := ins.State
+	if status == ready {
+		status = AVAILABLE
+	}
+
+	return models.Resource{
+		Name:         name,
+		Type:         Instance,
+		UID:          projectID + "/" + region + "/" + name,
+		Region:       region,
+		CreationTime: ins.CreateTime,
+		Status:       status,
+		Labels:       ins.Labels,
+		Spec: models.Spec{
+			MemoryGB:         float64(ins.MemorySizeGb),
+			MachineClass:     ins.Tier,
+			HighAvailability: ins.Tier == standardHATier,
+		},
+		Settings: models.Settings{
+			"tier":           ins.Tier,
+			"memory_size_gb": ins.MemorySizeGb,
+			"node_count":     nodes,
+			"redis_version":  ins.RedisVersion,
+			"zone":           ins.LocationId,
+		},
+	}
+}
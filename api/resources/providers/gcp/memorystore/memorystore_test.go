@@ -0,0 +1,63 @@
+package memorystore
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"google.golang.org/api/redis/v1"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/internal/gcptest"
+)
+
+func newClient(t *testing.T, url string) *Client {
+	t.Helper()
+
+	svc := gcptest.NewService(t, redis.NewService, url)
+
+	return &Client{Instances: svc.Projects.Locations.Instances}
+}
+
+func TestClient_GetAllInstances(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	srv := gcptest.NewServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/projects/test-project/locations/-/instances", r.URL.Path)
+
+		gcptest.WriteJSON(w, &redis.ListInstancesResponse{Instances: []*redis.Instance{
+			{Name: "projects/test-project/locations/us-central1/instances/sessions", Tier: "STANDARD_HA",
+				MemorySizeGb: 5, State: "READY", LocationId: "us-central1-a", RedisVersion: "REDIS_7_0",
+				CreateTime: "2025-06-01T10:00:00Z", ReplicaCount: 2, Labels: map[string]string{"env": "dev"}},
+			{Name: "projects/test-project/locations/europe-west1/instances/cache", Tier: "BASIC",
+				MemorySizeGb: 1, State: "CREATING", LocationId: "europe-west1-b"},
+		}})
+	}))
+
+	instances, err := newClient(t, srv.URL).GetAllInstances(ctx, "test-project")
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{
+		{Name: "sessions", Type: Instance, UID: "test-project/us-central1/sessions", Region: "us-central1",
+			CreationTime: "2025-06-01T10:00:00Z", Status: AVAILABLE, Labels: models.Labels{"env": "dev"},
+			Spec: models.Spec{MemoryGB: 5, MachineClass: "STANDARD_HA", HighAvailability: true},
+			Settings: models.Settings{"tier": "STANDARD_HA", "memory_size_gb": int64(5), "node_count": int64(3),
+				"redis_version": "REDIS_7_0", "zone": "us-central1-a"}},
+		{Name: "cache", Type: Instance, UID: "test-project/europe-west1/cache", Region: "europe-west1",
+			Status: "CREATING", Spec: models.Spec{MemoryGB: 1, MachineClass: "BASIC"},
+			Settings: models.Settings{"tier": "BASIC", "memory_size_gb": int64(1), "node_count": int64(1),
+				"redis_version": "", "zone": "europe-west1-b"}},
+	}, instances)
+}
+
+func TestClient_GetAllInstances_Error(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	srv := gcptest.NewServer(t, gcptest.Error(http.StatusNotFound))
+
+	instances, err := newClient(t, srv.URL).GetAllInstances(ctx, "test-project")
+
+	require.Error(t, err)
+	assert.Nil(t, instances)
+}
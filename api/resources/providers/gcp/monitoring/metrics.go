@@ -8,6 +8,7 @@ import (
 	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
 	"gofr.dev/pkg/gofr"
 	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zopdev/zopdev/api/resources/models"
@@ -18,17 +19,38 @@ type Client struct {
 }
 
 func (c *Client) GetTimeSeries(ctx *gofr.Context, start, end time.Time, projectID, filter string) ([]models.Metric, error) {
+	return c.listTimeSeries(ctx, &monitoringpb.ListTimeSeriesRequest{
+		Name:   "projects/" + projectID,
+		Filter: filter,
+		Interval: &monitoringpb.TimeInterval{
+			StartTime: timestamppb.New(start),
+			EndTime:   timestamppb.New(end),
+		},
+		View: monitoringpb.ListTimeSeriesRequest_FULL,
+	})
+}
+
+// GetPeakValues returns the peak value of each time series matching the filter over the time range, the points of
+// a time series are aligned to a single point holding their maximum.
+func (c *Client) GetPeakValues(ctx *gofr.Context, start, end time.Time, projectID, filter string) ([]models.Metric, error) {
+	return c.listTimeSeries(ctx, &monitoringpb.ListTimeSeriesRequest{
+		Name:   "projects/" + projectID,
+		Filter: filter,
+		Interval: &monitoringpb.TimeInterval{
+			StartTime: timestamppb.New(start),
+			EndTime:   timestamppb.New(end),
+		},
+		Aggregation: &monitoringpb.Aggregation{
+			AlignmentPeriod:  durationpb.New(end.Sub(start)),
+			PerSeriesAligner: monitoringpb.Aggregation_ALIGN_MAX,
+		},
+		View: monitoringpb.ListTimeSeriesRequest_FULL,
+	})
+}
+
+func (c *Client) listTimeSeries(ctx *gofr.Context, req *monitoringpb.ListTimeSeriesRequest) ([]models.Metric, error) {
 	var (
 		metrics = make([]models.Metric, 0)
-		req     = &monitoringpb.ListTimeSeriesRequest{
-			Name:   "projects/" + projectID,
-			Filter: filter,
-			Interval: &monitoringpb.TimeInterval{
-				StartTime: timestamppb.New(start),
-				EndTime:   timestamppb.New(end),
-			},
-			View: monitoringpb.ListTimeSeriesRequest_FULL,
-		}
 
 		// Create an iterator to list time series
 		it = c.MetricClient.ListTimeSeries(ctx, req)
@@ -169,3 +169,49 @@ func TestClient_GetTimeSeries_Error(t *testing.T) {
 	require.Nil(t, resp)
 	assert.Error(t, er)
 }
+
+func TestClient_GetPeakValues(t *testing.T) {
+	grpcSrv, fakeServerAddr := getGRPCServer(t, false)
+	defer grpcSrv.Stop()
+
+	metricClient, err := monitoring.NewMetricClient(
+		context.Background(),
+		option.WithEndpoint(fakeServerAddr),
+		option.WithoutAuthentication(),
+		option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := Client{metricClient}
+	endTime := time.Now()
+	startTime := endTime.Add(-24 * time.Hour)
+
+	resp, er := client.GetPeakValues(&gofr.Context{Context: context.Background()}, startTime, endTime, "test-project", "")
+
+	require.NoError(t, er)
+	assert.Len(t, resp, 5)
+	assert.Equal(t, models.Metric{Point: int64(1)}, resp[0])
+}
+
+func TestClient_GetPeakValues_Error(t *testing.T) {
+	grpcSrv, fakeServerAddr := getGRPCServer(t, true)
+	defer grpcSrv.Stop()
+
+	metricClient, err := monitoring.NewMetricClient(
+		context.Background(),
+		option.WithEndpoint(fakeServerAddr),
+		option.WithoutAuthentication(),
+		option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := Client{metricClient}
+	endTime := time.Now()
+	resp, er := client.GetPeakValues(&gofr.Context{Context: context.Background()},
+		endTime.Add(-time.Hour), endTime, "test-project", "")
+
+	require.Nil(t, resp)
+	assert.Error(t, er)
+}
@@ -1 +1,220 @@
 package vm
+
+import (
+	"errors"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const (
+	// RUNNING instance state for zopdev.
+	RUNNING = "RUNNING"
+	// STOPPED instance state for zopdev.
+	STOPPED = "STOPPED"
+	// STARTING instance state for zopdev.
+	STARTING = "STARTING"
+	// STOPPING instance state for zopdev.
+	STOPPING = "STOPPING"
+
+	// GCE is the resource type used for Compute Engine instances.
+	GCE = "GCE"
+
+	mbPerGB = 1024
+)
+
+// sharedCoreTypes are the machine types whose size is not encoded in the machine type name.
+var sharedCoreTypes = map[string]models.Spec{
+	"e2-micro":  {VCPU: 0.25, MemoryGB: 1},
+	"e2-small":  {VCPU: 0.5, MemoryGB: 2},
+	"e2-medium": {VCPU: 1, MemoryGB: 4},
+	"f1-micro":  {VCPU: 0.2, MemoryGB: 0.6},
+	"g1-small":  {VCPU: 0.5, MemoryGB: 1.7},
+}
+
+// memoryPerVCPU is the memory in GB per vCPU of the predefined machine types, keyed by the machine class.
+// The N1 series is sized differently and has its own ratios.
+var (
+	memoryPerVCPU = map[string]float64{
+		"standard": 4,
+		"highmem":  8,
+		"highcpu":  1,
+	}
+	n1MemoryPerVCPU = map[string]float64{
+		"standard": 3.75,
+		"highmem":  6.5,
+		"highcpu":  0.9,
+	}
+)
+
+type Client struct {
+	Instances *compute.InstancesService
+}
+
+// GetAllInstances lists the Compute Engine instances of every zone in the project using the aggregated list API.
+func (c *Client) GetAllInstances(ctx *gofr.Context, projectID string) ([]models.Resource, error) {
+	instances := make([]models.Resource, 0)
+
+	err := c.Instances.AggregatedList(projectID).Pages(ctx, func(list *compute.InstanceAggregatedList) error {
+		for _, scope := range list.Items {
+			for _, item := range scope.Instances {
+				instances = append(instances, toResource(projectID, item))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return instances, nil
+}
+
+// GetInstance fetches the current state of a Compute Engine instance of a zone, without listing the instances of
+// the project.
+func (c *Client) GetInstance(ctx *gofr.Context, projectID, zone, instanceName string) (*models.Resource, error) {
+	item, err := c.Instances.Get(projectID, zone, instanceName).Context(ctx).Do()
+	if err != nil {
+		var gErr *googleapi.Error
+		if errors.As(err, &gErr) && gErr.Code == http.StatusNotFound {
+			return nil, gofrHttp.ErrorEntityNotFound{Name: "instance", Value: instanceName}
+		}
+
+		return nil, err
+	}
+
+	res := toResource(projectID, item)
+
+	return &res, nil
+}
+
+// toResource converts a Compute Engine instance of a project to a resource.
+func toResource(projectID string, item *compute.Instance) models.Resource {
+	zone := path.Base(item.Zone)
+	machineType := path.Base(item.MachineType)
+
+	return models.Resource{
+		Name:         item.Name,
+		Type:         GCE,
+		Region:       getRegion(zone),
+		CreationTime: item.CreationTimestamp,
+		UID:          projectID + "/" + zone + "/" + item.Name,
+		Status:       getState(item.Status),
+		Labels:       item.Labels,
+		Spec:         getSpec(machineType, item.Disks),
+		Settings: models.Settings{
+			"InstanceType": machineType,
+			"zone":         zone,
+		},
+	}
+}
+
+// StartInstance starts a stopped Compute Engine instance in the given zone.
+func (c *Client) StartInstance(ctx *gofr.Context, projectID, zone, instanceName string) error {
+	_, err := c.Instances.Start(projectID, zone, instanceName).Context(ctx).Do()
+
+	return err
+}
+
+// StopInstance stops a running Compute Engine instance in the given zone.
+func (c *Client) StopInstance(ctx *gofr.Context, projectID, zone, instanceName string) error {
+	_, err := c.Instances.Stop(projectID, zone, instanceName).Context(ctx).Do()
+
+	return err
+}
+
+// getState maps the Compute Engine instance status to RUNNING, STOPPED, STARTING, STOPPING, or the original status.
+func getState(status string) string {
+	switch status {
+	case "RUNNING":
+		return RUNNING
+	case "STOPPED", "SUSPENDED", "TERMINATED":
+		return STOPPED
+	case "PROVISIONING", "STAGING":
+		return STARTING
+	case "STOPPING", "SUSPENDING":
+		return STOPPING
+	default:
+		return status
+	}
+}
+
+// getSpec normalizes the machine type and the attached disks of a Compute Engine instance.
+func getSpec(machineType string, disks []*compute.AttachedDisk) models.Spec {
+	spec := getMachineTypeSize(machineType)
+	spec.MachineClass = machineType
+
+	for _, disk := range disks {
+		spec.StorageGB += disk.DiskSizeGb
+	}
+
+	return spec
+}
+
+// getMachineTypeSize returns the vCPUs and memory of a machine type, e.g. n2-standard-4 or n2-custom-4-16384.
+// Zero is returned for machine types whose size is not known.
+func getMachineTypeSize(machineType string) models.Spec {
+	if spec, ok := sharedCoreTypes[machineType]; ok {
+		return spec
+	}
+
+	parts := strings.Split(machineType, "-")
+
+	// Custom N1 machine types have no series prefix, e.g. custom-4-16384.
+	if parts[0] == "custom" {
+		parts = append([]string{"n1"}, parts...)
+	}
+
+	if len(parts) < 3 {
+		return models.Spec{}
+	}
+
+	if parts[1] == "custom" {
+		if len(parts) < 4 {
+			return models.Spec{}
+		}
+
+		cpus, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return models.Spec{}
+		}
+
+		memoryMB, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return models.Spec{}
+		}
+
+		return models.Spec{VCPU: float64(cpus), MemoryGB: float64(memoryMB) / mbPerGB}
+	}
+
+	cpus, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return models.Spec{}
+	}
+
+	ratio := memoryPerVCPU[parts[1]]
+	if parts[0] == "n1" {
+		ratio = n1MemoryPerVCPU[parts[1]]
+	}
+
+	return models.Spec{VCPU: float64(cpus), MemoryGB: float64(cpus) * ratio}
+}
+
+// getRegion derives the region from a zone name, e.g. us-central1-a -> us-central1.
+func getRegion(zone string) string {
+	idx := strings.LastIndex(zone, "-")
+	if idx <= 0 {
+		return zone
+	}
+
+	return zone[:idx]
+}
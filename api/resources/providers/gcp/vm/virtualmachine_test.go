@@ -0,0 +1,177 @@
+package vm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/internal/gcptest"
+)
+
+func newClient(t *testing.T, url string) *Client {
+	t.Helper()
+
+	svc := gcptest.NewService(t, compute.NewService, url)
+
+	return &Client{Instances: svc.Instances}
+}
+
+func Test_GetAllInstances(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+	resp := &compute.InstanceAggregatedList{
+		Items: map[string]compute.InstancesScopedList{
+			"zones/us-central1-a": {Instances: []*compute.Instance{
+				{
+					Name:              "vm-1",
+					Zone:              "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-a",
+					MachineType:       "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-a/machineTypes/e2-medium",
+					Status:            "RUNNING",
+					CreationTimestamp: "2025-06-01T10:00:00.000-07:00",
+					Labels:            map[string]string{"env": "dev"},
+					Disks:             []*compute.AttachedDisk{{DiskSizeGb: 10}, {DiskSizeGb: 100}},
+				},
+			}},
+			"zones/europe-west1-b": {Instances: []*compute.Instance{
+				{
+					Name:        "vm-2",
+					Zone:        "https://www.googleapis.com/compute/v1/projects/test-project/zones/europe-west1-b",
+					MachineType: "https://www.googleapis.com/compute/v1/projects/test-project/zones/europe-west1-b/machineTypes/n2-standard-4",
+					Status:      "TERMINATED",
+				},
+			}},
+			"zones/asia-south1-c": {},
+		},
+	}
+	expected := []models.Resource{
+		{Name: "vm-1", Type: GCE, Region: "us-central1", CreationTime: "2025-06-01T10:00:00.000-07:00",
+			UID: "test-project/us-central1-a/vm-1", Status: RUNNING, Labels: models.Labels{"env": "dev"},
+			Settings: models.Settings{"InstanceType": "e2-medium", "zone": "us-central1-a"},
+			Spec:     models.Spec{VCPU: 1, MemoryGB: 4, StorageGB: 110, MachineClass: "e2-medium"}},
+		{Name: "vm-2", Type: GCE, Region: "europe-west1",
+			UID: "test-project/europe-west1-b/vm-2", Status: STOPPED,
+			Settings: models.Settings{"InstanceType": "n2-standard-4", "zone": "europe-west1-b"},
+			Spec:     models.Spec{VCPU: 4, MemoryGB: 16, MachineClass: "n2-standard-4"}},
+	}
+
+	srv := gcptest.NewServer(t, gcptest.JSON(resp))
+
+	c := newClient(t, srv.URL)
+
+	instances, err := c.GetAllInstances(ctx, "test-project")
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, expected, instances)
+}
+
+func Test_GetAllInstances_Error(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+
+	srv := gcptest.NewServer(t, gcptest.Error(http.StatusInternalServerError))
+
+	c := newClient(t, srv.URL)
+
+	instances, err := c.GetAllInstances(ctx, "test-project")
+
+	require.Error(t, err)
+	assert.Nil(t, instances)
+}
+
+func TestClient_StartStopInstance(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+
+	srv := gcptest.NewServer(t, gcptest.JSON(&compute.Operation{Name: "operation-1"}))
+
+	c := newClient(t, srv.URL)
+
+	require.NoError(t, c.StartInstance(ctx, "test-project", "us-central1-a", "vm-1"))
+	require.NoError(t, c.StopInstance(ctx, "test-project", "us-central1-a", "vm-1"))
+
+	errSrv := gcptest.NewServer(t, gcptest.Error(http.StatusInternalServerError))
+
+	c = newClient(t, errSrv.URL)
+
+	require.Error(t, c.StartInstance(ctx, "test-project", "us-central1-a", "vm-1"))
+	require.Error(t, c.StopInstance(ctx, "test-project", "us-central1-a", "vm-1"))
+}
+
+func TestClient_GetInstance(t *testing.T) {
+	ctx := &gofr.Context{Context: context.Background()}
+
+	srv := gcptest.NewServer(t, gcptest.JSON(&compute.Instance{
+		Name:        "vm-1",
+		Zone:        "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-a",
+		MachineType: "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-a/machineTypes/e2-medium",
+		Status:      "STOPPING",
+	}))
+
+	c := newClient(t, srv.URL)
+
+	res, err := c.GetInstance(ctx, "test-project", "us-central1-a", "vm-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.Resource{Name: "vm-1", Type: GCE, Region: "us-central1",
+		UID: "test-project/us-central1-a/vm-1", Status: STOPPING,
+		Settings: models.Settings{"InstanceType": "e2-medium", "zone": "us-central1-a"},
+		Spec:     models.Spec{VCPU: 1, MemoryGB: 4, MachineClass: "e2-medium"}}, res)
+
+	// The instance was deleted.
+	notFound := gcptest.NewServer(t, gcptest.Error(http.StatusNotFound))
+
+	c = newClient(t, notFound.URL)
+
+	res, err = c.GetInstance(ctx, "test-project", "us-central1-a", "vm-1")
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "instance", Value: "vm-1"}, err)
+	assert.Nil(t, res)
+
+	errSrv := gcptest.NewServer(t, gcptest.Error(http.StatusInternalServerError))
+
+	c = newClient(t, errSrv.URL)
+
+	res, err = c.GetInstance(ctx, "test-project", "us-central1-a", "vm-1")
+
+	require.Error(t, err)
+	assert.Nil(t, res)
+}
+
+func Test_getState(t *testing.T) {
+	assert.Equal(t, RUNNING, getState("RUNNING"))
+	assert.Equal(t, STOPPED, getState("TERMINATED"))
+	assert.Equal(t, STOPPED, getState("SUSPENDED"))
+	assert.Equal(t, STARTING, getState("PROVISIONING"))
+	assert.Equal(t, STOPPING, getState("SUSPENDING"))
+	assert.Equal(t, "REPAIRING", getState("REPAIRING"))
+}
+
+func Test_getRegion(t *testing.T) {
+	assert.Equal(t, "us-central1", getRegion("us-central1-a"))
+	assert.Equal(t, "global", getRegion("global"))
+}
+
+func Test_getMachineTypeSize(t *testing.T) {
+	tests := []struct {
+		machineType string
+		expected    models.Spec
+	}{
+		{"e2-standard-2", models.Spec{VCPU: 2, MemoryGB: 8}},
+		{"n2-highmem-4", models.Spec{VCPU: 4, MemoryGB: 32}},
+		{"n1-standard-2", models.Spec{VCPU: 2, MemoryGB: 7.5}},
+		{"n2-custom-4-16384", models.Spec{VCPU: 4, MemoryGB: 16}},
+		{"custom-2-6144", models.Spec{VCPU: 2, MemoryGB: 6}},
+		{"f1-micro", models.Spec{VCPU: 0.2, MemoryGB: 0.6}},
+		{"m1-megamem-96", models.Spec{VCPU: 96}},
+		{"e2-custom-medium-4096", models.Spec{}},
+		{"unknown", models.Spec{}},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, getMachineTypeSize(tc.machineType), tc.machineType)
+	}
+}
@@ -0,0 +1,320 @@
+package database
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/database"
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const (
+	// RUNNING instance state for zopdev.
+	RUNNING = "RUNNING"
+	// STOPPED instance state for zopdev.
+	STOPPED = "STOPPED"
+	// STARTING instance state for zopdev.
+	STARTING = "STARTING"
+	// STOPPING instance state for zopdev.
+	STOPPING = "STOPPING"
+
+	// DBSystemType is the zopdev resource type of OCI DB systems.
+	DBSystemType = "OCI_DB_SYSTEM"
+	// AutonomousDBType is the zopdev resource type of OCI Autonomous Databases.
+	AutonomousDBType = "OCI_AUTONOMOUS_DB"
+
+	gbPerTB = 1024
+)
+
+// DatabaseAPI defines the methods used from the OCI Database client for easier testing/mocking.
+type DatabaseAPI interface {
+	ListDbSystems(ctx context.Context, request database.ListDbSystemsRequest) (database.ListDbSystemsResponse, error)
+	ListDbNodes(ctx context.Context, request database.ListDbNodesRequest) (database.ListDbNodesResponse, error)
+	DbNodeAction(ctx context.Context, request database.DbNodeActionRequest) (database.DbNodeActionResponse, error)
+	ListAutonomousDatabases(ctx context.Context,
+		request database.ListAutonomousDatabasesRequest) (database.ListAutonomousDatabasesResponse, error)
+	StartAutonomousDatabase(ctx context.Context,
+		request database.StartAutonomousDatabaseRequest) (database.StartAutonomousDatabaseResponse, error)
+	StopAutonomousDatabase(ctx context.Context,
+		request database.StopAutonomousDatabaseRequest) (database.StopAutonomousDatabaseResponse, error)
+}
+
+// Client lists and idles the DB systems and Autonomous Databases of an OCI compartment.
+type Client struct {
+	DB            DatabaseAPI
+	CompartmentID string
+	Region        string
+}
+
+// GetAllDBSystems lists the DB systems of the compartment, following the pagination tokens. A DB system has no
+// stopped lifecycle state, its status is derived from the states of its DB nodes. Terminated DB systems are skipped.
+func (c *Client) GetAllDBSystems(ctx *gofr.Context) ([]models.Resource, error) {
+	systems := make([]models.Resource, 0)
+	request := database.ListDbSystemsRequest{CompartmentId: common.String(c.CompartmentID)}
+
+	for {
+		response, err := c.DB.ListDbSystems(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range response.Items {
+			system := &response.Items[i]
+
+			if system.LifecycleState == database.DbSystemSummaryLifecycleStateTerminated {
+				continue
+			}
+
+			nodes, err := c.getDBNodes(ctx, stringValue(system.Id))
+			if err != nil {
+				return nil, err
+			}
+
+			systems = append(systems, models.Resource{
+				Name:         stringValue(system.DisplayName),
+				Type:         DBSystemType,
+				UID:          stringValue(system.Id),
+				Region:       c.Region,
+				CreationTime: formatTime(system.TimeCreated),
+				Status:       getDBSystemState(system.LifecycleState, nodes),
+				Labels:       getLabels(system.FreeformTags),
+				Settings: map[string]any{
+					"shape":               stringValue(system.Shape),
+					"database_edition":    string(system.DatabaseEdition),
+					"availability_domain": stringValue(system.AvailabilityDomain),
+				},
+				Spec: models.Spec{
+					VCPU:             float64(intValue(system.CpuCoreCount)),
+					MemoryGB:         float64(intValue(system.MemorySizeInGBs)),
+					StorageGB:        int64(intValue(system.DataStorageSizeInGBs)),
+					MachineClass:     stringValue(system.Shape),
+					HighAvailability: intValue(system.NodeCount) > 1,
+				},
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			})
+		}
+
+		if stringValue(response.OpcNextPage) == "" {
+			return systems, nil
+		}
+
+		request.Page = response.OpcNextPage
+	}
+}
+
+// StartDBSystem starts all the DB nodes of a DB system.
+func (c *Client) StartDBSystem(ctx *gofr.Context, dbSystemID string) error {
+	return c.dbNodesAction(ctx, dbSystemID, database.DbNodeActionActionStart)
+}
+
+// StopDBSystem stops all the DB nodes of a DB system.
+func (c *Client) StopDBSystem(ctx *gofr.Context, dbSystemID string) error {
+	return c.dbNodesAction(ctx, dbSystemID, database.DbNodeActionActionStop)
+}
+
+func (c *Client) dbNodesAction(ctx *gofr.Context, dbSystemID string, action database.DbNodeActionActionEnum) error {
+	nodes, err := c.getDBNodes(ctx, dbSystemID)
+	if err != nil {
+		return err
+	}
+
+	for i := range nodes {
+		_, err = c.DB.DbNodeAction(ctx, database.DbNodeActionRequest{DbNodeId: nodes[i].Id, Action: action})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getDBNodes lists the DB nodes of a DB system, following the pagination tokens.
+func (c *Client) getDBNodes(ctx *gofr.Context, dbSystemID string) ([]database.DbNodeSummary, error) {
+	var nodes []database.DbNodeSummary
+
+	request := database.ListDbNodesRequest{
+		CompartmentId: common.String(c.CompartmentID),
+		DbSystemId:    common.String(dbSystemID),
+	}
+
+	for {
+		response, err := c.DB.ListDbNodes(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, response.Items...)
+
+		if stringValue(response.OpcNextPage) == "" {
+			return nodes, nil
+		}
+
+		request.Page = response.OpcNextPage
+	}
+}
+
+// GetAllAutonomousDatabases lists the Autonomous Databases of the compartment, following the pagination tokens.
+// Terminated databases are skipped.
+func (c *Client) GetAllAutonomousDatabases(ctx *gofr.Context) ([]models.Resource, error) {
+	dbs := make([]models.Resource, 0)
+	request := database.ListAutonomousDatabasesRequest{CompartmentId: common.String(c.CompartmentID)}
+
+	for {
+		response, err := c.DB.ListAutonomousDatabases(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range response.Items {
+			db := &response.Items[i]
+
+			if db.LifecycleState == database.AutonomousDatabaseSummaryLifecycleStateTerminated {
+				continue
+			}
+
+			name := stringValue(db.DisplayName)
+			if name == "" {
+				name = stringValue(db.DbName)
+			}
+
+			storageGB := int64(intValue(db.DataStorageSizeInGBs))
+			if storageGB == 0 {
+				storageGB = int64(intValue(db.DataStorageSizeInTBs)) * gbPerTB
+			}
+
+			dbs = append(dbs, models.Resource{
+				Name:         name,
+				Type:         AutonomousDBType,
+				UID:          stringValue(db.Id),
+				Region:       c.Region,
+				CreationTime: formatTime(db.TimeCreated),
+				Status:       getAutonomousDBState(db.LifecycleState),
+				Labels:       getLabels(db.FreeformTags),
+				Settings: map[string]any{
+					"db_name":       stringValue(db.DbName),
+					"db_version":    stringValue(db.DbVersion),
+					"compute_model": string(db.ComputeModel),
+					"is_free_tier":  db.IsFreeTier != nil && *db.IsFreeTier,
+				},
+				Spec: models.Spec{
+					VCPU:      float64(float32Value(db.ComputeCount)),
+					StorageGB: storageGB,
+				},
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			})
+		}
+
+		if stringValue(response.OpcNextPage) == "" {
+			return dbs, nil
+		}
+
+		request.Page = response.OpcNextPage
+	}
+}
+
+func (c *Client) StartAutonomousDatabase(ctx *gofr.Context, id string) error {
+	_, err := c.DB.StartAutonomousDatabase(ctx, database.StartAutonomousDatabaseRequest{
+		AutonomousDatabaseId: common.String(id),
+	})
+
+	return err
+}
+
+func (c *Client) StopAutonomousDatabase(ctx *gofr.Context, id string) error {
+	_, err := c.DB.StopAutonomousDatabase(ctx, database.StopAutonomousDatabaseRequest{
+		AutonomousDatabaseId: common.String(id),
+	})
+
+	return err
+}
+
+// getDBSystemState derives the zopdev state of a DB system from the states of its DB nodes. A DB system is stopped
+// once all its nodes are stopped and running while any node is available, other lifecycle states are kept as is.
+func getDBSystemState(state database.DbSystemSummaryLifecycleStateEnum, nodes []database.DbNodeSummary) string {
+	if state != database.DbSystemSummaryLifecycleStateAvailable || len(nodes) == 0 {
+		return string(state)
+	}
+
+	counts := make(map[database.DbNodeSummaryLifecycleStateEnum]int)
+
+	for i := range nodes {
+		counts[nodes[i].LifecycleState]++
+	}
+
+	switch {
+	case counts[database.DbNodeSummaryLifecycleStateStarting] > 0:
+		return STARTING
+	case counts[database.DbNodeSummaryLifecycleStateStopping] > 0:
+		return STOPPING
+	case counts[database.DbNodeSummaryLifecycleStateAvailable] > 0:
+		return RUNNING
+	case counts[database.DbNodeSummaryLifecycleStateStopped] == len(nodes):
+		return STOPPED
+	default:
+		return string(nodes[0].LifecycleState)
+	}
+}
+
+// getAutonomousDBState maps the Autonomous Database lifecycle state to the zopdev state, other states are kept as is.
+func getAutonomousDBState(state database.AutonomousDatabaseSummaryLifecycleStateEnum) string {
+	switch state {
+	case database.AutonomousDatabaseSummaryLifecycleStateAvailable,
+		database.AutonomousDatabaseSummaryLifecycleStateAvailableNeedsAttention:
+		return RUNNING
+	case database.AutonomousDatabaseSummaryLifecycleStateStopped:
+		return STOPPED
+	case database.AutonomousDatabaseSummaryLifecycleStateStarting:
+		return STARTING
+	case database.AutonomousDatabaseSummaryLifecycleStateStopping:
+		return STOPPING
+	default:
+		return string(state)
+	}
+}
+
+// getLabels converts the freeform tags of a database to resource labels.
+func getLabels(tags map[string]string) models.Labels {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return maps.Clone(tags)
+}
+
+func formatTime(t *common.SDKTime) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func intValue(i *int) int {
+	if i == nil {
+		return 0
+	}
+
+	return *i
+}
+
+func float32Value(f *float32) float32 {
+	if f == nil {
+		return 0
+	}
+
+	return *f
+}
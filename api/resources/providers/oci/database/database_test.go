@@ -0,0 +1,208 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+type mockDB struct {
+	systems   []database.DbSystemSummary
+	nodes     map[string][]database.DbNodeSummary
+	dbs       []database.AutonomousDatabaseSummary
+	actions   []string
+	shouldErr bool
+}
+
+func (m *mockDB) ListDbSystems(_ context.Context,
+	_ database.ListDbSystemsRequest) (database.ListDbSystemsResponse, error) {
+	if m.shouldErr {
+		return database.ListDbSystemsResponse{}, assert.AnError
+	}
+
+	return database.ListDbSystemsResponse{Items: m.systems}, nil
+}
+
+func (m *mockDB) ListDbNodes(_ context.Context, req database.ListDbNodesRequest) (database.ListDbNodesResponse, error) {
+	if m.shouldErr {
+		return database.ListDbNodesResponse{}, assert.AnError
+	}
+
+	return database.ListDbNodesResponse{Items: m.nodes[*req.DbSystemId]}, nil
+}
+
+func (m *mockDB) DbNodeAction(_ context.Context, req database.DbNodeActionRequest) (database.DbNodeActionResponse, error) {
+	m.actions = append(m.actions, string(req.Action)+" "+*req.DbNodeId)
+
+	return database.DbNodeActionResponse{}, nil
+}
+
+func (m *mockDB) ListAutonomousDatabases(_ context.Context,
+	_ database.ListAutonomousDatabasesRequest) (database.ListAutonomousDatabasesResponse, error) {
+	if m.shouldErr {
+		return database.ListAutonomousDatabasesResponse{}, assert.AnError
+	}
+
+	return database.ListAutonomousDatabasesResponse{Items: m.dbs}, nil
+}
+
+func (m *mockDB) StartAutonomousDatabase(_ context.Context,
+	req database.StartAutonomousDatabaseRequest) (database.StartAutonomousDatabaseResponse, error) {
+	if m.shouldErr {
+		return database.StartAutonomousDatabaseResponse{}, assert.AnError
+	}
+
+	m.actions = append(m.actions, "START "+*req.AutonomousDatabaseId)
+
+	return database.StartAutonomousDatabaseResponse{}, nil
+}
+
+func (m *mockDB) StopAutonomousDatabase(_ context.Context,
+	req database.StopAutonomousDatabaseRequest) (database.StopAutonomousDatabaseResponse, error) {
+	if m.shouldErr {
+		return database.StopAutonomousDatabaseResponse{}, assert.AnError
+	}
+
+	m.actions = append(m.actions, "STOP "+*req.AutonomousDatabaseId)
+
+	return database.StopAutonomousDatabaseResponse{}, nil
+}
+
+func node(id string, state database.DbNodeSummaryLifecycleStateEnum) database.DbNodeSummary {
+	return database.DbNodeSummary{Id: common.String(id), LifecycleState: state}
+}
+
+func Test_GetAllDBSystems(t *testing.T) {
+	mock := &mockDB{
+		systems: []database.DbSystemSummary{
+			{
+				Id: common.String("ocid1.dbsystem.1"), DisplayName: common.String("db-1"),
+				Shape: common.String("VM.Standard2.2"), CpuCoreCount: common.Int(2), MemorySizeInGBs: common.Int(30),
+				DataStorageSizeInGBs: common.Int(256), NodeCount: common.Int(2),
+				LifecycleState: database.DbSystemSummaryLifecycleStateAvailable,
+				FreeformTags:   map[string]string{"team": "data"},
+			},
+			{
+				Id: common.String("ocid1.dbsystem.2"), DisplayName: common.String("db-2"),
+				LifecycleState: database.DbSystemSummaryLifecycleStateAvailable,
+			},
+			{Id: common.String("ocid1.dbsystem.3"), LifecycleState: database.DbSystemSummaryLifecycleStateTerminated},
+		},
+		nodes: map[string][]database.DbNodeSummary{
+			"ocid1.dbsystem.1": {node("n1", database.DbNodeSummaryLifecycleStateAvailable),
+				node("n2", database.DbNodeSummaryLifecycleStateAvailable)},
+			"ocid1.dbsystem.2": {node("n3", database.DbNodeSummaryLifecycleStateStopped)},
+		},
+	}
+	client := &Client{DB: mock, CompartmentID: "ocid1.compartment", Region: "us-ashburn-1"}
+
+	systems, err := client.GetAllDBSystems(nil)
+
+	require.NoError(t, err)
+	require.Len(t, systems, 2)
+	assert.Equal(t, "db-1", systems[0].Name)
+	assert.Equal(t, DBSystemType, systems[0].Type)
+	assert.Equal(t, "us-ashburn-1", systems[0].Region)
+	assert.Equal(t, RUNNING, systems[0].Status)
+	assert.Equal(t, models.Labels{"team": "data"}, systems[0].Labels)
+	assert.Equal(t, models.Spec{VCPU: 2, MemoryGB: 30, StorageGB: 256, MachineClass: "VM.Standard2.2",
+		HighAvailability: true}, systems[0].Spec)
+	assert.Equal(t, STOPPED, systems[1].Status)
+
+	mock.shouldErr = true
+
+	systems, err = client.GetAllDBSystems(nil)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, systems)
+}
+
+func Test_StartStopDBSystem(t *testing.T) {
+	mock := &mockDB{nodes: map[string][]database.DbNodeSummary{
+		"ocid1.dbsystem.1": {node("n1", database.DbNodeSummaryLifecycleStateStopped),
+			node("n2", database.DbNodeSummaryLifecycleStateStopped)},
+	}}
+	client := &Client{DB: mock}
+
+	require.NoError(t, client.StartDBSystem(nil, "ocid1.dbsystem.1"))
+	require.NoError(t, client.StopDBSystem(nil, "ocid1.dbsystem.1"))
+	assert.Equal(t, []string{"START n1", "START n2", "STOP n1", "STOP n2"}, mock.actions)
+
+	mock.shouldErr = true
+
+	require.ErrorIs(t, client.StopDBSystem(nil, "ocid1.dbsystem.1"), assert.AnError)
+}
+
+func Test_GetAllAutonomousDatabases(t *testing.T) {
+	mock := &mockDB{dbs: []database.AutonomousDatabaseSummary{
+		{
+			Id: common.String("ocid1.autonomousdatabase.1"), DbName: common.String("adb1"),
+			DisplayName: common.String("adb-1"), DataStorageSizeInTBs: common.Int(1), ComputeCount: common.Float32(2),
+			LifecycleState: database.AutonomousDatabaseSummaryLifecycleStateAvailable, IsFreeTier: common.Bool(true),
+		},
+		{
+			Id: common.String("ocid1.autonomousdatabase.2"), DbName: common.String("adb2"),
+			DataStorageSizeInGBs: common.Int(20),
+			LifecycleState:       database.AutonomousDatabaseSummaryLifecycleStateStopped,
+		},
+		{
+			Id:             common.String("ocid1.autonomousdatabase.3"),
+			LifecycleState: database.AutonomousDatabaseSummaryLifecycleStateTerminated,
+		},
+	}}
+	client := &Client{DB: mock, Region: "us-ashburn-1"}
+
+	dbs, err := client.GetAllAutonomousDatabases(nil)
+
+	require.NoError(t, err)
+	require.Len(t, dbs, 2)
+	assert.Equal(t, "adb-1", dbs[0].Name)
+	assert.Equal(t, AutonomousDBType, dbs[0].Type)
+	assert.Equal(t, RUNNING, dbs[0].Status)
+	assert.Equal(t, true, dbs[0].Settings["is_free_tier"])
+	assert.Equal(t, models.Spec{VCPU: 2, StorageGB: 1024}, dbs[0].Spec)
+	// The database name is used for the databases without a display name.
+	assert.Equal(t, "adb2", dbs[1].Name)
+	assert.Equal(t, STOPPED, dbs[1].Status)
+	assert.Equal(t, int64(20), dbs[1].Spec.StorageGB)
+
+	mock.shouldErr = true
+
+	dbs, err = client.GetAllAutonomousDatabases(nil)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, dbs)
+}
+
+func Test_StartStopAutonomousDatabase(t *testing.T) {
+	mock := &mockDB{}
+	client := &Client{DB: mock}
+
+	require.NoError(t, client.StartAutonomousDatabase(nil, "ocid1.autonomousdatabase.1"))
+	require.NoError(t, client.StopAutonomousDatabase(nil, "ocid1.autonomousdatabase.1"))
+	assert.Equal(t, []string{"START ocid1.autonomousdatabase.1", "STOP ocid1.autonomousdatabase.1"}, mock.actions)
+
+	mock.shouldErr = true
+
+	require.ErrorIs(t, client.StartAutonomousDatabase(nil, "ocid1.autonomousdatabase.1"), assert.AnError)
+	require.ErrorIs(t, client.StopAutonomousDatabase(nil, "ocid1.autonomousdatabase.1"), assert.AnError)
+}
+
+func Test_getDBSystemState(t *testing.T) {
+	available := database.DbSystemSummaryLifecycleStateAvailable
+
+	assert.Equal(t, "UPDATING", getDBSystemState(database.DbSystemSummaryLifecycleStateUpdating, nil))
+	assert.Equal(t, "AVAILABLE", getDBSystemState(available, nil))
+	assert.Equal(t, STARTING, getDBSystemState(available, []database.DbNodeSummary{
+		node("n1", database.DbNodeSummaryLifecycleStateStarting), node("n2", database.DbNodeSummaryLifecycleStateStopped)}))
+	assert.Equal(t, STOPPING, getDBSystemState(available, []database.DbNodeSummary{
+		node("n1", database.DbNodeSummaryLifecycleStateStopping)}))
+	assert.Equal(t, "FAILED", getDBSystemState(available, []database.DbNodeSummary{
+		node("n1", database.DbNodeSummaryLifecycleStateFailed)}))
+}
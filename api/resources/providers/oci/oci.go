@@ -0,0 +1,113 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	ocidb "github.com/oracle/oci-go-sdk/v65/database"
+	"gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/providers/oci/database"
+	"github.com/zopdev/zopdev/api/resources/providers/oci/vm"
+)
+
+var (
+	ErrInvalidCredentials = errors.New("invalid cloud credentials")
+	ErrInitializingClient = errors.New("error initializing OCI client")
+)
+
+type Client struct {
+}
+
+func New() *Client {
+	return &Client{}
+}
+
+type ociCredentials struct {
+	TenancyOCID string `json:"tenancy_ocid"`
+	UserOCID    string `json:"user_ocid"`
+	Region      string `json:"region"`
+	Fingerprint string `json:"fingerprint"`
+	PrivateKey  string `json:"private_key"`
+	Compartment string `json:"compartment"`
+}
+
+// NewComputeClient creates a new Compute client with stored credentials, scoped to the region and compartment of
+// the credentials.
+func (*Client) NewComputeClient(_ context.Context, creds any) (*vm.Client, error) {
+	ociCreds, err := getOCICredentials(creds)
+	if err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	cl, err := core.NewComputeClientWithConfigurationProvider(newConfigurationProvider(ociCreds))
+	if err != nil {
+		return nil, ErrInitializingClient
+	}
+
+	return &vm.Client{Compute: &cl, CompartmentID: ociCreds.Compartment, Region: ociCreds.Region}, nil
+}
+
+// NewDatabaseClient creates a new Database client with stored credentials, scoped to the region and compartment of
+// the credentials.
+func (*Client) NewDatabaseClient(_ context.Context, creds any) (*database.Client, error) {
+	ociCreds, err := getOCICredentials(creds)
+	if err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	cl, err := ocidb.NewDatabaseClientWithConfigurationProvider(newConfigurationProvider(ociCreds))
+	if err != nil {
+		return nil, ErrInitializingClient
+	}
+
+	return &database.Client{DB: &cl, CompartmentID: ociCreds.Compartment, Region: ociCreds.Region}, nil
+}
+
+func newConfigurationProvider(creds ociCredentials) common.ConfigurationProvider {
+	// The private key is stored with escaped newlines.
+	privateKey := strings.ReplaceAll(creds.PrivateKey, "\\n", "\n")
+
+	return common.NewRawConfigurationProvider(creds.TenancyOCID, creds.UserOCID, creds.Region, creds.Fingerprint,
+		privateKey, nil)
+}
+
+func getOCICredentials(creds any) (ociCredentials, error) {
+	var ociCred ociCredentials
+
+	ociCredBody, _ := json.Marshal(creds)
+
+	err := json.Unmarshal(ociCredBody, &ociCred)
+	if err != nil {
+		return ociCred, err
+	}
+
+	var missing []string
+
+	for _, param := range []struct{ name, value string }{
+		{"tenancy_ocid", ociCred.TenancyOCID},
+		{"user_ocid", ociCred.UserOCID},
+		{"region", ociCred.Region},
+		{"fingerprint", ociCred.Fingerprint},
+		{"private_key", ociCred.PrivateKey},
+	} {
+		if param.value == "" {
+			missing = append(missing, param.name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return ociCred, http.ErrorMissingParam{Params: missing}
+	}
+
+	// Resources are listed in the compartment of the credentials, the root compartment is the tenancy.
+	if ociCred.Compartment == "" {
+		ociCred.Compartment = ociCred.TenancyOCID
+	}
+
+	return ociCred, nil
+}
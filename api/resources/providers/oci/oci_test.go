@@ -0,0 +1,55 @@
+package oci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr/http"
+)
+
+func TestNew(t *testing.T) {
+	c := New()
+	assert.NotNil(t, c)
+}
+
+func Test_getOCICredentials(t *testing.T) {
+	creds := map[string]string{"tenancy_ocid": "ocid1.tenancy", "user_ocid": "ocid1.user", "region": "us-ashburn-1",
+		"fingerprint": "aa:bb", "private_key": "key"}
+
+	t.Run("success", func(t *testing.T) {
+		c, err := getOCICredentials(creds)
+		require.NoError(t, err)
+		assert.Equal(t, "us-ashburn-1", c.Region)
+		// The tenancy is the root compartment.
+		assert.Equal(t, "ocid1.tenancy", c.Compartment)
+	})
+	t.Run("with compartment", func(t *testing.T) {
+		withCompartment := map[string]string{"compartment": "ocid1.compartment"}
+		for k, v := range creds {
+			withCompartment[k] = v
+		}
+
+		c, err := getOCICredentials(withCompartment)
+		require.NoError(t, err)
+		assert.Equal(t, "ocid1.compartment", c.Compartment)
+	})
+	t.Run("missing params", func(t *testing.T) {
+		_, err := getOCICredentials(map[string]string{"tenancy_ocid": "ocid1.tenancy", "region": "us-ashburn-1"})
+		require.Equal(t, http.ErrorMissingParam{Params: []string{"user_ocid", "fingerprint", "private_key"}}, err)
+	})
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := getOCICredentials(12345)
+		require.Error(t, err)
+	})
+}
+
+func Test_NewClients_InvalidCredentials(t *testing.T) {
+	c := New()
+
+	_, err := c.NewComputeClient(t.Context(), map[string]string{})
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = c.NewDatabaseClient(t.Context(), map[string]string{})
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+}
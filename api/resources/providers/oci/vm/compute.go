@@ -0,0 +1,169 @@
+package vm
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const (
+	// RUNNING instance state for zopdev.
+	RUNNING = "RUNNING"
+	// STOPPED instance state for zopdev.
+	STOPPED = "STOPPED"
+	// STARTING instance state for zopdev.
+	STARTING = "STARTING"
+	// STOPPING instance state for zopdev.
+	STOPPING = "STOPPING"
+
+	// ResourceType is the zopdev resource type of OCI compute instances.
+	ResourceType = "OCI_COMPUTE"
+
+	// vcpusPerOCPU is the number of vCPUs of an OCPU, for the shapes that do not report their vCPUs.
+	vcpusPerOCPU = 2
+)
+
+// ComputeAPI defines the methods used from the OCI Compute client for easier testing/mocking.
+type ComputeAPI interface {
+	ListInstances(ctx context.Context, request core.ListInstancesRequest) (core.ListInstancesResponse, error)
+	InstanceAction(ctx context.Context, request core.InstanceActionRequest) (core.InstanceActionResponse, error)
+}
+
+// Client lists and idles the compute instances of an OCI compartment.
+type Client struct {
+	Compute       ComputeAPI
+	CompartmentID string
+	Region        string
+}
+
+// GetAllInstances lists the compute instances of the compartment, following the pagination tokens.
+// Terminated instances are skipped.
+func (c *Client) GetAllInstances(ctx *gofr.Context) ([]models.Resource, error) {
+	instances := make([]models.Resource, 0)
+	request := core.ListInstancesRequest{CompartmentId: common.String(c.CompartmentID)}
+
+	for {
+		response, err := c.Compute.ListInstances(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range response.Items {
+			inst := &response.Items[i]
+
+			if inst.LifecycleState == core.InstanceLifecycleStateTerminated {
+				continue
+			}
+
+			instances = append(instances, models.Resource{
+				Name:         stringValue(inst.DisplayName),
+				Type:         ResourceType,
+				UID:          stringValue(inst.Id),
+				Region:       stringValue(inst.Region),
+				CreationTime: formatTime(inst.TimeCreated),
+				Status:       getState(inst.LifecycleState),
+				Labels:       getLabels(inst.FreeformTags),
+				Settings: map[string]any{
+					"shape":               stringValue(inst.Shape),
+					"availability_domain": stringValue(inst.AvailabilityDomain),
+				},
+				Spec:      getSpec(inst),
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			})
+		}
+
+		if stringValue(response.OpcNextPage) == "" {
+			return instances, nil
+		}
+
+		request.Page = response.OpcNextPage
+	}
+}
+
+func (c *Client) StartInstance(ctx *gofr.Context, instanceID string) error {
+	_, err := c.Compute.InstanceAction(ctx, core.InstanceActionRequest{
+		InstanceId: common.String(instanceID),
+		Action:     core.InstanceActionActionStart,
+	})
+
+	return err
+}
+
+// StopInstance gracefully shuts down an instance, it is powered off once the shutdown completes.
+func (c *Client) StopInstance(ctx *gofr.Context, instanceID string) error {
+	_, err := c.Compute.InstanceAction(ctx, core.InstanceActionRequest{
+		InstanceId: common.String(instanceID),
+		Action:     core.InstanceActionActionSoftstop,
+	})
+
+	return err
+}
+
+// getState maps the OCI instance lifecycle state to the zopdev state, other states are kept as is.
+func getState(state core.InstanceLifecycleStateEnum) string {
+	switch state {
+	case core.InstanceLifecycleStateRunning:
+		return RUNNING
+	case core.InstanceLifecycleStateStopped:
+		return STOPPED
+	case core.InstanceLifecycleStateStarting:
+		return STARTING
+	case core.InstanceLifecycleStateStopping:
+		return STOPPING
+	default:
+		return string(state)
+	}
+}
+
+func getSpec(inst *core.Instance) models.Spec {
+	spec := models.Spec{MachineClass: stringValue(inst.Shape)}
+
+	if inst.ShapeConfig == nil {
+		return spec
+	}
+
+	switch {
+	case inst.ShapeConfig.Vcpus != nil:
+		spec.VCPU = float64(*inst.ShapeConfig.Vcpus)
+	case inst.ShapeConfig.Ocpus != nil:
+		spec.VCPU = float64(*inst.ShapeConfig.Ocpus) * vcpusPerOCPU
+	}
+
+	if inst.ShapeConfig.MemoryInGBs != nil {
+		spec.MemoryGB = float64(*inst.ShapeConfig.MemoryInGBs)
+	}
+
+	return spec
+}
+
+// getLabels converts the freeform tags of an instance to resource labels.
+func getLabels(tags map[string]string) models.Labels {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return maps.Clone(tags)
+}
+
+func formatTime(t *common.SDKTime) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
@@ -0,0 +1,124 @@
+package vm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+type mockCompute struct {
+	pages     [][]core.Instance
+	actions   []core.InstanceActionRequest
+	shouldErr bool
+}
+
+func (m *mockCompute) ListInstances(_ context.Context, req core.ListInstancesRequest) (core.ListInstancesResponse, error) {
+	if m.shouldErr {
+		return core.ListInstancesResponse{}, assert.AnError
+	}
+
+	page := 0
+	if req.Page != nil {
+		page = 1
+	}
+
+	resp := core.ListInstancesResponse{Items: m.pages[page]}
+	if page+1 < len(m.pages) {
+		resp.OpcNextPage = common.String("next")
+	}
+
+	return resp, nil
+}
+
+func (m *mockCompute) InstanceAction(_ context.Context, req core.InstanceActionRequest) (core.InstanceActionResponse, error) {
+	if m.shouldErr {
+		return core.InstanceActionResponse{}, assert.AnError
+	}
+
+	m.actions = append(m.actions, req)
+
+	return core.InstanceActionResponse{}, nil
+}
+
+func Test_GetAllInstances(t *testing.T) {
+	created := &common.SDKTime{Time: time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)}
+	mock := &mockCompute{pages: [][]core.Instance{
+		{
+			{
+				Id: common.String("ocid1.instance.1"), DisplayName: common.String("vm-1"), Region: common.String("iad"),
+				Shape: common.String("VM.Standard.E4.Flex"), AvailabilityDomain: common.String("AD-1"),
+				LifecycleState: core.InstanceLifecycleStateRunning, TimeCreated: created,
+				FreeformTags: map[string]string{"env": "dev"},
+				ShapeConfig:  &core.InstanceShapeConfig{Ocpus: common.Float32(1), MemoryInGBs: common.Float32(16)},
+			},
+			{Id: common.String("ocid1.instance.2"), LifecycleState: core.InstanceLifecycleStateTerminated},
+		},
+		{
+			{
+				Id: common.String("ocid1.instance.3"), DisplayName: common.String("vm-3"),
+				LifecycleState: core.InstanceLifecycleStateStopped,
+				ShapeConfig:    &core.InstanceShapeConfig{Vcpus: common.Int(4)},
+			},
+		},
+	}}
+	client := &Client{Compute: mock, CompartmentID: "ocid1.compartment"}
+
+	instances, err := client.GetAllInstances(nil)
+
+	require.NoError(t, err)
+	require.Len(t, instances, 2)
+	assert.Equal(t, "vm-1", instances[0].Name)
+	assert.Equal(t, ResourceType, instances[0].Type)
+	assert.Equal(t, "ocid1.instance.1", instances[0].UID)
+	assert.Equal(t, "iad", instances[0].Region)
+	assert.Equal(t, "2025-06-01T10:00:00Z", instances[0].CreationTime)
+	assert.Equal(t, RUNNING, instances[0].Status)
+	assert.Equal(t, models.Labels{"env": "dev"}, instances[0].Labels)
+	assert.Equal(t, "AD-1", instances[0].Settings["availability_domain"])
+	assert.Equal(t, models.Spec{VCPU: 2, MemoryGB: 16, MachineClass: "VM.Standard.E4.Flex"}, instances[0].Spec)
+	assert.Equal(t, STOPPED, instances[1].Status)
+	assert.InDelta(t, 4, instances[1].Spec.VCPU, 0)
+	assert.Nil(t, instances[1].Labels)
+}
+
+func Test_GetAllInstances_Error(t *testing.T) {
+	client := &Client{Compute: &mockCompute{shouldErr: true}}
+
+	instances, err := client.GetAllInstances(nil)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, instances)
+}
+
+func Test_StartStopInstance(t *testing.T) {
+	mock := &mockCompute{}
+	client := &Client{Compute: mock}
+
+	require.NoError(t, client.StartInstance(nil, "ocid1.instance.1"))
+	require.NoError(t, client.StopInstance(nil, "ocid1.instance.1"))
+
+	require.Len(t, mock.actions, 2)
+	assert.Equal(t, core.InstanceActionActionStart, mock.actions[0].Action)
+	assert.Equal(t, core.InstanceActionActionSoftstop, mock.actions[1].Action)
+	assert.Equal(t, "ocid1.instance.1", *mock.actions[1].InstanceId)
+
+	mock.shouldErr = true
+
+	require.ErrorIs(t, client.StartInstance(nil, "ocid1.instance.1"), assert.AnError)
+	require.ErrorIs(t, client.StopInstance(nil, "ocid1.instance.1"), assert.AnError)
+}
+
+func Test_getState(t *testing.T) {
+	assert.Equal(t, RUNNING, getState(core.InstanceLifecycleStateRunning))
+	assert.Equal(t, STOPPED, getState(core.InstanceLifecycleStateStopped))
+	assert.Equal(t, STARTING, getState(core.InstanceLifecycleStateStarting))
+	assert.Equal(t, STOPPING, getState(core.InstanceLifecycleStateStopping))
+	assert.Equal(t, "PROVISIONING", getState(core.InstanceLifecycleStateProvisioning))
+}
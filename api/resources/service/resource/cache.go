@@ -0,0 +1,164 @@
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"google.golang.org/api/option"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/elasticache"
+)
+
+// connectedClientsFilter selects the connected clients metric of a Memorystore for Redis instance.
+const connectedClientsFilter = `metric.type="redis.googleapis.com/clients/connected" AND ` +
+	`resource.labels.instance_id="projects/%s/locations/%s/instances/%s"`
+
+// minIdleWindow is the shortest window over which the metrics of a resource are checked.
+const minIdleWindow = time.Minute
+
+// IsIdle reports whether a resource served no client over the window that ends now. Only the resource types whose
+// usage is measured, e.g. the caches, can be checked.
+func (s *Service) IsIdle(ctx *gofr.Context, cloudAccID, resourceID int64, window time.Duration) (*models.IdleCheck, error) {
+	if window < minIdleWindow {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"window"}}
+	}
+
+	res, err := s.store.GetResourceByID(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil || res.CloudAccount.ID != cloudAccID {
+		return nil, gofrHttp.ErrorEntityNotFound{Name: "resource"}
+	}
+
+	ca, err := s.http.GetCloudCredentials(ctx, cloudAccID)
+	if err != nil {
+		return nil, err
+	}
+
+	d, ok := s.drivers.get(CloudProvider(strings.ToUpper(ca.Provider)), ResourceType(res.Type)).(IdleDetector)
+	if !ok {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Type"}}
+	}
+
+	idle, err := d.Idle(ctx, ca.Credentials, res, window)
+	if err != nil {
+		ctx.Errorf("failed to check whether %s resource %d is idle: %v", res.Type, res.ID, err)
+
+		return nil, err
+	}
+
+	return &models.IdleCheck{ResourceID: res.ID, Window: window.String(), Idle: idle}, nil
+}
+
+// memorystoreDriver manages Memorystore for Redis instances.
+type memorystoreDriver struct {
+	inventoryDriver
+
+	gcp GCPClient
+}
+
+func (d *memorystoreDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	c, err := d.gcp.NewGoogleCredentials(ctx, creds, cloudPlatformScope)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := d.gcp.NewMemorystoreClient(ctx, option.WithCredentials(c))
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllInstances(ctx, c.ProjectID)
+}
+
+// Idle reports whether no client was connected to the instance over the window, based on the peak of the connected
+// clients metric.
+func (d *memorystoreDriver) Idle(ctx *gofr.Context, creds any, res *models.Resource, window time.Duration) (bool, error) {
+	c, err := d.gcp.NewGoogleCredentials(ctx, creds, cloudPlatformScope)
+	if err != nil {
+		return false, err
+	}
+
+	cl, err := d.gcp.NewMetricsClient(ctx, option.WithCredentials(c))
+	if err != nil {
+		return false, err
+	}
+
+	end := time.Now()
+
+	peaks, err := cl.GetPeakValues(ctx, end.Add(-window), end, c.ProjectID,
+		fmt.Sprintf(connectedClientsFilter, c.ProjectID, res.Region, res.Name))
+	if err != nil {
+		return false, err
+	}
+
+	// An instance that reported no metric over the window may as well have served clients.
+	if len(peaks) == 0 {
+		return false, &ErrUsageUnknown{ResourceID: res.ID, Window: window.String()}
+	}
+
+	for i := range peaks {
+		if peaks[i].GetIns64Value() > 0 || peaks[i].GetDoubleValue() > 0 {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// elastiCacheDriver manages ElastiCache replication groups and standalone cache clusters.
+type elastiCacheDriver struct {
+	inventoryDriver
+
+	aws AWSClient
+}
+
+func (d *elastiCacheDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, err := d.aws.NewElastiCacheClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllInstances(ctx)
+}
+
+// Idle reports whether no client was connected to any cache cluster of the cache over the window.
+func (d *elastiCacheDriver) Idle(ctx *gofr.Context, creds any, res *models.Resource, window time.Duration) (bool, error) {
+	var clusters []string
+
+	// Settings read from the store are decoded as generic JSON, settings of a listed cache hold the IDs as is.
+	switch members := res.Settings["member_clusters"].(type) {
+	case []string:
+		clusters = members
+	case []any:
+		for _, m := range members {
+			if id, ok := m.(string); ok {
+				clusters = append(clusters, id)
+			}
+		}
+	}
+
+	if len(clusters) == 0 {
+		return false, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.member_clusters"}}
+	}
+
+	cl, err := d.aws.NewElastiCacheClient(ctx, creds)
+	if err != nil {
+		return false, err
+	}
+
+	// CloudWatch periods are multiples of a minute.
+	idle, err := cl.Idle(ctx, res.Region, clusters, window.Round(time.Minute))
+	if errors.Is(err, elasticache.ErrNoDatapoints) {
+		return false, &ErrUsageUnknown{ResourceID: res.ID, Window: window.String()}
+	}
+
+	return idle, err
+}
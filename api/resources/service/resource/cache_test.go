@@ -0,0 +1,258 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	awsElastiCache "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+
+	"github.com/zopdev/zopdev/api/resources/client"
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/elasticache"
+)
+
+// stubElastiCache implements the ElastiCache API interface with a single replication group of two clusters.
+type stubElastiCache struct{}
+
+func (*stubElastiCache) DescribeReplicationGroupsWithContext(_ aws.Context,
+	_ *awsElastiCache.DescribeReplicationGroupsInput, _ ...request.Option) (
+	*awsElastiCache.DescribeReplicationGroupsOutput, error) {
+	return &awsElastiCache.DescribeReplicationGroupsOutput{ReplicationGroups: []*awsElastiCache.ReplicationGroup{
+		{ReplicationGroupId: aws.String("sessions"), ARN: aws.String("arn:sessions"), Status: aws.String("available"),
+			MemberClusters: aws.StringSlice([]string{"sessions-001", "sessions-002"})},
+	}}, nil
+}
+
+func (*stubElastiCache) DescribeCacheClustersWithContext(_ aws.Context, _ *awsElastiCache.DescribeCacheClustersInput,
+	_ ...request.Option) (*awsElastiCache.DescribeCacheClustersOutput, error) {
+	return &awsElastiCache.DescribeCacheClustersOutput{}, nil
+}
+
+// stubCloudWatch implements the CloudWatch API interface, clients are connected to the sessions-002 cluster and the
+// sessions-003 cluster reported no datapoint.
+type stubCloudWatch struct{}
+
+func (*stubCloudWatch) GetMetricStatisticsWithContext(_ aws.Context, input *cloudwatch.GetMetricStatisticsInput,
+	_ ...request.Option) (*cloudwatch.GetMetricStatisticsOutput, error) {
+	switch aws.StringValue(input.Dimensions[0].Value) {
+	case "sessions-002":
+		return &cloudwatch.GetMetricStatisticsOutput{Datapoints: []*cloudwatch.Datapoint{{Maximum: aws.Float64(3)}}}, nil
+	case "sessions-003":
+		return &cloudwatch.GetMetricStatisticsOutput{}, nil
+	default:
+		return &cloudwatch.GetMetricStatisticsOutput{Datapoints: []*cloudwatch.Datapoint{{Maximum: aws.Float64(0)}}}, nil
+	}
+}
+
+func TestService_IsIdle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mClient := NewMockHTTPClient(ctrl)
+	mStore := NewMockStore(ctrl)
+	mGCP := NewMockGCPClient(ctrl)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	s := New(mGCP, nil, nil, mClient, mStore, &pricing.Catalog{}, nil)
+	cache := &models.Resource{ID: 2, CloudAccount: models.CloudAccount{ID: 3}, Name: "redis-1",
+		Type: string(MEMORYSTORE), Region: "us-central1"}
+	mockCreds := &google.Credentials{ProjectID: "test-project"}
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(cache, nil).Times(2)
+	mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).
+		Return(&client.CloudAccount{ID: 3, Provider: "gcp"}, nil).Times(2)
+	mGCP.EXPECT().NewGoogleCredentials(ctx, gomock.Any(), cloudPlatformScope).Return(mockCreds, nil).Times(2)
+	mGCP.EXPECT().NewMetricsClient(ctx, option.WithCredentials(mockCreds)).
+		Return(&mockMetricsClient{peaks: []models.Metric{{Point: int64(0)}}}, nil)
+
+	res, err := s.IsIdle(ctx, 3, 2, 24*time.Hour)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.IdleCheck{ResourceID: 2, Window: "24h0m0s", Idle: true}, res)
+
+	mGCP.EXPECT().NewMetricsClient(ctx, option.WithCredentials(mockCreds)).Return(&mockMetricsClient{isError: true}, nil)
+
+	res, err = s.IsIdle(ctx, 3, 2, time.Hour)
+
+	require.ErrorIs(t, err, errMock)
+	assert.Nil(t, res)
+}
+
+func TestService_IsIdle_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mClient := NewMockHTTPClient(ctrl)
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background()}
+	s := New(nil, nil, nil, mClient, mStore, &pricing.Catalog{}, nil)
+
+	res, err := s.IsIdle(ctx, 3, 2, time.Second)
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"window"}}, err)
+	assert.Nil(t, res)
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(nil, errMock)
+
+	_, err = s.IsIdle(ctx, 3, 2, time.Hour)
+
+	require.ErrorIs(t, err, errMock)
+
+	// The resource belongs to another cloud account.
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+		Return(&models.Resource{ID: 2, CloudAccount: models.CloudAccount{ID: 4}}, nil)
+
+	_, err = s.IsIdle(ctx, 3, 2, time.Hour)
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "resource"}, err)
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+		Return(&models.Resource{ID: 2, CloudAccount: models.CloudAccount{ID: 3}, Type: string(SQL)}, nil).Times(2)
+	mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).Return(nil, errMock)
+
+	_, err = s.IsIdle(ctx, 3, 2, time.Hour)
+
+	require.ErrorIs(t, err, errMock)
+
+	// The usage of a Cloud SQL instance is not measured.
+	mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).Return(&client.CloudAccount{ID: 3, Provider: "GCP"}, nil)
+
+	_, err = s.IsIdle(ctx, 3, 2, time.Hour)
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Type"}}, err)
+}
+
+func TestService_ChangeState_Cache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mClient := NewMockHTTPClient(ctrl)
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background()}
+	s := New(nil, nil, nil, mClient, mStore, &pricing.Catalog{}, nil)
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+		Return(&models.Resource{ID: 2, Type: string(ELASTICACHE), Status: AVAILABLE}, nil)
+	mStore.EXPECT().GetActiveLock(ctx, int64(2), gomock.Any()).Return(nil, nil)
+	mStore.EXPECT().GetActiveBlackout(ctx, int64(2), gomock.Any()).Return(nil, nil)
+	mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).
+		Return(&client.CloudAccount{ID: 3, Provider: string(AWS)}, nil)
+
+	err := s.ChangeState(ctx, ResourceDetails{CloudAccID: 3, ID: 2, Type: ELASTICACHE, State: SUSPEND})
+
+	assert.Equal(t, &ErrNotChangeable{ResourceID: 2, Type: string(ELASTICACHE)}, err)
+}
+
+func TestMemorystoreDriver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	creds := map[string]any{"project_id": "test-project"}
+	mockCreds := &google.Credentials{ProjectID: "test-project"}
+	mGCP := NewMockGCPClient(ctrl)
+	d := &memorystoreDriver{gcp: mGCP}
+	cache := models.Resource{ID: 4, Name: "redis-1", UID: "test-project/us-central1/redis-1", Region: "us-central1"}
+	metrics := &mockMetricsClient{peaks: []models.Metric{{Point: int64(0)}, {Point: int64(5)}}}
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil).Times(3)
+	mGCP.EXPECT().NewMemorystoreClient(ctx, option.WithCredentials(mockCreds)).
+		Return(&mockSQLClient{instances: []models.Resource{cache}}, nil)
+	mGCP.EXPECT().NewMetricsClient(ctx, option.WithCredentials(mockCreds)).Return(metrics, nil)
+	mGCP.EXPECT().NewMetricsClient(ctx, option.WithCredentials(mockCreds)).Return(&mockMetricsClient{}, nil)
+
+	caches, err := d.List(ctx, creds)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{cache}, caches)
+
+	idle, err := d.Idle(ctx, creds, &cache, time.Hour)
+
+	require.NoError(t, err)
+	assert.False(t, idle)
+	assert.Equal(t, `metric.type="redis.googleapis.com/clients/connected" AND `+
+		`resource.labels.instance_id="projects/test-project/locations/us-central1/instances/redis-1"`, metrics.filter)
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"req.Type"}}, d.Stop(ctx, creds, &cache))
+
+	// The usage of an instance that reported no metric is unknown.
+	_, err = d.Idle(ctx, creds, &cache, time.Hour)
+
+	assert.Equal(t, &ErrUsageUnknown{ResourceID: 4, Window: "1h0m0s"}, err)
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil).Times(2)
+	mGCP.EXPECT().NewMemorystoreClient(ctx, option.WithCredentials(mockCreds)).Return(nil, errMock)
+	mGCP.EXPECT().NewMetricsClient(ctx, option.WithCredentials(mockCreds)).Return(nil, errMock)
+
+	caches, err = d.List(ctx, creds)
+
+	require.ErrorIs(t, err, errMock)
+	assert.Nil(t, caches)
+
+	_, err = d.Idle(ctx, creds, &cache, time.Hour)
+
+	require.ErrorIs(t, err, errMock)
+}
+
+func TestElastiCacheDriver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	mAWS := NewMockAWSClient(ctrl)
+	d := &elastiCacheDriver{aws: mAWS}
+	cl := &elasticache.Client{
+		ElastiCache: map[string]elasticache.ElastiCacheAPI{"us-east-1": &stubElastiCache{}},
+		CloudWatch:  map[string]elasticache.CloudWatchAPI{"us-east-1": &stubCloudWatch{}},
+	}
+
+	mAWS.EXPECT().NewElastiCacheClient(ctx, gomock.Any()).Return(cl, nil).Times(4)
+
+	caches, err := d.List(ctx, nil)
+
+	require.NoError(t, err)
+	require.Len(t, caches, 1)
+	assert.Equal(t, "arn:sessions", caches[0].UID)
+
+	idle, err := d.Idle(ctx, nil, &caches[0], time.Hour)
+
+	require.NoError(t, err)
+	assert.False(t, idle)
+
+	// Settings read from the store hold the cluster IDs as generic JSON.
+	idle, err = d.Idle(ctx, nil, &models.Resource{Region: "us-east-1",
+		Settings: models.Settings{"member_clusters": []any{"sessions-001"}}}, time.Hour)
+
+	require.NoError(t, err)
+	assert.True(t, idle)
+
+	// The usage of a cluster without datapoints is unknown.
+	_, err = d.Idle(ctx, nil, &models.Resource{ID: 4, Region: "us-east-1",
+		Settings: models.Settings{"member_clusters": []any{"sessions-001", "sessions-003"}}}, time.Hour)
+
+	assert.Equal(t, &ErrUsageUnknown{ResourceID: 4, Window: "1h0m0s"}, err)
+
+	_, err = d.Idle(ctx, nil, &models.Resource{Region: "us-east-1"}, time.Hour)
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.member_clusters"}}, err)
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"req.Type"}}, d.Start(ctx, nil, &caches[0]))
+
+	mAWS.EXPECT().NewElastiCacheClient(ctx, gomock.Any()).Return(nil, errMock)
+
+	caches, err = d.List(ctx, nil)
+
+	require.ErrorIs(t, err, errMock)
+	assert.Nil(t, caches)
+}
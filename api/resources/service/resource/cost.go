@@ -0,0 +1,67 @@
+package resource
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
+)
+
+// GetCost aggregates the estimated cost of the resources of a cloud account by resource type, region and
+// resource group. A resource that belongs to several resource groups is counted in each of them.
+func (s *Service) GetCost(ctx *gofr.Context, id int64) (*models.CostSummary, error) {
+	res, err := s.GetAll(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.store.GetResourceGroupNames(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &models.CostSummary{
+		ByType:          make(map[string]models.CostBreakdown),
+		ByRegion:        make(map[string]models.CostBreakdown),
+		ByResourceGroup: make(map[string]models.CostBreakdown),
+	}
+
+	for i := range res {
+		if res[i].Cost == nil {
+			summary.Unpriced++
+		}
+
+		summary.Total = addCost(summary.Total, &res[i])
+		summary.ByType[res[i].Type] = addCost(summary.ByType[res[i].Type], &res[i])
+		summary.ByRegion[res[i].Region] = addCost(summary.ByRegion[res[i].Region], &res[i])
+
+		for _, g := range groups[res[i].ID] {
+			summary.ByResourceGroup[g] = addCost(summary.ByResourceGroup[g], &res[i])
+		}
+	}
+
+	return summary, nil
+}
+
+// addCost adds the cost of a resource in its current state to the breakdown. Running resources cost their full
+// price and can be stopped to save the compute, every other resource only costs what remains while stopped.
+func addCost(b models.CostBreakdown, res *models.Resource) models.CostBreakdown {
+	b.Count++
+
+	if res.Cost == nil {
+		return b
+	}
+
+	if res.Status == RUNNING || res.Status == STARTING {
+		b.Hourly += res.Cost.Hourly
+		b.Monthly += res.Cost.Monthly
+		b.Savings += res.Cost.Monthly - res.Cost.StoppedMonthly
+
+		return b
+	}
+
+	b.Hourly += res.Cost.StoppedMonthly / pricing.HoursPerMonth
+	b.Monthly += res.Cost.StoppedMonthly
+
+	return b
+}
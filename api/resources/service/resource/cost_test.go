@@ -0,0 +1,71 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func TestService_GetCost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mStore := NewMockStore(ctrl)
+	mPricing := NewMockPricing(ctrl)
+	ctx := &gofr.Context{Context: context.Background()}
+	s := New(nil, nil, nil, nil, mStore, mPricing, nil)
+	resources := []models.Resource{
+		{ID: 1, Type: "SQL", Region: "us-central1", Status: RUNNING},
+		{ID: 2, Type: "SQL", Region: "us-central1", Status: STOPPED},
+		{ID: 3, Type: "GCE", Region: "europe-west1", Status: RUNNING},
+	}
+
+	mStore.EXPECT().GetResources(ctx, int64(1), nil).Return(resources, nil)
+	mStore.EXPECT().GetResourceGroupNames(ctx, int64(1)).Return(map[int64][]string{1: {"dev"}, 2: {"dev", "payments"}}, nil)
+	mPricing.EXPECT().Estimate(gomock.Any()).DoAndReturn(func(res *models.Resource) *models.Cost {
+		if res.Type == "GCE" {
+			return nil
+		}
+
+		return &models.Cost{Hourly: 0.2, Monthly: 146, StoppedMonthly: 7.3}
+	}).Times(3)
+
+	summary, err := s.GetCost(ctx, 1)
+	require.NoError(t, err)
+
+	sql := models.CostBreakdown{Count: 2, Hourly: 0.21, Monthly: 153.3, Savings: 138.7}
+
+	assert.Equal(t, 1, summary.Unpriced)
+	assertBreakdown(t, models.CostBreakdown{Count: 3, Hourly: 0.21, Monthly: 153.3, Savings: 138.7}, summary.Total)
+	assertBreakdown(t, sql, summary.ByType["SQL"])
+	assertBreakdown(t, models.CostBreakdown{Count: 1}, summary.ByType["GCE"])
+	assertBreakdown(t, sql, summary.ByRegion["us-central1"])
+	assertBreakdown(t, sql, summary.ByResourceGroup["dev"])
+	assertBreakdown(t, models.CostBreakdown{Count: 1, Hourly: 0.01, Monthly: 7.3}, summary.ByResourceGroup["payments"])
+
+	mStore.EXPECT().GetResources(ctx, int64(2), nil).Return(nil, errMock)
+
+	_, err = s.GetCost(ctx, 2)
+	require.ErrorIs(t, err, errMock)
+
+	mStore.EXPECT().GetResources(ctx, int64(3), nil).Return(nil, nil)
+	mStore.EXPECT().GetResourceGroupNames(ctx, int64(3)).Return(nil, errMock)
+
+	_, err = s.GetCost(ctx, 3)
+	require.ErrorIs(t, err, errMock)
+}
+
+func assertBreakdown(t *testing.T, expected, actual models.CostBreakdown) {
+	t.Helper()
+
+	assert.Equal(t, expected.Count, actual.Count)
+	assert.InDelta(t, expected.Hourly, actual.Hourly, 1e-9)
+	assert.InDelta(t, expected.Monthly, actual.Monthly, 1e-9)
+	assert.InDelta(t, expected.Savings, actual.Savings, 1e-9)
+}
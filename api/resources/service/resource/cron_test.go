@@ -16,6 +16,7 @@ import (
 
 	"github.com/zopdev/zopdev/api/resources/client"
 	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
 	"github.com/zopdev/zopdev/api/resources/providers/aws/database"
 	"github.com/zopdev/zopdev/api/resources/providers/aws/vm"
 )
@@ -35,6 +36,10 @@ func (*stubEC2) StopInstancesWithContext(_ aws.Context, _ *ec2.StopInstancesInpu
 	_ ...request.Option) (*ec2.StopInstancesOutput, error) {
 	return &ec2.StopInstancesOutput{}, nil
 }
+func (*stubEC2) DescribeInstanceTypesWithContext(_ aws.Context, _ *ec2.DescribeInstanceTypesInput,
+	_ ...request.Option) (*ec2.DescribeInstanceTypesOutput, error) {
+	return &ec2.DescribeInstanceTypesOutput{}, nil
+}
 
 // stubRDS implements the RDSAPI interface with no-op methods.
 type stubRDS struct{}
@@ -59,6 +64,16 @@ func (*stubRDS) StopDBInstanceWithContext(_ aws.Context, _ *rds.StopDBInstanceIn
 	_ ...request.Option) (*rds.StopDBInstanceOutput, error) {
 	return &rds.StopDBInstanceOutput{}, nil
 }
+func (*stubRDS) DescribeDBClustersWithContext(_ aws.Context, _ *rds.DescribeDBClustersInput,
+	_ ...request.Option) (*rds.DescribeDBClustersOutput, error) {
+	return &rds.DescribeDBClustersOutput{DBClusters: []*rds.DBCluster{{DBClusterIdentifier: aws.String("dev"),
+		ServerlessV2ScalingConfiguration: &rds.ServerlessV2ScalingConfigurationInfo{
+			MinCapacity: aws.Float64(2), MaxCapacity: aws.Float64(16)}}}}, nil
+}
+func (*stubRDS) ModifyDBClusterWithContext(_ aws.Context, _ *rds.ModifyDBClusterInput,
+	_ ...request.Option) (*rds.ModifyDBClusterOutput, error) {
+	return &rds.ModifyDBClusterOutput{}, nil
+}
 
 func TestService_SyncCron(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -84,7 +99,7 @@ func TestService_SyncCron(t *testing.T) {
 	}
 	mockCreds := &google.Credentials{ProjectID: "test-project"}
 
-	service := New(mGCP, mAWS, mHTTP, mStore)
+	service := New(mGCP, mAWS, nil, mHTTP, mStore, &pricing.Catalog{}, nil)
 
 	// mock expectations
 	mHTTP.EXPECT().GetAllCloudAccounts(ctx).
@@ -95,22 +110,39 @@ func TestService_SyncCron(t *testing.T) {
 		Return(&client.CloudAccount{ID: 2, Provider: "Unknown"}, nil)
 
 	mGCP.EXPECT().NewGoogleCredentials(ctx, gomock.Any(), "https://www.googleapis.com/auth/cloud-platform").
-		Return(mockCreds, nil)
+		Return(mockCreds, nil).Times(9)
 	mGCP.EXPECT().NewSQLClient(ctx, gomock.Any()).
 		Return(mockLister, nil)
+	mGCP.EXPECT().NewComputeClient(ctx, gomock.Any()).
+		Return(&mockComputeClient{}, nil)
+	mGCP.EXPECT().NewGKEClient(ctx, gomock.Any()).
+		Return(&mockGKEClient{}, nil)
+	mGCP.EXPECT().NewCloudRunClient(ctx, gomock.Any()).
+		Return(&mockCloudRunClient{}, nil)
+	mGCP.EXPECT().NewCloudFunctionsClient(ctx, gomock.Any()).
+		Return(&mockCloudFunctionsClient{}, nil)
+	mGCP.EXPECT().NewDiskClient(ctx, gomock.Any()).
+		Return(&mockDiskClient{}, nil).Times(2)
+	mGCP.EXPECT().NewAddressClient(ctx, gomock.Any()).
+		Return(&mockAddressClient{}, nil)
+	mGCP.EXPECT().NewMemorystoreClient(ctx, gomock.Any()).
+		Return(&mockSQLClient{}, nil)
 
 	mStore.EXPECT().GetResources(ctx, int64(1), nil).
 		Return(mockResp, nil).AnyTimes()
 	mStore.EXPECT().GetResources(ctx, int64(2), nil).
 		Return(nil, nil).AnyTimes()
-	mStore.EXPECT().UpdateStatus(ctx, RUNNING, int64(1)).
-		Return(nil)
-	mStore.EXPECT().UpdateStatus(ctx, STOPPED, int64(2)).
-		Return(nil)
+	// The listed resources are unchanged, only the sync runs are written.
+	mStore.EXPECT().InsertSyncRun(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, run *models.SyncRun) error {
+		run.ID = run.CloudAccountID
+
+		return nil
+	}).Times(2)
+	mStore.EXPECT().CompleteSyncRun(ctx, gomock.Any()).Return(nil).Times(2)
 
 	// Add correct mocks for AWS EC2 and RDS clients
-	mAWS.EXPECT().NewEC2Client(gomock.Any(), gomock.Any()).Return(&vm.Client{EC2: &stubEC2{}}, nil).AnyTimes()
-	mAWS.EXPECT().NewRDSClient(gomock.Any(), gomock.Any()).Return(&database.Client{RDS: &stubRDS{}}, nil).AnyTimes()
+	mAWS.EXPECT().NewEC2Client(gomock.Any(), gomock.Any()).Return(&vm.Client{EC2: map[string]vm.EC2API{"us-east-1": &stubEC2{}}}, nil).AnyTimes()
+	mAWS.EXPECT().NewRDSClient(gomock.Any(), gomock.Any()).Return(&database.Client{RDS: map[string]database.RDSAPI{"us-east-1": &stubRDS{}}}, nil).AnyTimes()
 
 	service.SyncCron(ctx)
 
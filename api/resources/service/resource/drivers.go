@@ -0,0 +1,936 @@
+package resource
+
+import (
+	"encoding/json"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/asg"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/database"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/ecsservice"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/nodegroup"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/cloudrun"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/functions"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/gke"
+)
+
+const (
+	cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+	// suspendedSizeKey is the setting of a resource suspended by scaling it to zero that records its size before.
+	suspendedSizeKey = "suspended_size"
+	// stoppedAtKey is the setting of a stopped RDS instance or Aurora cluster that records when zopdev stopped it.
+	stoppedAtKey = "stopped_at"
+)
+
+// Drivers is the registry of the drivers by cloud provider and resource type.
+type Drivers map[CloudProvider]map[ResourceType]Driver
+
+// newDrivers registers the drivers of the supported resource types. A new resource kind is supported by writing its
+// driver and registering it here.
+func newDrivers(gcpClient GCPClient, awsClient AWSClient, ociClient OCIClient) Drivers {
+	return Drivers{
+		GCP: {
+			SQL:           &gcpSQLDriver{gcp: gcpClient},
+			GCPCOMPUTE:    &gceDriver{gcp: gcpClient},
+			GKENODEPOOL:   &gkeNodePoolDriver{gcp: gcpClient},
+			CLOUDRUN:      &cloudRunDriver{gcp: gcpClient},
+			CLOUDFUNCTION: &cloudFunctionDriver{gcp: gcpClient},
+			GCPDISK:       &gcpDiskDriver{gcp: gcpClient},
+			GCPSNAPSHOT:   &gcpSnapshotDriver{gcp: gcpClient},
+			GCPADDRESS:    &gcpAddressDriver{gcp: gcpClient},
+			MEMORYSTORE:   &memorystoreDriver{gcp: gcpClient},
+		},
+		AWS: {
+			RDS:          &rdsDriver{aws: awsClient},
+			AWSCOMPUTE:   &ec2Driver{aws: awsClient},
+			ASG:          &asgDriver{aws: awsClient},
+			EKSNODEGROUP: &eksNodeGroupDriver{aws: awsClient},
+			ECSSERVICE:   &ecsServiceDriver{aws: awsClient},
+			EBSVOLUME:    &ebsVolumeDriver{aws: awsClient},
+			EBSSNAPSHOT:  &ebsSnapshotDriver{aws: awsClient},
+			ELASTICIP:    &elasticIPDriver{aws: awsClient},
+			ELASTICACHE:  &elastiCacheDriver{aws: awsClient},
+		},
+		OCI: {
+			OCICOMPUTE:      &ociComputeDriver{oci: ociClient},
+			OCIDBSYSTEM:     &ociDBSystemDriver{oci: ociClient},
+			OCIAUTONOMOUSDB: &ociAutonomousDBDriver{oci: ociClient},
+		},
+	}
+}
+
+// get returns the driver of a resource type of a cloud provider, nil if the resource type is not supported.
+func (d Drivers) get(provider CloudProvider, resourceType ResourceType) Driver {
+	return d[provider][resourceType]
+}
+
+// describe fetches the current state of a resource from the cloud provider, through its driver when the driver
+// describes a single resource and by listing the resources of its type otherwise.
+func describe(ctx *gofr.Context, d Driver, creds any, res *models.Resource) (*models.Resource, error) {
+	if desc, ok := d.(Describer); ok {
+		return desc.Describe(ctx, creds, res)
+	}
+
+	return describeByList(ctx, d, creds, res)
+}
+
+// describeByList describes a resource by listing the resources of its type, for the resource types whose provider
+// client only lists resources in bulk.
+func describeByList(ctx *gofr.Context, d Driver, creds any, res *models.Resource) (*models.Resource, error) {
+	instances, err := d.List(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range instances {
+		if instances[i].UID == res.UID {
+			return &instances[i], nil
+		}
+	}
+
+	return nil, gofrHttp.ErrorEntityNotFound{Name: "resource", Value: res.UID}
+}
+
+// recordSize records in the settings of a resource its size before it was scaled to zero.
+func recordSize(res *models.Resource, size any) {
+	if res.Settings == nil {
+		res.Settings = make(models.Settings)
+	}
+
+	res.Settings[suspendedSizeKey] = size
+}
+
+// suspendedSize decodes the size recorded when a resource was scaled to zero. Settings read from the store are
+// decoded as generic JSON, the recorded size is decoded into its type.
+func suspendedSize(res *models.Resource, size any) error {
+	v := res.Settings[suspendedSizeKey]
+	if v == nil {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings." + suspendedSizeKey}}
+	}
+
+	b, _ := json.Marshal(v)
+	if err := json.Unmarshal(b, size); err != nil {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings." + suspendedSizeKey}}
+	}
+
+	return nil
+}
+
+// inventoryDriver is embedded by the drivers of the resource types that are only inventoried, e.g. the waste
+// candidates, which are deleted, and the caches. These resources are not started or stopped.
+type inventoryDriver struct{}
+
+func (inventoryDriver) Start(*gofr.Context, any, *models.Resource) error {
+	return gofrHttp.ErrorInvalidParam{Params: []string{"req.Type"}}
+}
+
+func (inventoryDriver) Stop(*gofr.Context, any, *models.Resource) error {
+	return gofrHttp.ErrorInvalidParam{Params: []string{"req.Type"}}
+}
+
+func (inventoryDriver) inventoryOnly() {}
+
+// gcpSQLDriver manages Cloud SQL instances.
+type gcpSQLDriver struct {
+	gcp GCPClient
+}
+
+func (d *gcpSQLDriver) client(ctx *gofr.Context, creds any) (gcp.SQLClient, *google.Credentials, error) {
+	c, err := d.gcp.NewGoogleCredentials(ctx, creds, cloudPlatformScope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cl, err := d.gcp.NewSQLClient(ctx, option.WithCredentials(c))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cl, c, nil
+}
+
+func (d *gcpSQLDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllInstances(ctx, c.ProjectID)
+}
+
+func (d *gcpSQLDriver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.StartInstance(ctx, c.ProjectID, res.Name)
+}
+
+func (d *gcpSQLDriver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.StopInstance(ctx, c.ProjectID, res.Name)
+}
+
+// gceDriver manages Compute Engine instances.
+type gceDriver struct {
+	gcp GCPClient
+}
+
+func (d *gceDriver) client(ctx *gofr.Context, creds any) (gcp.ComputeClient, *google.Credentials, error) {
+	c, err := d.gcp.NewGoogleCredentials(ctx, creds, cloudPlatformScope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cl, err := d.gcp.NewComputeClient(ctx, option.WithCredentials(c))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cl, c, nil
+}
+
+func (d *gceDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllInstances(ctx, c.ProjectID)
+}
+
+func (d *gceDriver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	zone, ok := res.Settings["zone"].(string)
+	if !ok {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.zone"}}
+	}
+
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.StartInstance(ctx, c.ProjectID, zone, res.Name)
+}
+
+func (d *gceDriver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	zone, ok := res.Settings["zone"].(string)
+	if !ok {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.zone"}}
+	}
+
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.StopInstance(ctx, c.ProjectID, zone, res.Name)
+}
+
+// Describe fetches the instance from its zone instead of listing the instances of every zone.
+func (d *gceDriver) Describe(ctx *gofr.Context, creds any, res *models.Resource) (*models.Resource, error) {
+	zone, ok := res.Settings["zone"].(string)
+	if !ok {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.zone"}}
+	}
+
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetInstance(ctx, c.ProjectID, zone, res.Name)
+}
+
+// gkeNodePoolDriver manages GKE node pools, a node pool is suspended by scaling it to zero nodes. The size of the
+// node pool is recorded in its settings when it is suspended and restored when it is started.
+type gkeNodePoolDriver struct {
+	gcp GCPClient
+}
+
+func (d *gkeNodePoolDriver) client(ctx *gofr.Context, creds any) (gcp.GKEClient, *google.Credentials, error) {
+	c, err := d.gcp.NewGoogleCredentials(ctx, creds, cloudPlatformScope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cl, err := d.gcp.NewGKEClient(ctx, option.WithCredentials(c))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cl, c, nil
+}
+
+func (d *gkeNodePoolDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllNodePools(ctx, c.ProjectID)
+}
+
+func (d *gkeNodePoolDriver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	location, cluster, nodePool, err := nodePoolSettings(res)
+	if err != nil {
+		return err
+	}
+
+	var size gke.Size
+
+	if err = suspendedSize(res, &size); err != nil {
+		return err
+	}
+
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	err = cl.StartNodePool(ctx, c.ProjectID, location, cluster, nodePool, &size)
+	if err != nil {
+		return err
+	}
+
+	delete(res.Settings, suspendedSizeKey)
+
+	return nil
+}
+
+func (d *gkeNodePoolDriver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	location, cluster, nodePool, err := nodePoolSettings(res)
+	if err != nil {
+		return err
+	}
+
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	// The size is recorded also when scaling down fails, the autoscaling of the node pool may already be disabled.
+	size, err := cl.StopNodePool(ctx, c.ProjectID, location, cluster, nodePool)
+	if size != nil {
+		recordSize(res, size)
+	}
+
+	return err
+}
+
+// nodePoolSettings returns the location, cluster and name of a node pool from its settings.
+func nodePoolSettings(res *models.Resource) (location, cluster, nodePool string, err error) {
+	values := make([]string, 0, 3)
+
+	for _, key := range []string{"location", "cluster", "node_pool"} {
+		v, ok := res.Settings[key].(string)
+		if !ok {
+			return "", "", "", gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings." + key}}
+		}
+
+		values = append(values, v)
+	}
+
+	return values[0], values[1], values[2], nil
+}
+
+// cloudRunDriver manages Cloud Run services, a service is suspended by setting its minimum number of instances to
+// zero. The minimum is recorded in its settings when it is suspended and restored when it is started.
+type cloudRunDriver struct {
+	gcp GCPClient
+}
+
+func (d *cloudRunDriver) client(ctx *gofr.Context, creds any) (gcp.CloudRunClient, *google.Credentials, error) {
+	c, err := d.gcp.NewGoogleCredentials(ctx, creds, cloudPlatformScope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cl, err := d.gcp.NewCloudRunClient(ctx, option.WithCredentials(c))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cl, c, nil
+}
+
+func (d *cloudRunDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllServices(ctx, c.ProjectID)
+}
+
+func (d *cloudRunDriver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	location, ok := res.Settings["location"].(string)
+	if !ok {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.location"}}
+	}
+
+	var size cloudrun.Size
+
+	if err := suspendedSize(res, &size); err != nil {
+		return err
+	}
+
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	err = cl.StartService(ctx, c.ProjectID, location, res.Name, &size)
+	if err != nil {
+		return err
+	}
+
+	delete(res.Settings, suspendedSizeKey)
+
+	return nil
+}
+
+func (d *cloudRunDriver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	location, ok := res.Settings["location"].(string)
+	if !ok {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.location"}}
+	}
+
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	size, err := cl.StopService(ctx, c.ProjectID, location, res.Name)
+	if err != nil {
+		return err
+	}
+
+	recordSize(res, size)
+
+	return nil
+}
+
+// cloudFunctionDriver manages 2nd gen Cloud Functions, a function is suspended by setting its minimum number of
+// instances to zero. The minimum is recorded in its settings when it is suspended and restored when it is started.
+type cloudFunctionDriver struct {
+	gcp GCPClient
+}
+
+func (d *cloudFunctionDriver) client(ctx *gofr.Context, creds any) (gcp.CloudFunctionsClient, *google.Credentials,
+	error) {
+	c, err := d.gcp.NewGoogleCredentials(ctx, creds, cloudPlatformScope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cl, err := d.gcp.NewCloudFunctionsClient(ctx, option.WithCredentials(c))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cl, c, nil
+}
+
+func (d *cloudFunctionDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllFunctions(ctx, c.ProjectID)
+}
+
+func (d *cloudFunctionDriver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	location, ok := res.Settings["location"].(string)
+	if !ok {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.location"}}
+	}
+
+	var size functions.Size
+
+	if err := suspendedSize(res, &size); err != nil {
+		return err
+	}
+
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	err = cl.StartFunction(ctx, c.ProjectID, location, res.Name, &size)
+	if err != nil {
+		return err
+	}
+
+	delete(res.Settings, suspendedSizeKey)
+
+	return nil
+}
+
+func (d *cloudFunctionDriver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	location, ok := res.Settings["location"].(string)
+	if !ok {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.location"}}
+	}
+
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	size, err := cl.StopFunction(ctx, c.ProjectID, location, res.Name)
+	if err != nil {
+		return err
+	}
+
+	recordSize(res, size)
+
+	return nil
+}
+
+// rdsDriver manages RDS instances and Aurora clusters.
+type rdsDriver struct {
+	aws AWSClient
+}
+
+func (d *rdsDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, err := d.aws.NewRDSClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllInstances(ctx)
+}
+
+// Start starts an instance or a cluster, and restores the capacity range of an Aurora Serverless v2 cluster.
+func (d *rdsDriver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	var capacity database.Capacity
+
+	serverless := res.Spec.MachineClass == database.ServerlessInstanceClass
+	if serverless {
+		if err := suspendedSize(res, &capacity); err != nil {
+			return err
+		}
+	}
+
+	cl, err := d.aws.NewRDSClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	if serverless {
+		err = cl.RestoreCluster(ctx, res, &capacity)
+	} else {
+		err = cl.StartInstance(ctx, res)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	delete(res.Settings, suspendedSizeKey)
+	delete(res.Settings, stoppedAtKey)
+
+	return nil
+}
+
+// Stop stops an instance or a cluster and records the stop time, as AWS starts it again after seven days. Aurora
+// Serverless v2 instances cannot be stopped, their cluster is scaled down to the minimum capacity instead.
+func (d *rdsDriver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, err := d.aws.NewRDSClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	if res.Spec.MachineClass == database.ServerlessInstanceClass {
+		capacity, er := cl.ScaleDownCluster(ctx, res)
+		if er != nil {
+			return er
+		}
+
+		recordSize(res, capacity)
+
+		return nil
+	}
+
+	err = cl.StopInstance(ctx, res)
+	if err != nil {
+		return err
+	}
+
+	if res.Settings == nil {
+		res.Settings = make(models.Settings)
+	}
+
+	res.Settings[stoppedAtKey] = time.Now().UTC().Format(time.RFC3339)
+
+	return nil
+}
+
+// Describe fetches the instance or the serverless instance with its cluster capacity by its ARN.
+func (d *rdsDriver) Describe(ctx *gofr.Context, creds any, res *models.Resource) (*models.Resource, error) {
+	cl, err := d.aws.NewRDSClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.DescribeInstance(ctx, res)
+}
+
+// ec2Driver manages EC2 instances.
+type ec2Driver struct {
+	aws AWSClient
+}
+
+func (d *ec2Driver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, err := d.aws.NewEC2Client(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllInstances(ctx)
+}
+
+func (d *ec2Driver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, err := d.aws.NewEC2Client(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.StartInstance(ctx, res.Region, res.UID)
+}
+
+func (d *ec2Driver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, err := d.aws.NewEC2Client(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.StopInstance(ctx, res.Region, res.UID)
+}
+
+// Describe fetches the instance from its region instead of listing the instances of every region.
+func (d *ec2Driver) Describe(ctx *gofr.Context, creds any, res *models.Resource) (*models.Resource, error) {
+	cl, err := d.aws.NewEC2Client(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.DescribeInstance(ctx, res.Region, res.UID)
+}
+
+// asgDriver manages Auto Scaling groups, a group is suspended by scaling it to zero instances. Stopping the instances
+// of a group would only make it replace them.
+type asgDriver struct {
+	aws AWSClient
+}
+
+func (d *asgDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, err := d.aws.NewAutoScalingClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllGroups(ctx)
+}
+
+func (d *asgDriver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	var size asg.Size
+
+	if err := suspendedSize(res, &size); err != nil {
+		return err
+	}
+
+	cl, err := d.aws.NewAutoScalingClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	err = cl.StartGroup(ctx, res.Region, res.Name, &size)
+	if err != nil {
+		return err
+	}
+
+	delete(res.Settings, suspendedSizeKey)
+
+	return nil
+}
+
+func (d *asgDriver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, err := d.aws.NewAutoScalingClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	size, err := cl.StopGroup(ctx, res.Region, res.Name)
+	if err != nil {
+		return err
+	}
+
+	recordSize(res, size)
+
+	return nil
+}
+
+// eksNodeGroupDriver manages EKS managed node groups, a node group is suspended by scaling it to zero nodes.
+type eksNodeGroupDriver struct {
+	aws AWSClient
+}
+
+func (d *eksNodeGroupDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, err := d.aws.NewEKSClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllNodeGroups(ctx)
+}
+
+func (d *eksNodeGroupDriver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cluster, nodeGroup, err := nodeGroupSettings(res)
+	if err != nil {
+		return err
+	}
+
+	var size nodegroup.Size
+
+	if err = suspendedSize(res, &size); err != nil {
+		return err
+	}
+
+	cl, err := d.aws.NewEKSClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	err = cl.StartNodeGroup(ctx, res.Region, cluster, nodeGroup, &size)
+	if err != nil {
+		return err
+	}
+
+	delete(res.Settings, suspendedSizeKey)
+
+	return nil
+}
+
+func (d *eksNodeGroupDriver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cluster, nodeGroup, err := nodeGroupSettings(res)
+	if err != nil {
+		return err
+	}
+
+	cl, err := d.aws.NewEKSClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	size, err := cl.StopNodeGroup(ctx, res.Region, cluster, nodeGroup)
+	if err != nil {
+		return err
+	}
+
+	recordSize(res, size)
+
+	return nil
+}
+
+// nodeGroupSettings returns the cluster and name of a node group from its settings.
+func nodeGroupSettings(res *models.Resource) (cluster, nodeGroup string, err error) {
+	cluster, ok := res.Settings["cluster"].(string)
+	if !ok {
+		return "", "", gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.cluster"}}
+	}
+
+	nodeGroup, ok = res.Settings["node_group"].(string)
+	if !ok {
+		return "", "", gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.node_group"}}
+	}
+
+	return cluster, nodeGroup, nil
+}
+
+// ecsServiceDriver manages ECS services, a service is suspended by scaling it to zero tasks.
+type ecsServiceDriver struct {
+	aws AWSClient
+}
+
+func (d *ecsServiceDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, err := d.aws.NewECSClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllServices(ctx)
+}
+
+func (d *ecsServiceDriver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cluster, service, err := ecsServiceSettings(res)
+	if err != nil {
+		return err
+	}
+
+	var size ecsservice.Size
+
+	if err = suspendedSize(res, &size); err != nil {
+		return err
+	}
+
+	cl, err := d.aws.NewECSClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	err = cl.StartService(ctx, res.Region, cluster, service, &size)
+	if err != nil {
+		return err
+	}
+
+	delete(res.Settings, suspendedSizeKey)
+
+	return nil
+}
+
+func (d *ecsServiceDriver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cluster, service, err := ecsServiceSettings(res)
+	if err != nil {
+		return err
+	}
+
+	cl, err := d.aws.NewECSClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	size, err := cl.StopService(ctx, res.Region, cluster, service)
+	if err != nil {
+		return err
+	}
+
+	recordSize(res, size)
+
+	return nil
+}
+
+// ecsServiceSettings returns the cluster and name of an ECS service from its settings.
+func ecsServiceSettings(res *models.Resource) (cluster, service string, err error) {
+	cluster, ok := res.Settings["cluster"].(string)
+	if !ok {
+		return "", "", gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.cluster"}}
+	}
+
+	service, ok = res.Settings["service"].(string)
+	if !ok {
+		return "", "", gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.service"}}
+	}
+
+	return cluster, service, nil
+}
+
+// ociComputeDriver manages OCI compute instances.
+type ociComputeDriver struct {
+	oci OCIClient
+}
+
+func (d *ociComputeDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, err := d.oci.NewComputeClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllInstances(ctx)
+}
+
+func (d *ociComputeDriver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, err := d.oci.NewComputeClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.StartInstance(ctx, res.UID)
+}
+
+func (d *ociComputeDriver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, err := d.oci.NewComputeClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.StopInstance(ctx, res.UID)
+}
+
+// ociDBSystemDriver manages OCI DB systems.
+type ociDBSystemDriver struct {
+	oci OCIClient
+}
+
+func (d *ociDBSystemDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, err := d.oci.NewDatabaseClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllDBSystems(ctx)
+}
+
+func (d *ociDBSystemDriver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, err := d.oci.NewDatabaseClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.StartDBSystem(ctx, res.UID)
+}
+
+func (d *ociDBSystemDriver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, err := d.oci.NewDatabaseClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.StopDBSystem(ctx, res.UID)
+}
+
+// ociAutonomousDBDriver manages OCI Autonomous Databases.
+type ociAutonomousDBDriver struct {
+	oci OCIClient
+}
+
+func (d *ociAutonomousDBDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, err := d.oci.NewDatabaseClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllAutonomousDatabases(ctx)
+}
+
+func (d *ociAutonomousDBDriver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, err := d.oci.NewDatabaseClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.StartAutonomousDatabase(ctx, res.UID)
+}
+
+func (d *ociAutonomousDBDriver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, err := d.oci.NewDatabaseClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.StopAutonomousDatabase(ctx, res.UID)
+}
@@ -0,0 +1,705 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	ocidb "github.com/oracle/oci-go-sdk/v65/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/asg"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/database"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/ecsservice"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/nodegroup"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/vm"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/cloudrun"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/functions"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/gke"
+	ociDatabase "github.com/zopdev/zopdev/api/resources/providers/oci/database"
+	ociVM "github.com/zopdev/zopdev/api/resources/providers/oci/vm"
+)
+
+// stubAutoScaling implements the Auto Scaling API interface with a single group.
+type stubAutoScaling struct{}
+
+func (*stubAutoScaling) DescribeAutoScalingGroupsWithContext(_ aws.Context, _ *autoscaling.DescribeAutoScalingGroupsInput,
+	_ ...request.Option) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	return &autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: []*autoscaling.Group{
+		{AutoScalingGroupName: aws.String("web"), MinSize: aws.Int64(1), DesiredCapacity: aws.Int64(2)},
+	}}, nil
+}
+
+func (*stubAutoScaling) UpdateAutoScalingGroupWithContext(_ aws.Context, _ *autoscaling.UpdateAutoScalingGroupInput,
+	_ ...request.Option) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+	return &autoscaling.UpdateAutoScalingGroupOutput{}, nil
+}
+
+// stubEKS implements the EKS API interface with a single node group and no clusters to list.
+type stubEKS struct{}
+
+func (*stubEKS) ListClustersWithContext(_ aws.Context, _ *eks.ListClustersInput,
+	_ ...request.Option) (*eks.ListClustersOutput, error) {
+	return &eks.ListClustersOutput{}, nil
+}
+
+func (*stubEKS) ListNodegroupsWithContext(_ aws.Context, _ *eks.ListNodegroupsInput,
+	_ ...request.Option) (*eks.ListNodegroupsOutput, error) {
+	return &eks.ListNodegroupsOutput{}, nil
+}
+
+func (*stubEKS) DescribeNodegroupWithContext(_ aws.Context, _ *eks.DescribeNodegroupInput,
+	_ ...request.Option) (*eks.DescribeNodegroupOutput, error) {
+	return &eks.DescribeNodegroupOutput{Nodegroup: &eks.Nodegroup{
+		ScalingConfig: &eks.NodegroupScalingConfig{MinSize: aws.Int64(1), MaxSize: aws.Int64(3), DesiredSize: aws.Int64(2)},
+	}}, nil
+}
+
+func (*stubEKS) UpdateNodegroupConfigWithContext(_ aws.Context, _ *eks.UpdateNodegroupConfigInput,
+	_ ...request.Option) (*eks.UpdateNodegroupConfigOutput, error) {
+	return &eks.UpdateNodegroupConfigOutput{}, nil
+}
+
+// stubECS implements the ECS API interface with a single service and no clusters to list.
+type stubECS struct{}
+
+func (*stubECS) ListClustersWithContext(_ aws.Context, _ *ecs.ListClustersInput,
+	_ ...request.Option) (*ecs.ListClustersOutput, error) {
+	return &ecs.ListClustersOutput{}, nil
+}
+
+func (*stubECS) ListServicesWithContext(_ aws.Context, _ *ecs.ListServicesInput,
+	_ ...request.Option) (*ecs.ListServicesOutput, error) {
+	return &ecs.ListServicesOutput{}, nil
+}
+
+func (*stubECS) DescribeServicesWithContext(_ aws.Context, _ *ecs.DescribeServicesInput,
+	_ ...request.Option) (*ecs.DescribeServicesOutput, error) {
+	return &ecs.DescribeServicesOutput{Services: []*ecs.Service{{DesiredCount: aws.Int64(2)}}}, nil
+}
+
+func (*stubECS) UpdateServiceWithContext(_ aws.Context, _ *ecs.UpdateServiceInput,
+	_ ...request.Option) (*ecs.UpdateServiceOutput, error) {
+	return &ecs.UpdateServiceOutput{}, nil
+}
+
+// stubOCICompute implements the OCI ComputeAPI interface with no-op methods.
+type stubOCICompute struct{}
+
+func (*stubOCICompute) ListInstances(_ context.Context, _ core.ListInstancesRequest) (core.ListInstancesResponse, error) {
+	return core.ListInstancesResponse{}, nil
+}
+
+func (*stubOCICompute) InstanceAction(_ context.Context,
+	_ core.InstanceActionRequest) (core.InstanceActionResponse, error) {
+	return core.InstanceActionResponse{}, nil
+}
+
+// stubOCIDatabase implements the OCI DatabaseAPI interface with no-op methods.
+type stubOCIDatabase struct{}
+
+func (*stubOCIDatabase) ListDbSystems(_ context.Context,
+	_ ocidb.ListDbSystemsRequest) (ocidb.ListDbSystemsResponse, error) {
+	return ocidb.ListDbSystemsResponse{}, nil
+}
+
+func (*stubOCIDatabase) ListDbNodes(_ context.Context, _ ocidb.ListDbNodesRequest) (ocidb.ListDbNodesResponse, error) {
+	return ocidb.ListDbNodesResponse{}, nil
+}
+
+func (*stubOCIDatabase) DbNodeAction(_ context.Context, _ ocidb.DbNodeActionRequest) (ocidb.DbNodeActionResponse, error) {
+	return ocidb.DbNodeActionResponse{}, nil
+}
+
+func (*stubOCIDatabase) ListAutonomousDatabases(_ context.Context,
+	_ ocidb.ListAutonomousDatabasesRequest) (ocidb.ListAutonomousDatabasesResponse, error) {
+	return ocidb.ListAutonomousDatabasesResponse{}, nil
+}
+
+func (*stubOCIDatabase) StartAutonomousDatabase(_ context.Context,
+	_ ocidb.StartAutonomousDatabaseRequest) (ocidb.StartAutonomousDatabaseResponse, error) {
+	return ocidb.StartAutonomousDatabaseResponse{}, nil
+}
+
+func (*stubOCIDatabase) StopAutonomousDatabase(_ context.Context,
+	_ ocidb.StopAutonomousDatabaseRequest) (ocidb.StopAutonomousDatabaseResponse, error) {
+	return ocidb.StopAutonomousDatabaseResponse{}, nil
+}
+
+func TestNewDrivers(t *testing.T) {
+	d := newDrivers(nil, nil, nil)
+
+	assert.IsType(t, &gcpSQLDriver{}, d.get(GCP, SQL))
+	assert.IsType(t, &gceDriver{}, d.get(GCP, GCPCOMPUTE))
+	assert.IsType(t, &gkeNodePoolDriver{}, d.get(GCP, GKENODEPOOL))
+	assert.IsType(t, &cloudRunDriver{}, d.get(GCP, CLOUDRUN))
+	assert.IsType(t, &cloudFunctionDriver{}, d.get(GCP, CLOUDFUNCTION))
+	assert.IsType(t, &gcpDiskDriver{}, d.get(GCP, GCPDISK))
+	assert.IsType(t, &gcpSnapshotDriver{}, d.get(GCP, GCPSNAPSHOT))
+	assert.IsType(t, &gcpAddressDriver{}, d.get(GCP, GCPADDRESS))
+	assert.IsType(t, &memorystoreDriver{}, d.get(GCP, MEMORYSTORE))
+	assert.IsType(t, &rdsDriver{}, d.get(AWS, RDS))
+	assert.IsType(t, &ec2Driver{}, d.get(AWS, AWSCOMPUTE))
+	assert.IsType(t, &asgDriver{}, d.get(AWS, ASG))
+	assert.IsType(t, &eksNodeGroupDriver{}, d.get(AWS, EKSNODEGROUP))
+	assert.IsType(t, &ecsServiceDriver{}, d.get(AWS, ECSSERVICE))
+	assert.IsType(t, &ebsVolumeDriver{}, d.get(AWS, EBSVOLUME))
+	assert.IsType(t, &ebsSnapshotDriver{}, d.get(AWS, EBSSNAPSHOT))
+	assert.IsType(t, &elasticIPDriver{}, d.get(AWS, ELASTICIP))
+	assert.IsType(t, &elastiCacheDriver{}, d.get(AWS, ELASTICACHE))
+	assert.IsType(t, &ociComputeDriver{}, d.get(OCI, OCICOMPUTE))
+	assert.IsType(t, &ociDBSystemDriver{}, d.get(OCI, OCIDBSYSTEM))
+	assert.IsType(t, &ociAutonomousDBDriver{}, d.get(OCI, OCIAUTONOMOUSDB))
+	assert.Nil(t, d.get(GCP, RDS))
+	assert.Nil(t, d.get("Unknown", SQL))
+}
+
+func TestGCPSQLDriver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	creds := map[string]any{"project_id": "test-project"}
+	mockCreds := &google.Credentials{ProjectID: "test-project"}
+	mGCP := NewMockGCPClient(ctrl)
+	instances := []models.Resource{{Name: "sql-instance-1", UID: "test-project/sql-instance-1"}}
+	d := &gcpSQLDriver{gcp: mGCP}
+
+	testCases := []struct {
+		name      string
+		sqlClient *mockSQLClient
+		credsErr  error
+		clientErr error
+		expErr    error
+	}{
+		{name: "Success", sqlClient: &mockSQLClient{instances: instances}},
+		{name: "Error creating credentials", credsErr: errMock, expErr: errMock},
+		{name: "Error creating SQL client", clientErr: errMock, expErr: errMock},
+		{name: "Error from SQL client", sqlClient: &mockSQLClient{isError: true}, expErr: errMock},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expect := func() {
+				if tc.credsErr != nil {
+					mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(nil, tc.credsErr)
+					return
+				}
+
+				mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil)
+
+				if tc.clientErr != nil {
+					mGCP.EXPECT().NewSQLClient(ctx, option.WithCredentials(mockCreds)).Return(nil, tc.clientErr)
+					return
+				}
+
+				mGCP.EXPECT().NewSQLClient(ctx, option.WithCredentials(mockCreds)).Return(tc.sqlClient, nil)
+			}
+
+			expect()
+
+			listed, err := d.List(ctx, creds)
+
+			assert.Equal(t, tc.expErr, err)
+
+			if tc.expErr == nil {
+				assert.Equal(t, instances, listed)
+			}
+
+			expect()
+			assert.Equal(t, tc.expErr, d.Start(ctx, creds, &instances[0]))
+
+			expect()
+			assert.Equal(t, tc.expErr, d.Stop(ctx, creds, &instances[0]))
+		})
+	}
+}
+
+func TestGCEDriver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	creds := map[string]any{"project_id": "test-project"}
+	mockCreds := &google.Credentials{ProjectID: "test-project"}
+	mGCP := NewMockGCPClient(ctrl)
+	res := &models.Resource{Name: "vm-1", UID: "test-project/vm-1", Settings: models.Settings{"zone": "us-central1-a"}}
+	d := &gceDriver{gcp: mGCP}
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil).Times(3)
+	mGCP.EXPECT().NewComputeClient(ctx, option.WithCredentials(mockCreds)).
+		Return(&mockComputeClient{instances: []models.Resource{*res}}, nil).Times(3)
+
+	listed, err := d.List(ctx, creds)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{*res}, listed)
+	require.NoError(t, d.Start(ctx, creds, res))
+	require.NoError(t, d.Stop(ctx, creds, res))
+
+	// The zone of an instance is required to change its state, it is checked before creating a client.
+	noZone := &models.Resource{Name: "vm-2"}
+	zoneErr := gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.zone"}}
+
+	assert.Equal(t, zoneErr, d.Start(ctx, creds, noZone))
+	assert.Equal(t, zoneErr, d.Stop(ctx, creds, noZone))
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil)
+	mGCP.EXPECT().NewComputeClient(ctx, option.WithCredentials(mockCreds)).Return(nil, errMock)
+
+	listed, err = d.List(ctx, creds)
+
+	require.ErrorIs(t, err, errMock)
+	assert.Nil(t, listed)
+}
+
+func TestGKENodePoolDriver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	creds := map[string]any{"project_id": "test-project"}
+	mockCreds := &google.Credentials{ProjectID: "test-project"}
+	mGCP := NewMockGCPClient(ctrl)
+	res := &models.Resource{Name: "dev/pool-1", UID: "test-project/us-central1-a/dev/pool-1",
+		Settings: models.Settings{"cluster": "dev", "location": "us-central1-a", "node_pool": "pool-1"}}
+	size := &gke.Size{NodeCount: 3, Autoscaling: true, MinNodeCount: 1, MaxNodeCount: 5}
+	d := &gkeNodePoolDriver{gcp: mGCP}
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil).Times(3)
+	mGCP.EXPECT().NewGKEClient(ctx, option.WithCredentials(mockCreds)).
+		Return(&mockGKEClient{nodePools: []models.Resource{*res}, size: size}, nil).Times(3)
+
+	listed, err := d.List(ctx, creds)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{*res}, listed)
+
+	// The size of the node pool is recorded when it is suspended and removed once it is restored.
+	require.NoError(t, d.Stop(ctx, creds, res))
+	assert.Equal(t, size, res.Settings["suspended_size"])
+	require.NoError(t, d.Start(ctx, creds, res))
+	assert.NotContains(t, res.Settings, "suspended_size")
+
+	// Settings read from the store hold the recorded size as generic JSON.
+	stored := &models.Resource{Settings: models.Settings{"cluster": "dev", "location": "us-central1-a",
+		"node_pool": "pool-1", "suspended_size": map[string]any{"node_count": float64(3)}}}
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil)
+	mGCP.EXPECT().NewGKEClient(ctx, option.WithCredentials(mockCreds)).Return(&mockGKEClient{isError: true}, nil)
+
+	require.ErrorIs(t, d.Start(ctx, creds, stored), errMock)
+	assert.Contains(t, stored.Settings, "suspended_size")
+
+	// A node pool that was not suspended by zopdev has no recorded size to restore.
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.suspended_size"}},
+		d.Start(ctx, creds, res))
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.location"}},
+		d.Stop(ctx, creds, &models.Resource{Name: "dev/pool-2"}))
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil)
+	mGCP.EXPECT().NewGKEClient(ctx, option.WithCredentials(mockCreds)).Return(nil, errMock)
+
+	listed, err = d.List(ctx, creds)
+
+	require.ErrorIs(t, err, errMock)
+	assert.Nil(t, listed)
+}
+
+func TestCloudRunDriver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	creds := map[string]any{"project_id": "test-project"}
+	mockCreds := &google.Credentials{ProjectID: "test-project"}
+	mGCP := NewMockGCPClient(ctrl)
+	res := &models.Resource{Name: "api", UID: "test-project/us-central1/api",
+		Settings: models.Settings{"location": "us-central1", "min_instances": int64(2)}}
+	size := &cloudrun.Size{ServiceMinInstances: 2, RevisionMinInstances: 1}
+	d := &cloudRunDriver{gcp: mGCP}
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil).Times(3)
+	mGCP.EXPECT().NewCloudRunClient(ctx, option.WithCredentials(mockCreds)).
+		Return(&mockCloudRunClient{services: []models.Resource{*res}, size: size}, nil).Times(3)
+
+	listed, err := d.List(ctx, creds)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{*res}, listed)
+
+	// The minimum number of instances is recorded when the service is suspended and removed once it is restored.
+	require.NoError(t, d.Stop(ctx, creds, res))
+	assert.Equal(t, size, res.Settings["suspended_size"])
+	require.NoError(t, d.Start(ctx, creds, res))
+	assert.NotContains(t, res.Settings, "suspended_size")
+
+	stored := &models.Resource{Settings: models.Settings{"location": "us-central1",
+		"suspended_size": map[string]any{"service_min_instances": float64(2)}}}
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil)
+	mGCP.EXPECT().NewCloudRunClient(ctx, option.WithCredentials(mockCreds)).
+		Return(&mockCloudRunClient{isError: true}, nil)
+
+	require.ErrorIs(t, d.Start(ctx, creds, stored), errMock)
+	assert.Contains(t, stored.Settings, "suspended_size")
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.suspended_size"}},
+		d.Start(ctx, creds, res))
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.location"}},
+		d.Stop(ctx, creds, &models.Resource{Name: "web"}))
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(nil, errMock)
+
+	listed, err = d.List(ctx, creds)
+
+	require.ErrorIs(t, err, errMock)
+	assert.Nil(t, listed)
+}
+
+func TestCloudFunctionDriver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	creds := map[string]any{"project_id": "test-project"}
+	mockCreds := &google.Credentials{ProjectID: "test-project"}
+	mGCP := NewMockGCPClient(ctrl)
+	res := &models.Resource{Name: "resize", UID: "test-project/us-central1/resize",
+		Settings: models.Settings{"location": "us-central1", "min_instances": int64(1)}}
+	size := &functions.Size{MinInstances: 1}
+	d := &cloudFunctionDriver{gcp: mGCP}
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil).Times(3)
+	mGCP.EXPECT().NewCloudFunctionsClient(ctx, option.WithCredentials(mockCreds)).
+		Return(&mockCloudFunctionsClient{functions: []models.Resource{*res}, size: size}, nil).Times(3)
+
+	listed, err := d.List(ctx, creds)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{*res}, listed)
+
+	require.NoError(t, d.Stop(ctx, creds, res))
+	assert.Equal(t, size, res.Settings["suspended_size"])
+	require.NoError(t, d.Start(ctx, creds, res))
+	assert.NotContains(t, res.Settings, "suspended_size")
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.suspended_size"}},
+		d.Start(ctx, creds, res))
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.location"}},
+		d.Stop(ctx, creds, &models.Resource{Name: "thumbnail"}))
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil)
+	mGCP.EXPECT().NewCloudFunctionsClient(ctx, option.WithCredentials(mockCreds)).Return(nil, errMock)
+
+	listed, err = d.List(ctx, creds)
+
+	require.ErrorIs(t, err, errMock)
+	assert.Nil(t, listed)
+}
+
+func TestRDSDriver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	mAWS := NewMockAWSClient(ctrl)
+	res := &models.Resource{Name: "db-1", UID: "arn:db-1", Region: "us-east-1",
+		Settings: models.Settings{"engine": "postgres", "cluster_id": ""}}
+	d := &rdsDriver{aws: mAWS}
+
+	mAWS.EXPECT().NewRDSClient(ctx, gomock.Any()).
+		Return(&database.Client{RDS: map[string]database.RDSAPI{"us-east-1": &stubRDS{}}}, nil).Times(4)
+
+	listed, err := d.List(ctx, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+
+	described, err := d.Describe(ctx, nil, res)
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "DB instance", Value: "arn:db-1"}, err)
+	assert.Nil(t, described)
+	// The stop time is recorded as AWS starts the instance again after seven days, and removed once it is started.
+	require.NoError(t, d.Stop(ctx, nil, res))
+	assert.Contains(t, res.Settings, stoppedAtKey)
+	require.NoError(t, d.Start(ctx, nil, res))
+	assert.NotContains(t, res.Settings, stoppedAtKey)
+
+	// Aurora Serverless v2 clusters are scaled down to the minimum capacity, and restored to the capacity before.
+	serverless := &models.Resource{Name: "writer", Region: "us-east-1",
+		Spec:     models.Spec{MachineClass: database.ServerlessInstanceClass},
+		Settings: models.Settings{"engine": "aurora-postgresql", "cluster_id": "dev"}}
+
+	mAWS.EXPECT().NewRDSClient(ctx, gomock.Any()).
+		Return(&database.Client{RDS: map[string]database.RDSAPI{"us-east-1": &stubRDS{}}}, nil).Times(2)
+
+	require.NoError(t, d.Stop(ctx, nil, serverless))
+	assert.Equal(t, &database.Capacity{MinCapacity: 2, MaxCapacity: 16}, serverless.Settings[suspendedSizeKey])
+	assert.NotContains(t, serverless.Settings, stoppedAtKey)
+	require.NoError(t, d.Start(ctx, nil, serverless))
+	assert.NotContains(t, serverless.Settings, suspendedSizeKey)
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.suspended_size"}},
+		d.Start(ctx, nil, serverless))
+
+	mAWS.EXPECT().NewRDSClient(ctx, gomock.Any()).Return(nil, errMock).Times(4)
+
+	_, err = d.List(ctx, nil)
+
+	require.ErrorIs(t, err, errMock)
+	_, err = d.Describe(ctx, nil, res)
+	require.ErrorIs(t, err, errMock)
+	require.ErrorIs(t, d.Start(ctx, nil, res), errMock)
+	require.ErrorIs(t, d.Stop(ctx, nil, res), errMock)
+}
+
+func TestEC2Driver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	mAWS := NewMockAWSClient(ctrl)
+	res := &models.Resource{Name: "vm-1", UID: "i-123", Region: "us-east-1"}
+	d := &ec2Driver{aws: mAWS}
+
+	mAWS.EXPECT().NewEC2Client(ctx, gomock.Any()).
+		Return(&vm.Client{EC2: map[string]vm.EC2API{"us-east-1": &stubEC2{}}}, nil).Times(4)
+
+	listed, err := d.List(ctx, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+
+	described, err := d.Describe(ctx, nil, res)
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "instance", Value: "i-123"}, err)
+	assert.Nil(t, described)
+	require.NoError(t, d.Start(ctx, nil, res))
+	require.NoError(t, d.Stop(ctx, nil, res))
+
+	mAWS.EXPECT().NewEC2Client(ctx, gomock.Any()).Return(nil, errMock).Times(4)
+
+	_, err = d.List(ctx, nil)
+
+	require.ErrorIs(t, err, errMock)
+	_, err = d.Describe(ctx, nil, res)
+	require.ErrorIs(t, err, errMock)
+	require.ErrorIs(t, d.Start(ctx, nil, res), errMock)
+	require.ErrorIs(t, d.Stop(ctx, nil, res), errMock)
+}
+
+func TestASGDriver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	mAWS := NewMockAWSClient(ctrl)
+	res := &models.Resource{Name: "web", Region: "us-east-1"}
+	d := &asgDriver{aws: mAWS}
+
+	mAWS.EXPECT().NewAutoScalingClient(ctx, gomock.Any()).
+		Return(&asg.Client{AutoScaling: map[string]asg.AutoScalingAPI{"us-east-1": &stubAutoScaling{}}}, nil).Times(3)
+
+	listed, err := d.List(ctx, nil)
+
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+
+	// The capacity of the group is recorded when it is suspended and removed once it is restored.
+	require.NoError(t, d.Stop(ctx, nil, res))
+	assert.Equal(t, &asg.Size{MinSize: 1, DesiredCapacity: 2}, res.Settings["suspended_size"])
+	require.NoError(t, d.Start(ctx, nil, res))
+	assert.NotContains(t, res.Settings, "suspended_size")
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.suspended_size"}},
+		d.Start(ctx, nil, res))
+
+	mAWS.EXPECT().NewAutoScalingClient(ctx, gomock.Any()).Return(nil, errMock).Times(3)
+
+	suspended := &models.Resource{Name: "web", Region: "us-east-1",
+		Settings: models.Settings{"suspended_size": map[string]any{"min_size": float64(1)}}}
+
+	_, err = d.List(ctx, nil)
+
+	require.ErrorIs(t, err, errMock)
+	require.ErrorIs(t, d.Start(ctx, nil, suspended), errMock)
+	require.ErrorIs(t, d.Stop(ctx, nil, res), errMock)
+}
+
+func TestEKSNodeGroupDriver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	mAWS := NewMockAWSClient(ctrl)
+	res := &models.Resource{Name: "dev/pool", Region: "us-east-1",
+		Settings: models.Settings{"cluster": "dev", "node_group": "pool"}}
+	d := &eksNodeGroupDriver{aws: mAWS}
+
+	mAWS.EXPECT().NewEKSClient(ctx, gomock.Any()).
+		Return(&nodegroup.Client{EKS: map[string]nodegroup.EKSAPI{"us-east-1": &stubEKS{}}}, nil).Times(3)
+
+	listed, err := d.List(ctx, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+
+	require.NoError(t, d.Stop(ctx, nil, res))
+	assert.Equal(t, &nodegroup.Size{MinSize: 1, DesiredSize: 2}, res.Settings["suspended_size"])
+	require.NoError(t, d.Start(ctx, nil, res))
+	assert.NotContains(t, res.Settings, "suspended_size")
+
+	// The cluster and name of the node group are required, they are checked before creating a client.
+	noCluster := &models.Resource{Name: "dev/pool", Settings: models.Settings{"node_group": "pool"}}
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.cluster"}},
+		d.Stop(ctx, nil, noCluster))
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.node_group"}},
+		d.Start(ctx, nil, &models.Resource{Settings: models.Settings{"cluster": "dev"}}))
+
+	mAWS.EXPECT().NewEKSClient(ctx, gomock.Any()).Return(nil, errMock).Times(2)
+
+	_, err = d.List(ctx, nil)
+
+	require.ErrorIs(t, err, errMock)
+	require.ErrorIs(t, d.Stop(ctx, nil, res), errMock)
+}
+
+func TestECSServiceDriver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	mAWS := NewMockAWSClient(ctrl)
+	res := &models.Resource{Name: "dev/api", Region: "us-east-1",
+		Settings: models.Settings{"cluster": "dev", "service": "api"}}
+	d := &ecsServiceDriver{aws: mAWS}
+
+	mAWS.EXPECT().NewECSClient(ctx, gomock.Any()).
+		Return(&ecsservice.Client{ECS: map[string]ecsservice.ECSAPI{"us-east-1": &stubECS{}}}, nil).Times(3)
+
+	listed, err := d.List(ctx, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+
+	require.NoError(t, d.Stop(ctx, nil, res))
+	assert.Equal(t, &ecsservice.Size{DesiredCount: 2}, res.Settings["suspended_size"])
+	require.NoError(t, d.Start(ctx, nil, res))
+	assert.NotContains(t, res.Settings, "suspended_size")
+
+	// A service that was not suspended by zopdev has no recorded desired count to restore.
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.suspended_size"}},
+		d.Start(ctx, nil, res))
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.cluster"}},
+		d.Stop(ctx, nil, &models.Resource{Settings: models.Settings{"service": "api"}}))
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.service"}},
+		d.Stop(ctx, nil, &models.Resource{Settings: models.Settings{"cluster": "dev"}}))
+
+	mAWS.EXPECT().NewECSClient(ctx, gomock.Any()).Return(nil, errMock).Times(2)
+
+	_, err = d.List(ctx, nil)
+
+	require.ErrorIs(t, err, errMock)
+	require.ErrorIs(t, d.Stop(ctx, nil, res), errMock)
+}
+
+func TestOCIDrivers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	mOCI := NewMockOCIClient(ctrl)
+	res := &models.Resource{Name: "res-1", UID: "ocid1.res.1"}
+	drivers := []Driver{&ociComputeDriver{oci: mOCI}, &ociDBSystemDriver{oci: mOCI}, &ociAutonomousDBDriver{oci: mOCI}}
+
+	mOCI.EXPECT().NewComputeClient(ctx, gomock.Any()).
+		Return(&ociVM.Client{Compute: &stubOCICompute{}}, nil).Times(3)
+	mOCI.EXPECT().NewDatabaseClient(ctx, gomock.Any()).
+		Return(&ociDatabase.Client{DB: &stubOCIDatabase{}}, nil).Times(6)
+
+	for _, d := range drivers {
+		listed, err := d.List(ctx, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, listed)
+		require.NoError(t, d.Start(ctx, nil, res))
+		require.NoError(t, d.Stop(ctx, nil, res))
+	}
+
+	mOCI.EXPECT().NewComputeClient(ctx, gomock.Any()).Return(nil, errMock).Times(3)
+	mOCI.EXPECT().NewDatabaseClient(ctx, gomock.Any()).Return(nil, errMock).Times(6)
+
+	for _, d := range drivers {
+		_, err := d.List(ctx, nil)
+
+		require.ErrorIs(t, err, errMock)
+		require.ErrorIs(t, d.Start(ctx, nil, res), errMock)
+		require.ErrorIs(t, d.Stop(ctx, nil, res), errMock)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	creds := map[string]any{"project_id": "test-project"}
+	mockCreds := &google.Credentials{ProjectID: "test-project"}
+	mGCP := NewMockGCPClient(ctrl)
+	listed := []models.Resource{
+		{Name: "sql-instance-1", UID: "test-project/sql-instance-1", Status: RUNNING},
+		{Name: "sql-instance-2", UID: "test-project/sql-instance-2", Status: STOPPED},
+	}
+	d := &gcpSQLDriver{gcp: mGCP}
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil).Times(3)
+	mGCP.EXPECT().NewSQLClient(ctx, option.WithCredentials(mockCreds)).
+		Return(&mockSQLClient{instances: listed}, nil).Times(2)
+	mGCP.EXPECT().NewSQLClient(ctx, option.WithCredentials(mockCreds)).Return(nil, errMock)
+
+	res, err := describe(ctx, d, creds, &models.Resource{UID: "test-project/sql-instance-2"})
+
+	require.NoError(t, err)
+	assert.Equal(t, &listed[1], res)
+
+	res, err = describe(ctx, d, creds, &models.Resource{UID: "test-project/sql-instance-3"})
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "resource", Value: "test-project/sql-instance-3"}, err)
+	assert.Nil(t, res)
+
+	res, err = describe(ctx, d, creds, &models.Resource{UID: "test-project/sql-instance-1"})
+
+	require.ErrorIs(t, err, errMock)
+	assert.Nil(t, res)
+
+	// The Compute Engine instances are fetched one by one.
+	vm := models.Resource{Name: "vm-1", UID: "test-project/us-central1-a/vm-1", Status: STOPPING,
+		Settings: models.Settings{"zone": "us-central1-a"}}
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil)
+	mGCP.EXPECT().NewComputeClient(ctx, option.WithCredentials(mockCreds)).
+		Return(&mockComputeClient{instances: []models.Resource{vm}}, nil)
+
+	res, err = describe(ctx, &gceDriver{gcp: mGCP}, creds, &vm)
+
+	require.NoError(t, err)
+	assert.Equal(t, &vm, res)
+
+	res, err = describe(ctx, &gceDriver{gcp: mGCP}, creds, &models.Resource{Name: "vm-2"})
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.zone"}}, err)
+	assert.Nil(t, res)
+}
@@ -0,0 +1,129 @@
+package resource
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// ErrOperationInProgress is returned when the state of a resource is changed while a previous change is still in progress.
+type ErrOperationInProgress struct {
+	ResourceID int64 `json:"resourceID"`
+}
+
+func (e *ErrOperationInProgress) Error() string {
+	return fmt.Sprintf("an operation is already in progress for resource %d", e.ResourceID)
+}
+
+func (*ErrOperationInProgress) StatusCode() int {
+	return http.StatusConflict
+}
+
+// ErrResourceLocked is returned when a resource is suspended while a keep-awake lock on it or on one of its resource
+// groups is active.
+type ErrResourceLocked struct {
+	ResourceID int64     `json:"resourceID"`
+	Until      time.Time `json:"until"`
+	Author     string    `json:"author"`
+	Reason     string    `json:"reason,omitempty"`
+}
+
+func (e *ErrResourceLocked) Error() string {
+	return fmt.Sprintf("resource %d is kept awake by %s until %s", e.ResourceID, e.Author,
+		e.Until.Format(time.RFC3339))
+}
+
+func (*ErrResourceLocked) StatusCode() int {
+	return http.StatusConflict
+}
+
+// ErrBlackout is returned when a resource is suspended during a period of a blackout calendar of one of its resource
+// groups.
+type ErrBlackout struct {
+	ResourceID int64     `json:"resourceID"`
+	Calendar   string    `json:"calendar"`
+	Period     string    `json:"period,omitempty"`
+	Until      time.Time `json:"until"`
+}
+
+func (e *ErrBlackout) Error() string {
+	return fmt.Sprintf("resource %d cannot be suspended during the blackout calendar %s until %s", e.ResourceID,
+		e.Calendar, e.Until.Format(time.RFC3339))
+}
+
+func (*ErrBlackout) StatusCode() int {
+	return http.StatusConflict
+}
+
+// ErrResourceInUse is returned when a waste candidate is deleted while it is attached, e.g. a disk used by an instance.
+type ErrResourceInUse struct {
+	ResourceID int64 `json:"resourceID"`
+}
+
+func (e *ErrResourceInUse) Error() string {
+	return fmt.Sprintf("resource %d is attached and cannot be deleted", e.ResourceID)
+}
+
+func (*ErrResourceInUse) StatusCode() int {
+	return http.StatusConflict
+}
+
+// ErrNotDeletable is returned when a resource whose type is started and stopped rather than deleted is deleted.
+type ErrNotDeletable struct {
+	ResourceID int64  `json:"resourceID"`
+	Type       string `json:"type"`
+}
+
+func (e *ErrNotDeletable) Error() string {
+	return fmt.Sprintf("%s resource %d cannot be deleted", e.Type, e.ResourceID)
+}
+
+func (*ErrNotDeletable) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// ErrNotChangeable is returned when a resource whose type is only inventoried or deleted, e.g. a cache or an
+// unattached disk, is started or suspended.
+type ErrNotChangeable struct {
+	ResourceID int64  `json:"resourceID"`
+	Type       string `json:"type"`
+}
+
+func (e *ErrNotChangeable) Error() string {
+	return fmt.Sprintf("%s resource %d cannot be started or suspended", e.Type, e.ResourceID)
+}
+
+func (*ErrNotChangeable) StatusCode() int {
+	return http.StatusBadRequest
+}
+
+// ErrSyncFailed is returned when none of the resource types of a cloud account could be listed during a sync.
+type ErrSyncFailed struct {
+	CloudAccountID int64               `json:"cloudAccountID"`
+	Statuses       models.SyncStatuses `json:"statuses"`
+}
+
+func (e *ErrSyncFailed) Error() string {
+	return fmt.Sprintf("failed to list the resources of cloud account %d", e.CloudAccountID)
+}
+
+func (*ErrSyncFailed) StatusCode() int {
+	return http.StatusBadGateway
+}
+
+// ErrUsageUnknown is returned when the idleness of a resource is checked while no usage metric was reported for it
+// over the window.
+type ErrUsageUnknown struct {
+	ResourceID int64  `json:"resourceID"`
+	Window     string `json:"window"`
+}
+
+func (e *ErrUsageUnknown) Error() string {
+	return fmt.Sprintf("no usage metric was reported for resource %d over the last %s", e.ResourceID, e.Window)
+}
+
+func (*ErrUsageUnknown) StatusCode() int {
+	return http.StatusUnprocessableEntity
+}
@@ -0,0 +1,24 @@
+package resource
+
+import (
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// GetHistory returns the change history of a resource of the given cloud account within the time range.
+// The history is kept after the resource is removed, so the resource is not required to exist anymore.
+func (s *Service) GetHistory(ctx *gofr.Context, cloudAccID, resourceID int64, from, to time.Time) ([]models.Event, error) {
+	return s.store.GetEvents(ctx, cloudAccID, resourceID, from, to)
+}
+
+// recordEvent appends an event to the history of a resource. The history is best effort, a failure to record
+// an event is logged and does not fail the change that is being recorded.
+func (s *Service) recordEvent(ctx *gofr.Context, event *models.Event) {
+	err := s.store.InsertEvent(ctx, event)
+	if err != nil {
+		ctx.Errorf("failed to record %s event for resource %d: %v", event.Type, event.ResourceID, err)
+	}
+}
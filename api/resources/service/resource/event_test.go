@@ -0,0 +1,49 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
+)
+
+func TestService_GetHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background()}
+	s := New(nil, nil, nil, nil, mStore, &pricing.Catalog{}, nil)
+	from, to := time.Now().Add(-time.Hour), time.Now()
+	events := []models.Event{{ID: 1, ResourceID: 2, CloudAccountID: 3, Type: EventRemoved, Actor: ActorSync}}
+
+	mStore.EXPECT().GetEvents(ctx, int64(3), int64(2), from, to).Return(events, nil)
+
+	res, err := s.GetHistory(ctx, 3, 2, from, to)
+
+	assert.NoError(t, err)
+	assert.Equal(t, events, res)
+}
+
+func TestService_recordEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	s := New(nil, nil, nil, nil, mStore, &pricing.Catalog{}, nil)
+	event := &models.Event{ResourceID: 2, CloudAccountID: 3, Type: EventCreated, Actor: ActorSync}
+
+	// A failure to record the event is only logged.
+	mStore.EXPECT().InsertEvent(ctx, event).Return(errMock)
+
+	s.recordEvent(ctx, event)
+}
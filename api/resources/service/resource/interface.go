@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"time"
 
 	"gofr.dev/pkg/gofr"
 	"golang.org/x/oauth2/google"
@@ -9,19 +10,83 @@ import (
 
 	"github.com/zopdev/zopdev/api/resources/client"
 	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/asg"
 	"github.com/zopdev/zopdev/api/resources/providers/aws/database"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/ebs"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/ecsservice"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/eip"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/elasticache"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/nodegroup"
 	"github.com/zopdev/zopdev/api/resources/providers/aws/vm"
 	"github.com/zopdev/zopdev/api/resources/providers/gcp"
+	ociDatabase "github.com/zopdev/zopdev/api/resources/providers/oci/database"
+	ociVM "github.com/zopdev/zopdev/api/resources/providers/oci/vm"
 )
 
 type GCPClient interface {
 	NewGoogleCredentials(ctx context.Context, cred any, scopes ...string) (*google.Credentials, error)
 	NewSQLClient(ctx context.Context, opts ...option.ClientOption) (gcp.SQLClient, error)
+	NewComputeClient(ctx context.Context, opts ...option.ClientOption) (gcp.ComputeClient, error)
+	NewGKEClient(ctx context.Context, opts ...option.ClientOption) (gcp.GKEClient, error)
+	NewCloudRunClient(ctx context.Context, opts ...option.ClientOption) (gcp.CloudRunClient, error)
+	NewCloudFunctionsClient(ctx context.Context, opts ...option.ClientOption) (gcp.CloudFunctionsClient, error)
+	NewDiskClient(ctx context.Context, opts ...option.ClientOption) (gcp.DiskClient, error)
+	NewAddressClient(ctx context.Context, opts ...option.ClientOption) (gcp.AddressClient, error)
+	NewMemorystoreClient(ctx context.Context, opts ...option.ClientOption) (gcp.MemorystoreClient, error)
+	NewMetricsClient(ctx context.Context, opts ...option.ClientOption) (gcp.MetricsClient, error)
 }
 
 type AWSClient interface {
 	NewRDSClient(_ context.Context, creds any) (*database.Client, error)
 	NewEC2Client(_ context.Context, creds any) (*vm.Client, error)
+	NewAutoScalingClient(_ context.Context, creds any) (*asg.Client, error)
+	NewEKSClient(_ context.Context, creds any) (*nodegroup.Client, error)
+	NewECSClient(_ context.Context, creds any) (*ecsservice.Client, error)
+	NewEBSClient(_ context.Context, creds any) (*ebs.Client, error)
+	NewElasticIPClient(_ context.Context, creds any) (*eip.Client, error)
+	NewElastiCacheClient(_ context.Context, creds any) (*elasticache.Client, error)
+}
+
+type OCIClient interface {
+	NewComputeClient(_ context.Context, creds any) (*ociVM.Client, error)
+	NewDatabaseClient(_ context.Context, creds any) (*ociDatabase.Client, error)
+}
+
+// Driver manages the resources of one resource type of a cloud provider. The service dispatches the listing and the
+// state changes of a resource to the driver registered for its cloud provider and resource type.
+type Driver interface {
+	// List lists the resources of the type in a cloud account.
+	List(ctx *gofr.Context, creds any) ([]models.Resource, error)
+	// Start starts a stopped resource.
+	Start(ctx *gofr.Context, creds any, res *models.Resource) error
+	// Stop stops a running resource.
+	Stop(ctx *gofr.Context, creds any, res *models.Resource) error
+}
+
+// Describer is implemented by the drivers whose provider client fetches a single resource, to check the state of a
+// resource without listing all the resources of its type. The state of the other resources is checked by listing.
+type Describer interface {
+	// Describe fetches the current state of a resource from the cloud provider.
+	Describe(ctx *gofr.Context, creds any, res *models.Resource) (*models.Resource, error)
+}
+
+// Deleter is implemented by the drivers of the resource types that are waste candidates rather than start and stop
+// targets, e.g. unattached disks, orphaned snapshots and unused IP addresses. These resources can only be deleted.
+type Deleter interface {
+	// Delete deletes a resource from the cloud provider.
+	Delete(ctx *gofr.Context, creds any, res *models.Resource) error
+}
+
+// IdleDetector is implemented by the drivers of the resource types whose usage is measured, e.g. the caches, to report
+// whether a resource was idle over a window.
+type IdleDetector interface {
+	// Idle reports whether the resource served no client over the window that ends now.
+	Idle(ctx *gofr.Context, creds any, res *models.Resource, window time.Duration) (bool, error)
+}
+
+// Pricing estimates the cost of a resource, nil is returned for resources that cannot be priced.
+type Pricing interface {
+	Estimate(res *models.Resource) *models.Cost
 }
 
 type HTTPClient interface {
@@ -31,8 +96,40 @@ type HTTPClient interface {
 
 type Store interface {
 	InsertResource(ctx *gofr.Context, resources *models.Resource) error
-	GetResources(ctx *gofr.Context, cloudAccountID int64, resourceType []string) ([]models.Resource, error)
+	GetResources(ctx *gofr.Context, cloudAccountID int64, resourceType []string,
+		selectors ...models.LabelSelector) ([]models.Resource, error)
 	UpdateStatus(ctx *gofr.Context, status string, id int64) error
+	UpdateRegion(ctx *gofr.Context, region string, id int64) error
+	UpdateLabels(ctx *gofr.Context, resourceID int64, labels models.Labels) error
+	UpdateSpec(ctx *gofr.Context, spec models.Spec, id int64) error
+	UpdateSettings(ctx *gofr.Context, settings models.Settings, id int64) error
 	RemoveResource(ctx *gofr.Context, id int64) error
 	GetResourceByID(ctx *gofr.Context, id int64) (*models.Resource, error)
+	GetResourceGroupNames(ctx *gofr.Context, cloudAccountID int64) (map[int64][]string, error)
+	SearchResources(ctx *gofr.Context, q *models.ResourceQuery) ([]models.Resource, error)
+
+	InsertOperation(ctx *gofr.Context, op *models.Operation) error
+	GetOperations(ctx *gofr.Context, resourceID int64) ([]models.Operation, error)
+	GetOperationsByStatus(ctx *gofr.Context, status string) ([]models.Operation, error)
+	HasOperation(ctx *gofr.Context, resourceID int64, status string) (bool, error)
+	CompleteOperation(ctx *gofr.Context, id int64, status, errMsg string) error
+
+	InsertEvent(ctx *gofr.Context, event *models.Event) error
+	GetEvents(ctx *gofr.Context, cloudAccountID, resourceID int64, from, to time.Time) ([]models.Event, error)
+
+	InsertSavings(ctx *gofr.Context, savings *models.Savings) error
+	GetOpenSavings(ctx *gofr.Context, resourceID int64) (*models.Savings, error)
+	CloseSavings(ctx *gofr.Context, id int64, startedAt time.Time, amount float64) error
+	VoidSavings(ctx *gofr.Context, id int64) error
+	GetSavings(ctx *gofr.Context, cloudAccountID int64, from, to time.Time) ([]models.Savings, error)
+
+	InsertSyncRun(ctx *gofr.Context, run *models.SyncRun) error
+	CompleteSyncRun(ctx *gofr.Context, run *models.SyncRun) error
+	GetSyncRuns(ctx *gofr.Context, cloudAccountID int64, limit int) ([]models.SyncRun, error)
+
+	InsertLock(ctx *gofr.Context, lock *models.Lock) error
+	GetLocks(ctx *gofr.Context, cloudAccountID, resourceID, groupID int64, at time.Time) ([]models.Lock, error)
+	GetActiveLock(ctx *gofr.Context, resourceID int64, at time.Time) (*models.Lock, error)
+	ReleaseLock(ctx *gofr.Context, cloudAccountID, id int64, at time.Time) (bool, error)
+	GetActiveBlackout(ctx *gofr.Context, resourceID int64, at time.Time) (*models.Calendar, error)
 }
@@ -4,113 +4,58 @@ import (
 	"strings"
 
 	"gofr.dev/pkg/gofr"
-	"google.golang.org/api/option"
 
 	"github.com/zopdev/zopdev/api/resources/client"
 	"github.com/zopdev/zopdev/api/resources/models"
 )
 
-func (s *Service) getAllInstances(ctx *gofr.Context, ca *client.CloudAccount) ([]models.Resource, error) {
-	var instances []models.Resource
+// getAllInstances lists the resources of a cloud account through the drivers of its cloud provider. The drivers run
+// concurrently and independently, the resources of the drivers that succeeded are returned with the sync status of
+// every resource type. The failure of one driver does not prevent syncing the resources of the others.
+func (s *Service) getAllInstances(ctx *gofr.Context, ca *client.CloudAccount) ([]models.Resource, models.SyncStatuses) {
+	// Unknown cloud providers have no drivers, their sync is not implemented and lists nothing.
+	return listInstances(ctx, ca, s.drivers[CloudProvider(strings.ToUpper(ca.Provider))])
+}
 
+// listInstances lists the resources of a cloud account through the given drivers, keyed by resource type.
+func listInstances(ctx *gofr.Context, ca *client.CloudAccount,
+	drivers map[ResourceType]Driver) ([]models.Resource, models.SyncStatuses) {
 	type result struct {
-		instances []models.Resource
-		err       error
+		resourceType ResourceType
+		instances    []models.Resource
+		err          error
 	}
 
-	sqlCh := make(chan result, 1)
-	computeCh := make(chan result, 1)
+	results := make(chan result, len(drivers))
 
-	// Fetch SQL instances concurrently
-	go func() {
-		sql, err := s.getAllSQLInstances(ctx, CloudDetails{
-			CloudType: CloudProvider(strings.ToUpper(ca.Provider)),
-			Creds:     ca.Credentials,
-		})
+	for resourceType, d := range drivers {
+		go func() {
+			instances, err := d.List(ctx, ca.Credentials)
+			results <- result{resourceType: resourceType, instances: instances, err: err}
+		}()
+	}
 
-		for i := range sql {
-			sql[i].CloudAccount.ID = ca.ID
-			sql[i].CloudAccount.Type = ca.Provider
-		}
+	var instances []models.Resource
 
-		sqlCh <- result{sql, err}
-	}()
+	statuses := make(models.SyncStatuses, len(drivers))
 
-	// Fetch compute instances concurrently
-	go func() {
-		computeInstances, err := s.getALLComputeInstances(ctx, CloudDetails{
-			CloudType: CloudProvider(strings.ToUpper(ca.Provider)),
-			Creds:     ca.Credentials,
-		})
+	for range drivers {
+		r := <-results
 
-		for i := range computeInstances {
-			computeInstances[i].CloudAccount.ID = ca.ID
-			computeInstances[i].CloudAccount.Type = ca.Provider
+		if r.err != nil {
+			statuses[string(r.resourceType)] = models.SyncStatus{Status: SyncFailed, Error: r.err.Error()}
+			continue
 		}
 
-		computeCh <- result{computeInstances, err}
-	}()
-
-	var sqlRes, computeRes result
+		statuses[string(r.resourceType)] = models.SyncStatus{Status: SyncSucceeded}
 
-	for i := 0; i < 2; i++ {
-		select {
-		case r := <-sqlCh:
-			sqlRes = r
-		case r := <-computeCh:
-			computeRes = r
+		for i := range r.instances {
+			r.instances[i].CloudAccount.ID = ca.ID
+			r.instances[i].CloudAccount.Type = ca.Provider
 		}
-	}
-
-	if sqlRes.err != nil {
-		return nil, sqlRes.err
-	}
-
-	if computeRes.err != nil {
-		return nil, computeRes.err
-	}
-
-	instances = append(instances, sqlRes.instances...)
-	instances = append(instances, computeRes.instances...)
-
-	// Get all other instances (e.g., Compute Engine, Kubernetes, etc.)
-	// TODO: Implement other instance types
-
-	return instances, nil
-}
-
-func (s *Service) getAllSQLInstances(ctx *gofr.Context, req CloudDetails) ([]models.Resource, error) {
-	switch req.CloudType {
-	case GCP:
-		return s.getGCPSQLInstances(ctx, req.Creds)
-	case AWS:
-		return s.getAWSRDSInstances(ctx, req.Creds)
-	default:
-		// We are not returning any error because the sync process is completely internal, works on the cloud Account ID,
-		// if we are getting an unknown cloud type, then this feature is not implemented and we simply return nil.
-		return nil, nil
-	}
-}
-
-func (s *Service) getGCPSQLInstances(ctx *gofr.Context, cred any) ([]models.Resource, error) {
-	creds, err := s.gcp.NewGoogleCredentials(ctx, cred, "https://www.googleapis.com/auth/cloud-platform")
-	if err != nil {
-		return nil, err
-	}
-
-	sqlClient, err := s.gcp.NewSQLClient(ctx, option.WithCredentials(creds))
-	if err != nil {
-		return nil, err
-	}
-
-	return sqlClient.GetAllInstances(ctx, creds.ProjectID)
-}
 
-func (s *Service) getAWSRDSInstances(ctx *gofr.Context, cred any) ([]models.Resource, error) {
-	awsRDSClient, err := s.aws.NewRDSClient(ctx, cred)
-	if err != nil {
-		return nil, err
+		instances = append(instances, r.instances...)
 	}
 
-	return awsRDSClient.GetAllInstances(ctx)
+	return instances, statuses
 }
@@ -5,121 +5,56 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"gofr.dev/pkg/gofr"
-	"golang.org/x/oauth2/google"
-	"google.golang.org/api/option"
 
+	"github.com/zopdev/zopdev/api/resources/client"
 	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/nodegroup"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/vm"
 )
 
-func TestService_getAllSQLInstances_UnsupportedCloud(t *testing.T) {
-	ctx := &gofr.Context{}
-	req := CloudDetails{CloudType: "Unknown"}
-
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-	mAWS := NewMockAWSClient(ctrl)
-	s := New(nil, mAWS, nil, nil)
-	instances, err := s.getAllSQLInstances(ctx, req)
-
-	assert.Nil(t, instances)
-	require.NoError(t, err)
-}
-
-func TestService_getAllSQLInstances_GCP(t *testing.T) {
+func TestService_getAllInstances(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	req := CloudDetails{
-		CloudType: GCP,
-		Creds: map[string]any{
-			"project_id": "test-project",
-			"region":     "us-central1",
-		},
-	}
-	ctx := &gofr.Context{
-		Context: context.Background(),
-	}
-
-	mockGCP := NewMockGCPClient(ctrl)
-	mockCreds := &google.Credentials{ProjectID: "test-project"}
-	mockResp := []models.Resource{
-		{Name: "sql-instance-1"}, {Name: "sql-instance-2"},
-	}
-	mockLister := &mockSQLClient{
-		isError:   false,
-		instances: mockResp,
-	}
-
-	testCases := []struct {
-		name      string
-		req       CloudDetails
-		expResp   []models.Resource
-		expErr    error
-		mockCalls func()
-	}{
-		{
-			name:    "Success",
-			req:     req,
-			expResp: mockResp,
-			expErr:  nil,
-			mockCalls: func() {
-				mockGCP.EXPECT().NewGoogleCredentials(ctx, req.Creds, "https://www.googleapis.com/auth/cloud-platform").
-					Return(mockCreds, nil)
-				mockGCP.EXPECT().NewSQLClient(ctx, option.WithCredentials(mockCreds)).
-					Return(mockLister, nil)
-			},
-		},
-		{
-			name:   "Error creating credentials",
-			req:    req,
-			expErr: errMock,
-			mockCalls: func() {
-				mockGCP.EXPECT().NewGoogleCredentials(ctx, req.Creds, "https://www.googleapis.com/auth/cloud-platform").
-					Return(nil, errMock)
-			},
-		},
-		{
-			name:   "Error creating SQL instance lister",
-			req:    req,
-			expErr: errMock,
-			mockCalls: func() {
-				mockGCP.EXPECT().NewGoogleCredentials(ctx, req.Creds, "https://www.googleapis.com/auth/cloud-platform").
-					Return(mockCreds, nil)
-				mockGCP.EXPECT().NewSQLClient(ctx, option.WithCredentials(mockCreds)).
-					Return(nil, errMock)
-			},
-		},
-		{
-			name:   "Error getting SQL instances",
-			req:    req,
-			expErr: errMock,
-			mockCalls: func() {
-				mockLister.isError = true
-
-				mockGCP.EXPECT().NewGoogleCredentials(ctx, req.Creds, "https://www.googleapis.com/auth/cloud-platform").
-					Return(mockCreds, nil)
-				mockGCP.EXPECT().NewSQLClient(ctx, option.WithCredentials(mockCreds)).
-					Return(mockLister, nil)
-			},
-		},
-	}
-
+	ctx := &gofr.Context{Context: context.Background()}
 	mAWS := NewMockAWSClient(ctrl)
-	s := New(mockGCP, mAWS, nil, nil)
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			tc.mockCalls()
+	s := New(nil, mAWS, nil, nil, nil, &pricing.Catalog{}, nil)
 
-			instances, err := s.getAllSQLInstances(ctx, req)
+	// Unknown cloud providers have no drivers.
+	instances, statuses := s.getAllInstances(ctx, &client.CloudAccount{ID: 1, Provider: "Unknown"})
 
-			assert.Equal(t, tc.expResp, instances)
-			assert.Equal(t, tc.expErr, err)
-		})
-	}
+	assert.Nil(t, instances)
+	assert.Empty(t, statuses)
+
+	// A failing driver does not prevent listing the other resource types.
+	mAWS.EXPECT().NewRDSClient(ctx, gomock.Any()).Return(nil, errMock)
+	mAWS.EXPECT().NewEC2Client(ctx, gomock.Any()).
+		Return(&vm.Client{EC2: map[string]vm.EC2API{"us-east-1": &stubEC2{}}}, nil)
+	mAWS.EXPECT().NewAutoScalingClient(ctx, gomock.Any()).Return(nil, errMock)
+	mAWS.EXPECT().NewEKSClient(ctx, gomock.Any()).
+		Return(&nodegroup.Client{EKS: map[string]nodegroup.EKSAPI{"us-east-1": &stubEKS{}}}, nil)
+	mAWS.EXPECT().NewECSClient(ctx, gomock.Any()).Return(nil, errMock)
+	mAWS.EXPECT().NewEBSClient(ctx, gomock.Any()).Return(nil, errMock).Times(2)
+	mAWS.EXPECT().NewElasticIPClient(ctx, gomock.Any()).Return(nil, errMock)
+	mAWS.EXPECT().NewElastiCacheClient(ctx, gomock.Any()).Return(nil, errMock)
+
+	instances, statuses = s.getAllInstances(ctx, &client.CloudAccount{ID: 2, Provider: "aws"})
+
+	assert.Empty(t, instances)
+	assert.Equal(t, models.SyncStatuses{
+		string(RDS):          {Status: SyncFailed, Error: errMock.Error()},
+		string(AWSCOMPUTE):   {Status: SyncSucceeded},
+		string(ASG):          {Status: SyncFailed, Error: errMock.Error()},
+		string(EKSNODEGROUP): {Status: SyncSucceeded},
+		string(ECSSERVICE):   {Status: SyncFailed, Error: errMock.Error()},
+		string(EBSVOLUME):    {Status: SyncFailed, Error: errMock.Error()},
+		string(EBSSNAPSHOT):  {Status: SyncFailed, Error: errMock.Error()},
+		string(ELASTICIP):    {Status: SyncFailed, Error: errMock.Error()},
+		string(ELASTICACHE):  {Status: SyncFailed, Error: errMock.Error()},
+	}, statuses)
 }
 
 func TestService_bSearch(t *testing.T) {
@@ -0,0 +1,112 @@
+package resource
+
+import (
+	"strconv"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// maxLockDuration is the longest time a keep-awake lock can be held for.
+const maxLockDuration = 7 * 24 * time.Hour
+
+// LockResource keeps a resource of a cloud account from being suspended until the lock expires.
+func (s *Service) LockResource(ctx *gofr.Context, cloudAccID, resourceID int64,
+	lock *models.Lock) (*models.Lock, error) {
+	res, err := s.store.GetResourceByID(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil || res.CloudAccount.ID != cloudAccID {
+		return nil, gofrHttp.ErrorEntityNotFound{Name: "resource"}
+	}
+
+	lock.CloudAccountID, lock.ResourceID, lock.GroupID = cloudAccID, resourceID, 0
+
+	return s.CreateLock(ctx, lock)
+}
+
+// CreateLock creates a keep-awake lock on the resource or the resource group of the lock, the caller checks that they
+// belong to the cloud account of the lock. The lock is held until its time or for its duration from now.
+func (s *Service) CreateLock(ctx *gofr.Context, lock *models.Lock) (*models.Lock, error) {
+	now := time.Now().UTC()
+
+	if lock.Author == "" {
+		return nil, gofrHttp.ErrorMissingParam{Params: []string{"author"}}
+	}
+
+	if lock.Duration != "" {
+		d, err := time.ParseDuration(lock.Duration)
+		if err != nil || d <= 0 {
+			return nil, gofrHttp.ErrorInvalidParam{Params: []string{"duration"}}
+		}
+
+		lock.Until = now.Add(d)
+	}
+
+	switch {
+	case lock.Until.IsZero():
+		return nil, gofrHttp.ErrorMissingParam{Params: []string{"until"}}
+	case !lock.Until.After(now) || lock.Until.Sub(now) > maxLockDuration:
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"until"}}
+	}
+
+	lock.Until, lock.Duration, lock.CreatedAt = lock.Until.UTC(), "", now
+
+	if err := s.store.InsertLock(ctx, lock); err != nil {
+		return nil, err
+	}
+
+	return lock, nil
+}
+
+// GetLocks returns the active keep-awake locks of a cloud account. A non-zero resource or group ID restricts them to
+// the locks held on the resource or on the group.
+func (s *Service) GetLocks(ctx *gofr.Context, cloudAccID, resourceID, groupID int64) ([]models.Lock, error) {
+	return s.store.GetLocks(ctx, cloudAccID, resourceID, groupID, time.Now().UTC())
+}
+
+// ReleaseLock expires an active keep-awake lock of a cloud account before its time.
+func (s *Service) ReleaseLock(ctx *gofr.Context, cloudAccID, lockID int64) error {
+	released, err := s.store.ReleaseLock(ctx, cloudAccID, lockID, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	if !released {
+		return gofrHttp.ErrorEntityNotFound{Name: "lock", Value: strconv.FormatInt(lockID, 10)}
+	}
+
+	return nil
+}
+
+// checkSuspend returns an error when a resource is kept running, either by a keep-awake lock on the resource or on one
+// of its groups until the lock expires, or by a blackout calendar of one of its groups until the blackout ends.
+func (s *Service) checkSuspend(ctx *gofr.Context, resourceID int64) error {
+	now := time.Now().UTC()
+
+	lock, err := s.store.GetActiveLock(ctx, resourceID, now)
+	if err != nil {
+		return err
+	}
+
+	if lock != nil {
+		return &ErrResourceLocked{ResourceID: resourceID, Until: lock.Until, Author: lock.Author, Reason: lock.Reason}
+	}
+
+	cal, err := s.store.GetActiveBlackout(ctx, resourceID, now)
+	if err != nil {
+		return err
+	}
+
+	if cal != nil && len(cal.Periods) > 0 {
+		return &ErrBlackout{ResourceID: resourceID, Calendar: cal.Name, Period: cal.Periods[0].Name,
+			Until: cal.Periods[0].End}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
+)
+
+func TestService_LockResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background()}
+	s := New(nil, nil, nil, nil, mStore, &pricing.Catalog{}, nil)
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+		Return(&models.Resource{ID: 2, CloudAccount: models.CloudAccount{ID: 123}}, nil)
+	mStore.EXPECT().InsertLock(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, lock *models.Lock) error {
+		lock.ID = 5
+		return nil
+	})
+
+	start := time.Now()
+	lock, err := s.LockResource(ctx, 123, 2, &models.Lock{GroupID: 7, Duration: "2h", Author: "jane"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), lock.ID)
+	assert.Equal(t, int64(123), lock.CloudAccountID)
+	assert.Equal(t, int64(2), lock.ResourceID)
+	assert.Zero(t, lock.GroupID)
+	assert.Empty(t, lock.Duration)
+	assert.WithinDuration(t, start.Add(2*time.Hour), lock.Until, time.Minute)
+
+	// A resource of another cloud account is not found.
+	mStore.EXPECT().GetResourceByID(ctx, int64(3)).
+		Return(&models.Resource{ID: 3, CloudAccount: models.CloudAccount{ID: 456}}, nil)
+
+	lock, err = s.LockResource(ctx, 123, 3, &models.Lock{Duration: "2h", Author: "jane"})
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "resource"}, err)
+	assert.Nil(t, lock)
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(nil, errMock)
+
+	lock, err = s.LockResource(ctx, 123, 2, &models.Lock{Duration: "2h", Author: "jane"})
+
+	require.ErrorIs(t, err, errMock)
+	assert.Nil(t, lock)
+}
+
+func TestService_CreateLock_Invalid(t *testing.T) {
+	s := New(nil, nil, nil, nil, nil, &pricing.Catalog{}, nil)
+	ctx := &gofr.Context{Context: context.Background()}
+	now := time.Now()
+
+	testCases := []struct {
+		name   string
+		lock   *models.Lock
+		expErr error
+	}{
+		{"missing author", &models.Lock{Duration: "1h"}, gofrHttp.ErrorMissingParam{Params: []string{"author"}}},
+		{"invalid duration", &models.Lock{Duration: "soon", Author: "jane"},
+			gofrHttp.ErrorInvalidParam{Params: []string{"duration"}}},
+		{"negative duration", &models.Lock{Duration: "-1h", Author: "jane"},
+			gofrHttp.ErrorInvalidParam{Params: []string{"duration"}}},
+		{"missing until", &models.Lock{Author: "jane"}, gofrHttp.ErrorMissingParam{Params: []string{"until"}}},
+		{"until in the past", &models.Lock{Until: now.Add(-time.Minute), Author: "jane"},
+			gofrHttp.ErrorInvalidParam{Params: []string{"until"}}},
+		{"longer than a week", &models.Lock{Duration: "169h", Author: "jane"},
+			gofrHttp.ErrorInvalidParam{Params: []string{"until"}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lock, err := s.CreateLock(ctx, tc.lock)
+
+			assert.Equal(t, tc.expErr, err)
+			assert.Nil(t, lock)
+		})
+	}
+}
+
+func TestService_ReleaseLock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background()}
+	s := New(nil, nil, nil, nil, mStore, &pricing.Catalog{}, nil)
+
+	mStore.EXPECT().ReleaseLock(ctx, int64(123), int64(5), gomock.Any()).Return(true, nil)
+
+	require.NoError(t, s.ReleaseLock(ctx, 123, 5))
+
+	mStore.EXPECT().ReleaseLock(ctx, int64(123), int64(6), gomock.Any()).Return(false, nil)
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "lock", Value: "6"}, s.ReleaseLock(ctx, 123, 6))
+
+	mStore.EXPECT().ReleaseLock(ctx, int64(123), int64(5), gomock.Any()).Return(false, errMock)
+
+	require.ErrorIs(t, s.ReleaseLock(ctx, 123, 5), errMock)
+}
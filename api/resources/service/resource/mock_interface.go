@@ -12,12 +12,21 @@ package resource
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	client "github.com/zopdev/zopdev/api/resources/client"
 	models "github.com/zopdev/zopdev/api/resources/models"
+	asg "github.com/zopdev/zopdev/api/resources/providers/aws/asg"
 	database "github.com/zopdev/zopdev/api/resources/providers/aws/database"
+	ebs "github.com/zopdev/zopdev/api/resources/providers/aws/ebs"
+	ecsservice "github.com/zopdev/zopdev/api/resources/providers/aws/ecsservice"
+	eip "github.com/zopdev/zopdev/api/resources/providers/aws/eip"
+	elasticache "github.com/zopdev/zopdev/api/resources/providers/aws/elasticache"
+	nodegroup "github.com/zopdev/zopdev/api/resources/providers/aws/nodegroup"
 	vm "github.com/zopdev/zopdev/api/resources/providers/aws/vm"
 	gcp "github.com/zopdev/zopdev/api/resources/providers/gcp"
+	database0 "github.com/zopdev/zopdev/api/resources/providers/oci/database"
+	vm0 "github.com/zopdev/zopdev/api/resources/providers/oci/vm"
 	gomock "go.uber.org/mock/gomock"
 	gofr "gofr.dev/pkg/gofr"
 	google "golang.org/x/oauth2/google"
@@ -48,6 +57,126 @@ func (m *MockGCPClient) EXPECT() *MockGCPClientMockRecorder {
 	return m.recorder
 }
 
+// NewAddressClient mocks base method.
+func (m *MockGCPClient) NewAddressClient(ctx context.Context, opts ...option.ClientOption) (gcp.AddressClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewAddressClient", varargs...)
+	ret0, _ := ret[0].(gcp.AddressClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewAddressClient indicates an expected call of NewAddressClient.
+func (mr *MockGCPClientMockRecorder) NewAddressClient(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAddressClient", reflect.TypeOf((*MockGCPClient)(nil).NewAddressClient), varargs...)
+}
+
+// NewCloudFunctionsClient mocks base method.
+func (m *MockGCPClient) NewCloudFunctionsClient(ctx context.Context, opts ...option.ClientOption) (gcp.CloudFunctionsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewCloudFunctionsClient", varargs...)
+	ret0, _ := ret[0].(gcp.CloudFunctionsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewCloudFunctionsClient indicates an expected call of NewCloudFunctionsClient.
+func (mr *MockGCPClientMockRecorder) NewCloudFunctionsClient(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCloudFunctionsClient", reflect.TypeOf((*MockGCPClient)(nil).NewCloudFunctionsClient), varargs...)
+}
+
+// NewCloudRunClient mocks base method.
+func (m *MockGCPClient) NewCloudRunClient(ctx context.Context, opts ...option.ClientOption) (gcp.CloudRunClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewCloudRunClient", varargs...)
+	ret0, _ := ret[0].(gcp.CloudRunClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewCloudRunClient indicates an expected call of NewCloudRunClient.
+func (mr *MockGCPClientMockRecorder) NewCloudRunClient(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCloudRunClient", reflect.TypeOf((*MockGCPClient)(nil).NewCloudRunClient), varargs...)
+}
+
+// NewComputeClient mocks base method.
+func (m *MockGCPClient) NewComputeClient(ctx context.Context, opts ...option.ClientOption) (gcp.ComputeClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewComputeClient", varargs...)
+	ret0, _ := ret[0].(gcp.ComputeClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewComputeClient indicates an expected call of NewComputeClient.
+func (mr *MockGCPClientMockRecorder) NewComputeClient(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewComputeClient", reflect.TypeOf((*MockGCPClient)(nil).NewComputeClient), varargs...)
+}
+
+// NewDiskClient mocks base method.
+func (m *MockGCPClient) NewDiskClient(ctx context.Context, opts ...option.ClientOption) (gcp.DiskClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewDiskClient", varargs...)
+	ret0, _ := ret[0].(gcp.DiskClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewDiskClient indicates an expected call of NewDiskClient.
+func (mr *MockGCPClientMockRecorder) NewDiskClient(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDiskClient", reflect.TypeOf((*MockGCPClient)(nil).NewDiskClient), varargs...)
+}
+
+// NewGKEClient mocks base method.
+func (m *MockGCPClient) NewGKEClient(ctx context.Context, opts ...option.ClientOption) (gcp.GKEClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewGKEClient", varargs...)
+	ret0, _ := ret[0].(gcp.GKEClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewGKEClient indicates an expected call of NewGKEClient.
+func (mr *MockGCPClientMockRecorder) NewGKEClient(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGKEClient", reflect.TypeOf((*MockGCPClient)(nil).NewGKEClient), varargs...)
+}
+
 // NewGoogleCredentials mocks base method.
 func (m *MockGCPClient) NewGoogleCredentials(ctx context.Context, cred any, scopes ...string) (*google.Credentials, error) {
 	m.ctrl.T.Helper()
@@ -68,6 +197,46 @@ func (mr *MockGCPClientMockRecorder) NewGoogleCredentials(ctx, cred any, scopes
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGoogleCredentials", reflect.TypeOf((*MockGCPClient)(nil).NewGoogleCredentials), varargs...)
 }
 
+// NewMemorystoreClient mocks base method.
+func (m *MockGCPClient) NewMemorystoreClient(ctx context.Context, opts ...option.ClientOption) (gcp.MemorystoreClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewMemorystoreClient", varargs...)
+	ret0, _ := ret[0].(gcp.MemorystoreClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewMemorystoreClient indicates an expected call of NewMemorystoreClient.
+func (mr *MockGCPClientMockRecorder) NewMemorystoreClient(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMemorystoreClient", reflect.TypeOf((*MockGCPClient)(nil).NewMemorystoreClient), varargs...)
+}
+
+// NewMetricsClient mocks base method.
+func (m *MockGCPClient) NewMetricsClient(ctx context.Context, opts ...option.ClientOption) (gcp.MetricsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewMetricsClient", varargs...)
+	ret0, _ := ret[0].(gcp.MetricsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewMetricsClient indicates an expected call of NewMetricsClient.
+func (mr *MockGCPClientMockRecorder) NewMetricsClient(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewMetricsClient", reflect.TypeOf((*MockGCPClient)(nil).NewMetricsClient), varargs...)
+}
+
 // NewSQLClient mocks base method.
 func (m *MockGCPClient) NewSQLClient(ctx context.Context, opts ...option.ClientOption) (gcp.SQLClient, error) {
 	m.ctrl.T.Helper()
@@ -112,6 +281,36 @@ func (m *MockAWSClient) EXPECT() *MockAWSClientMockRecorder {
 	return m.recorder
 }
 
+// NewAutoScalingClient mocks base method.
+func (m *MockAWSClient) NewAutoScalingClient(arg0 context.Context, creds any) (*asg.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewAutoScalingClient", arg0, creds)
+	ret0, _ := ret[0].(*asg.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewAutoScalingClient indicates an expected call of NewAutoScalingClient.
+func (mr *MockAWSClientMockRecorder) NewAutoScalingClient(arg0, creds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewAutoScalingClient", reflect.TypeOf((*MockAWSClient)(nil).NewAutoScalingClient), arg0, creds)
+}
+
+// NewEBSClient mocks base method.
+func (m *MockAWSClient) NewEBSClient(arg0 context.Context, creds any) (*ebs.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewEBSClient", arg0, creds)
+	ret0, _ := ret[0].(*ebs.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewEBSClient indicates an expected call of NewEBSClient.
+func (mr *MockAWSClientMockRecorder) NewEBSClient(arg0, creds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewEBSClient", reflect.TypeOf((*MockAWSClient)(nil).NewEBSClient), arg0, creds)
+}
+
 // NewEC2Client mocks base method.
 func (m *MockAWSClient) NewEC2Client(arg0 context.Context, creds any) (*vm.Client, error) {
 	m.ctrl.T.Helper()
@@ -127,6 +326,66 @@ func (mr *MockAWSClientMockRecorder) NewEC2Client(arg0, creds any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewEC2Client", reflect.TypeOf((*MockAWSClient)(nil).NewEC2Client), arg0, creds)
 }
 
+// NewECSClient mocks base method.
+func (m *MockAWSClient) NewECSClient(arg0 context.Context, creds any) (*ecsservice.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewECSClient", arg0, creds)
+	ret0, _ := ret[0].(*ecsservice.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewECSClient indicates an expected call of NewECSClient.
+func (mr *MockAWSClientMockRecorder) NewECSClient(arg0, creds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewECSClient", reflect.TypeOf((*MockAWSClient)(nil).NewECSClient), arg0, creds)
+}
+
+// NewEKSClient mocks base method.
+func (m *MockAWSClient) NewEKSClient(arg0 context.Context, creds any) (*nodegroup.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewEKSClient", arg0, creds)
+	ret0, _ := ret[0].(*nodegroup.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewEKSClient indicates an expected call of NewEKSClient.
+func (mr *MockAWSClientMockRecorder) NewEKSClient(arg0, creds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewEKSClient", reflect.TypeOf((*MockAWSClient)(nil).NewEKSClient), arg0, creds)
+}
+
+// NewElastiCacheClient mocks base method.
+func (m *MockAWSClient) NewElastiCacheClient(arg0 context.Context, creds any) (*elasticache.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewElastiCacheClient", arg0, creds)
+	ret0, _ := ret[0].(*elasticache.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewElastiCacheClient indicates an expected call of NewElastiCacheClient.
+func (mr *MockAWSClientMockRecorder) NewElastiCacheClient(arg0, creds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewElastiCacheClient", reflect.TypeOf((*MockAWSClient)(nil).NewElastiCacheClient), arg0, creds)
+}
+
+// NewElasticIPClient mocks base method.
+func (m *MockAWSClient) NewElasticIPClient(arg0 context.Context, creds any) (*eip.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewElasticIPClient", arg0, creds)
+	ret0, _ := ret[0].(*eip.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewElasticIPClient indicates an expected call of NewElasticIPClient.
+func (mr *MockAWSClientMockRecorder) NewElasticIPClient(arg0, creds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewElasticIPClient", reflect.TypeOf((*MockAWSClient)(nil).NewElasticIPClient), arg0, creds)
+}
+
 // NewRDSClient mocks base method.
 func (m *MockAWSClient) NewRDSClient(arg0 context.Context, creds any) (*database.Client, error) {
 	m.ctrl.T.Helper()
@@ -142,6 +401,165 @@ func (mr *MockAWSClientMockRecorder) NewRDSClient(arg0, creds any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRDSClient", reflect.TypeOf((*MockAWSClient)(nil).NewRDSClient), arg0, creds)
 }
 
+// MockOCIClient is a mock of OCIClient interface.
+type MockOCIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockOCIClientMockRecorder
+	isgomock struct{}
+}
+
+// MockOCIClientMockRecorder is the mock recorder for MockOCIClient.
+type MockOCIClientMockRecorder struct {
+	mock *MockOCIClient
+}
+
+// NewMockOCIClient creates a new mock instance.
+func NewMockOCIClient(ctrl *gomock.Controller) *MockOCIClient {
+	mock := &MockOCIClient{ctrl: ctrl}
+	mock.recorder = &MockOCIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOCIClient) EXPECT() *MockOCIClientMockRecorder {
+	return m.recorder
+}
+
+// NewComputeClient mocks base method.
+func (m *MockOCIClient) NewComputeClient(arg0 context.Context, creds any) (*vm0.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewComputeClient", arg0, creds)
+	ret0, _ := ret[0].(*vm0.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewComputeClient indicates an expected call of NewComputeClient.
+func (mr *MockOCIClientMockRecorder) NewComputeClient(arg0, creds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewComputeClient", reflect.TypeOf((*MockOCIClient)(nil).NewComputeClient), arg0, creds)
+}
+
+// NewDatabaseClient mocks base method.
+func (m *MockOCIClient) NewDatabaseClient(arg0 context.Context, creds any) (*database0.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDatabaseClient", arg0, creds)
+	ret0, _ := ret[0].(*database0.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewDatabaseClient indicates an expected call of NewDatabaseClient.
+func (mr *MockOCIClientMockRecorder) NewDatabaseClient(arg0, creds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDatabaseClient", reflect.TypeOf((*MockOCIClient)(nil).NewDatabaseClient), arg0, creds)
+}
+
+// MockDriver is a mock of Driver interface.
+type MockDriver struct {
+	ctrl     *gomock.Controller
+	recorder *MockDriverMockRecorder
+	isgomock struct{}
+}
+
+// MockDriverMockRecorder is the mock recorder for MockDriver.
+type MockDriverMockRecorder struct {
+	mock *MockDriver
+}
+
+// NewMockDriver creates a new mock instance.
+func NewMockDriver(ctrl *gomock.Controller) *MockDriver {
+	mock := &MockDriver{ctrl: ctrl}
+	mock.recorder = &MockDriverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDriver) EXPECT() *MockDriverMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, creds)
+	ret0, _ := ret[0].([]models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockDriverMockRecorder) List(ctx, creds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDriver)(nil).List), ctx, creds)
+}
+
+// Start mocks base method.
+func (m *MockDriver) Start(ctx *gofr.Context, creds any, res *models.Resource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, creds, res)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockDriverMockRecorder) Start(ctx, creds, res any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockDriver)(nil).Start), ctx, creds, res)
+}
+
+// Stop mocks base method.
+func (m *MockDriver) Stop(ctx *gofr.Context, creds any, res *models.Resource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx, creds, res)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockDriverMockRecorder) Stop(ctx, creds, res any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockDriver)(nil).Stop), ctx, creds, res)
+}
+
+// MockPricing is a mock of Pricing interface.
+type MockPricing struct {
+	ctrl     *gomock.Controller
+	recorder *MockPricingMockRecorder
+	isgomock struct{}
+}
+
+// MockPricingMockRecorder is the mock recorder for MockPricing.
+type MockPricingMockRecorder struct {
+	mock *MockPricing
+}
+
+// NewMockPricing creates a new mock instance.
+func NewMockPricing(ctrl *gomock.Controller) *MockPricing {
+	mock := &MockPricing{ctrl: ctrl}
+	mock.recorder = &MockPricingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPricing) EXPECT() *MockPricingMockRecorder {
+	return m.recorder
+}
+
+// Estimate mocks base method.
+func (m *MockPricing) Estimate(res *models.Resource) *models.Cost {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Estimate", res)
+	ret0, _ := ret[0].(*models.Cost)
+	return ret0
+}
+
+// Estimate indicates an expected call of Estimate.
+func (mr *MockPricingMockRecorder) Estimate(res any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Estimate", reflect.TypeOf((*MockPricing)(nil).Estimate), res)
+}
+
 // MockHTTPClient is a mock of HTTPClient interface.
 type MockHTTPClient struct {
 	ctrl     *gomock.Controller
@@ -220,6 +638,153 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// CloseSavings mocks base method.
+func (m *MockStore) CloseSavings(ctx *gofr.Context, id int64, startedAt time.Time, amount float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSavings", ctx, id, startedAt, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSavings indicates an expected call of CloseSavings.
+func (mr *MockStoreMockRecorder) CloseSavings(ctx, id, startedAt, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSavings", reflect.TypeOf((*MockStore)(nil).CloseSavings), ctx, id, startedAt, amount)
+}
+
+// CompleteOperation mocks base method.
+func (m *MockStore) CompleteOperation(ctx *gofr.Context, id int64, status, errMsg string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteOperation", ctx, id, status, errMsg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteOperation indicates an expected call of CompleteOperation.
+func (mr *MockStoreMockRecorder) CompleteOperation(ctx, id, status, errMsg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteOperation", reflect.TypeOf((*MockStore)(nil).CompleteOperation), ctx, id, status, errMsg)
+}
+
+// CompleteSyncRun mocks base method.
+func (m *MockStore) CompleteSyncRun(ctx *gofr.Context, run *models.SyncRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteSyncRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteSyncRun indicates an expected call of CompleteSyncRun.
+func (mr *MockStoreMockRecorder) CompleteSyncRun(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteSyncRun", reflect.TypeOf((*MockStore)(nil).CompleteSyncRun), ctx, run)
+}
+
+// GetActiveBlackout mocks base method.
+func (m *MockStore) GetActiveBlackout(ctx *gofr.Context, resourceID int64, at time.Time) (*models.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveBlackout", ctx, resourceID, at)
+	ret0, _ := ret[0].(*models.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveBlackout indicates an expected call of GetActiveBlackout.
+func (mr *MockStoreMockRecorder) GetActiveBlackout(ctx, resourceID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveBlackout", reflect.TypeOf((*MockStore)(nil).GetActiveBlackout), ctx, resourceID, at)
+}
+
+// GetActiveLock mocks base method.
+func (m *MockStore) GetActiveLock(ctx *gofr.Context, resourceID int64, at time.Time) (*models.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveLock", ctx, resourceID, at)
+	ret0, _ := ret[0].(*models.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveLock indicates an expected call of GetActiveLock.
+func (mr *MockStoreMockRecorder) GetActiveLock(ctx, resourceID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveLock", reflect.TypeOf((*MockStore)(nil).GetActiveLock), ctx, resourceID, at)
+}
+
+// GetEvents mocks base method.
+func (m *MockStore) GetEvents(ctx *gofr.Context, cloudAccountID, resourceID int64, from, to time.Time) ([]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", ctx, cloudAccountID, resourceID, from, to)
+	ret0, _ := ret[0].([]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockStoreMockRecorder) GetEvents(ctx, cloudAccountID, resourceID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockStore)(nil).GetEvents), ctx, cloudAccountID, resourceID, from, to)
+}
+
+// GetLocks mocks base method.
+func (m *MockStore) GetLocks(ctx *gofr.Context, cloudAccountID, resourceID, groupID int64, at time.Time) ([]models.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocks", ctx, cloudAccountID, resourceID, groupID, at)
+	ret0, _ := ret[0].([]models.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocks indicates an expected call of GetLocks.
+func (mr *MockStoreMockRecorder) GetLocks(ctx, cloudAccountID, resourceID, groupID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocks", reflect.TypeOf((*MockStore)(nil).GetLocks), ctx, cloudAccountID, resourceID, groupID, at)
+}
+
+// GetOpenSavings mocks base method.
+func (m *MockStore) GetOpenSavings(ctx *gofr.Context, resourceID int64) (*models.Savings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenSavings", ctx, resourceID)
+	ret0, _ := ret[0].(*models.Savings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenSavings indicates an expected call of GetOpenSavings.
+func (mr *MockStoreMockRecorder) GetOpenSavings(ctx, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenSavings", reflect.TypeOf((*MockStore)(nil).GetOpenSavings), ctx, resourceID)
+}
+
+// GetOperations mocks base method.
+func (m *MockStore) GetOperations(ctx *gofr.Context, resourceID int64) ([]models.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperations", ctx, resourceID)
+	ret0, _ := ret[0].([]models.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperations indicates an expected call of GetOperations.
+func (mr *MockStoreMockRecorder) GetOperations(ctx, resourceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperations", reflect.TypeOf((*MockStore)(nil).GetOperations), ctx, resourceID)
+}
+
+// GetOperationsByStatus mocks base method.
+func (m *MockStore) GetOperationsByStatus(ctx *gofr.Context, status string) ([]models.Operation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperationsByStatus", ctx, status)
+	ret0, _ := ret[0].([]models.Operation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperationsByStatus indicates an expected call of GetOperationsByStatus.
+func (mr *MockStoreMockRecorder) GetOperationsByStatus(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperationsByStatus", reflect.TypeOf((*MockStore)(nil).GetOperationsByStatus), ctx, status)
+}
+
 // GetResourceByID mocks base method.
 func (m *MockStore) GetResourceByID(ctx *gofr.Context, id int64) (*models.Resource, error) {
 	m.ctrl.T.Helper()
@@ -235,19 +800,126 @@ func (mr *MockStoreMockRecorder) GetResourceByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceByID", reflect.TypeOf((*MockStore)(nil).GetResourceByID), ctx, id)
 }
 
+// GetResourceGroupNames mocks base method.
+func (m *MockStore) GetResourceGroupNames(ctx *gofr.Context, cloudAccountID int64) (map[int64][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceGroupNames", ctx, cloudAccountID)
+	ret0, _ := ret[0].(map[int64][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceGroupNames indicates an expected call of GetResourceGroupNames.
+func (mr *MockStoreMockRecorder) GetResourceGroupNames(ctx, cloudAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceGroupNames", reflect.TypeOf((*MockStore)(nil).GetResourceGroupNames), ctx, cloudAccountID)
+}
+
 // GetResources mocks base method.
-func (m *MockStore) GetResources(ctx *gofr.Context, cloudAccountID int64, resourceType []string) ([]models.Resource, error) {
+func (m *MockStore) GetResources(ctx *gofr.Context, cloudAccountID int64, resourceType []string, selectors ...models.LabelSelector) ([]models.Resource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetResources", ctx, cloudAccountID, resourceType)
+	varargs := []any{ctx, cloudAccountID, resourceType}
+	for _, a := range selectors {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetResources", varargs...)
 	ret0, _ := ret[0].([]models.Resource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetResources indicates an expected call of GetResources.
-func (mr *MockStoreMockRecorder) GetResources(ctx, cloudAccountID, resourceType any) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetResources(ctx, cloudAccountID, resourceType any, selectors ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, cloudAccountID, resourceType}, selectors...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResources", reflect.TypeOf((*MockStore)(nil).GetResources), varargs...)
+}
+
+// GetSavings mocks base method.
+func (m *MockStore) GetSavings(ctx *gofr.Context, cloudAccountID int64, from, to time.Time) ([]models.Savings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavings", ctx, cloudAccountID, from, to)
+	ret0, _ := ret[0].([]models.Savings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavings indicates an expected call of GetSavings.
+func (mr *MockStoreMockRecorder) GetSavings(ctx, cloudAccountID, from, to any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResources", reflect.TypeOf((*MockStore)(nil).GetResources), ctx, cloudAccountID, resourceType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavings", reflect.TypeOf((*MockStore)(nil).GetSavings), ctx, cloudAccountID, from, to)
+}
+
+// GetSyncRuns mocks base method.
+func (m *MockStore) GetSyncRuns(ctx *gofr.Context, cloudAccountID int64, limit int) ([]models.SyncRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSyncRuns", ctx, cloudAccountID, limit)
+	ret0, _ := ret[0].([]models.SyncRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSyncRuns indicates an expected call of GetSyncRuns.
+func (mr *MockStoreMockRecorder) GetSyncRuns(ctx, cloudAccountID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncRuns", reflect.TypeOf((*MockStore)(nil).GetSyncRuns), ctx, cloudAccountID, limit)
+}
+
+// HasOperation mocks base method.
+func (m *MockStore) HasOperation(ctx *gofr.Context, resourceID int64, status string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasOperation", ctx, resourceID, status)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasOperation indicates an expected call of HasOperation.
+func (mr *MockStoreMockRecorder) HasOperation(ctx, resourceID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasOperation", reflect.TypeOf((*MockStore)(nil).HasOperation), ctx, resourceID, status)
+}
+
+// InsertEvent mocks base method.
+func (m *MockStore) InsertEvent(ctx *gofr.Context, event *models.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertEvent indicates an expected call of InsertEvent.
+func (mr *MockStoreMockRecorder) InsertEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertEvent", reflect.TypeOf((*MockStore)(nil).InsertEvent), ctx, event)
+}
+
+// InsertLock mocks base method.
+func (m *MockStore) InsertLock(ctx *gofr.Context, lock *models.Lock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertLock", ctx, lock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertLock indicates an expected call of InsertLock.
+func (mr *MockStoreMockRecorder) InsertLock(ctx, lock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertLock", reflect.TypeOf((*MockStore)(nil).InsertLock), ctx, lock)
+}
+
+// InsertOperation mocks base method.
+func (m *MockStore) InsertOperation(ctx *gofr.Context, op *models.Operation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertOperation", ctx, op)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertOperation indicates an expected call of InsertOperation.
+func (mr *MockStoreMockRecorder) InsertOperation(ctx, op any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertOperation", reflect.TypeOf((*MockStore)(nil).InsertOperation), ctx, op)
 }
 
 // InsertResource mocks base method.
@@ -264,6 +936,49 @@ func (mr *MockStoreMockRecorder) InsertResource(ctx, resources any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertResource", reflect.TypeOf((*MockStore)(nil).InsertResource), ctx, resources)
 }
 
+// InsertSavings mocks base method.
+func (m *MockStore) InsertSavings(ctx *gofr.Context, savings *models.Savings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertSavings", ctx, savings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertSavings indicates an expected call of InsertSavings.
+func (mr *MockStoreMockRecorder) InsertSavings(ctx, savings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSavings", reflect.TypeOf((*MockStore)(nil).InsertSavings), ctx, savings)
+}
+
+// InsertSyncRun mocks base method.
+func (m *MockStore) InsertSyncRun(ctx *gofr.Context, run *models.SyncRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertSyncRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertSyncRun indicates an expected call of InsertSyncRun.
+func (mr *MockStoreMockRecorder) InsertSyncRun(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSyncRun", reflect.TypeOf((*MockStore)(nil).InsertSyncRun), ctx, run)
+}
+
+// ReleaseLock mocks base method.
+func (m *MockStore) ReleaseLock(ctx *gofr.Context, cloudAccountID, id int64, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseLock", ctx, cloudAccountID, id, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseLock indicates an expected call of ReleaseLock.
+func (mr *MockStoreMockRecorder) ReleaseLock(ctx, cloudAccountID, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLock", reflect.TypeOf((*MockStore)(nil).ReleaseLock), ctx, cloudAccountID, id, at)
+}
+
 // RemoveResource mocks base method.
 func (m *MockStore) RemoveResource(ctx *gofr.Context, id int64) error {
 	m.ctrl.T.Helper()
@@ -278,6 +993,77 @@ func (mr *MockStoreMockRecorder) RemoveResource(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveResource", reflect.TypeOf((*MockStore)(nil).RemoveResource), ctx, id)
 }
 
+// SearchResources mocks base method.
+func (m *MockStore) SearchResources(ctx *gofr.Context, q *models.ResourceQuery) ([]models.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchResources", ctx, q)
+	ret0, _ := ret[0].([]models.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchResources indicates an expected call of SearchResources.
+func (mr *MockStoreMockRecorder) SearchResources(ctx, q any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchResources", reflect.TypeOf((*MockStore)(nil).SearchResources), ctx, q)
+}
+
+// UpdateLabels mocks base method.
+func (m *MockStore) UpdateLabels(ctx *gofr.Context, resourceID int64, labels models.Labels) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLabels", ctx, resourceID, labels)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLabels indicates an expected call of UpdateLabels.
+func (mr *MockStoreMockRecorder) UpdateLabels(ctx, resourceID, labels any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLabels", reflect.TypeOf((*MockStore)(nil).UpdateLabels), ctx, resourceID, labels)
+}
+
+// UpdateRegion mocks base method.
+func (m *MockStore) UpdateRegion(ctx *gofr.Context, region string, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRegion", ctx, region, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRegion indicates an expected call of UpdateRegion.
+func (mr *MockStoreMockRecorder) UpdateRegion(ctx, region, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegion", reflect.TypeOf((*MockStore)(nil).UpdateRegion), ctx, region, id)
+}
+
+// UpdateSettings mocks base method.
+func (m *MockStore) UpdateSettings(ctx *gofr.Context, settings models.Settings, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", ctx, settings, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *MockStoreMockRecorder) UpdateSettings(ctx, settings, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockStore)(nil).UpdateSettings), ctx, settings, id)
+}
+
+// UpdateSpec mocks base method.
+func (m *MockStore) UpdateSpec(ctx *gofr.Context, spec models.Spec, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSpec", ctx, spec, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSpec indicates an expected call of UpdateSpec.
+func (mr *MockStoreMockRecorder) UpdateSpec(ctx, spec, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSpec", reflect.TypeOf((*MockStore)(nil).UpdateSpec), ctx, spec, id)
+}
+
 // UpdateStatus mocks base method.
 func (m *MockStore) UpdateStatus(ctx *gofr.Context, status string, id int64) error {
 	m.ctrl.T.Helper()
@@ -291,3 +1077,17 @@ func (mr *MockStoreMockRecorder) UpdateStatus(ctx, status, id any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockStore)(nil).UpdateStatus), ctx, status, id)
 }
+
+// VoidSavings mocks base method.
+func (m *MockStore) VoidSavings(ctx *gofr.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidSavings", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidSavings indicates an expected call of VoidSavings.
+func (mr *MockStoreMockRecorder) VoidSavings(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidSavings", reflect.TypeOf((*MockStore)(nil).VoidSavings), ctx, id)
+}
@@ -2,10 +2,15 @@ package resource
 
 import (
 	"errors"
+	"time"
 
 	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
 
 	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/cloudrun"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/functions"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/gke"
 )
 
 var errMock = errors.New("mock error")
@@ -38,3 +43,215 @@ func (m *mockSQLClient) StopInstance(_ *gofr.Context, _, _ string) error {
 
 	return nil
 }
+
+type mockComputeClient struct {
+	isError   bool
+	instances []models.Resource
+}
+
+func (m *mockComputeClient) GetAllInstances(_ *gofr.Context, _ string) ([]models.Resource, error) {
+	if m.isError {
+		return nil, errMock
+	}
+
+	return m.instances, nil
+}
+
+func (m *mockComputeClient) GetInstance(_ *gofr.Context, _, _, name string) (*models.Resource, error) {
+	if m.isError {
+		return nil, errMock
+	}
+
+	for i := range m.instances {
+		if m.instances[i].Name == name {
+			return &m.instances[i], nil
+		}
+	}
+
+	return nil, gofrHttp.ErrorEntityNotFound{Name: "instance", Value: name}
+}
+
+func (m *mockComputeClient) StartInstance(_ *gofr.Context, _, _, _ string) error {
+	if m.isError {
+		return errMock
+	}
+
+	return nil
+}
+
+func (m *mockComputeClient) StopInstance(_ *gofr.Context, _, _, _ string) error {
+	if m.isError {
+		return errMock
+	}
+
+	return nil
+}
+
+type mockGKEClient struct {
+	isError   bool
+	nodePools []models.Resource
+	size      *gke.Size
+}
+
+func (m *mockGKEClient) GetAllNodePools(_ *gofr.Context, _ string) ([]models.Resource, error) {
+	if m.isError {
+		return nil, errMock
+	}
+
+	return m.nodePools, nil
+}
+
+func (m *mockGKEClient) StopNodePool(_ *gofr.Context, _, _, _, _ string) (*gke.Size, error) {
+	if m.isError {
+		return m.size, errMock
+	}
+
+	return m.size, nil
+}
+
+func (m *mockGKEClient) StartNodePool(_ *gofr.Context, _, _, _, _ string, _ *gke.Size) error {
+	if m.isError {
+		return errMock
+	}
+
+	return nil
+}
+
+type mockCloudRunClient struct {
+	isError  bool
+	services []models.Resource
+	size     *cloudrun.Size
+}
+
+func (m *mockCloudRunClient) GetAllServices(_ *gofr.Context, _ string) ([]models.Resource, error) {
+	if m.isError {
+		return nil, errMock
+	}
+
+	return m.services, nil
+}
+
+func (m *mockCloudRunClient) StopService(_ *gofr.Context, _, _, _ string) (*cloudrun.Size, error) {
+	if m.isError {
+		return nil, errMock
+	}
+
+	return m.size, nil
+}
+
+func (m *mockCloudRunClient) StartService(_ *gofr.Context, _, _, _ string, _ *cloudrun.Size) error {
+	if m.isError {
+		return errMock
+	}
+
+	return nil
+}
+
+type mockCloudFunctionsClient struct {
+	isError   bool
+	functions []models.Resource
+	size      *functions.Size
+}
+
+func (m *mockCloudFunctionsClient) GetAllFunctions(_ *gofr.Context, _ string) ([]models.Resource, error) {
+	if m.isError {
+		return nil, errMock
+	}
+
+	return m.functions, nil
+}
+
+func (m *mockCloudFunctionsClient) StopFunction(_ *gofr.Context, _, _, _ string) (*functions.Size, error) {
+	if m.isError {
+		return nil, errMock
+	}
+
+	return m.size, nil
+}
+
+func (m *mockCloudFunctionsClient) StartFunction(_ *gofr.Context, _, _, _ string, _ *functions.Size) error {
+	if m.isError {
+		return errMock
+	}
+
+	return nil
+}
+
+type mockDiskClient struct {
+	isError   bool
+	disks     []models.Resource
+	snapshots []models.Resource
+}
+
+func (m *mockDiskClient) GetAllDisks(_ *gofr.Context, _ string) ([]models.Resource, error) {
+	if m.isError {
+		return nil, errMock
+	}
+
+	return m.disks, nil
+}
+
+func (m *mockDiskClient) GetAllSnapshots(_ *gofr.Context, _ string) ([]models.Resource, error) {
+	if m.isError {
+		return nil, errMock
+	}
+
+	return m.snapshots, nil
+}
+
+func (m *mockDiskClient) DeleteDisk(_ *gofr.Context, _, _, _, _ string) error {
+	if m.isError {
+		return errMock
+	}
+
+	return nil
+}
+
+func (m *mockDiskClient) DeleteSnapshot(_ *gofr.Context, _, _ string) error {
+	if m.isError {
+		return errMock
+	}
+
+	return nil
+}
+
+type mockAddressClient struct {
+	isError   bool
+	addresses []models.Resource
+}
+
+func (m *mockAddressClient) GetAllAddresses(_ *gofr.Context, _ string) ([]models.Resource, error) {
+	if m.isError {
+		return nil, errMock
+	}
+
+	return m.addresses, nil
+}
+
+func (m *mockAddressClient) DeleteAddress(_ *gofr.Context, _, _, _ string) error {
+	if m.isError {
+		return errMock
+	}
+
+	return nil
+}
+
+type mockMetricsClient struct {
+	isError bool
+	filter  string
+	peaks   []models.Metric
+}
+
+func (m *mockMetricsClient) GetTimeSeries(_ *gofr.Context, _, _ time.Time, _, _ string) ([]models.Metric, error) {
+	return nil, nil
+}
+
+func (m *mockMetricsClient) GetPeakValues(_ *gofr.Context, _, _ time.Time, _, filter string) ([]models.Metric, error) {
+	if m.isError {
+		return nil, errMock
+	}
+
+	m.filter = filter
+
+	return m.peaks, nil
+}
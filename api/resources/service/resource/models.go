@@ -12,13 +12,42 @@ const (
 
 	GCP CloudProvider = "GCP"
 	AWS CloudProvider = "AWS"
+	OCI CloudProvider = "OCI"
 
 	// Resource Types that are currently supported in zopdev.
 	// TODO: add more resource types.
 
 	SQL ResourceType = "SQL"
+	RDS ResourceType = "RDS"
 
 	AWSCOMPUTE ResourceType = "EC2"
+	GCPCOMPUTE ResourceType = "GCE"
+	OCICOMPUTE ResourceType = "OCI_COMPUTE"
+
+	OCIDBSYSTEM     ResourceType = "OCI_DB_SYSTEM"
+	OCIAUTONOMOUSDB ResourceType = "OCI_AUTONOMOUS_DB"
+
+	GKENODEPOOL   ResourceType = "GKE_NODEPOOL"
+	CLOUDRUN      ResourceType = "CLOUD_RUN"
+	CLOUDFUNCTION ResourceType = "CLOUD_FUNCTION"
+
+	ASG          ResourceType = "ASG"
+	EKSNODEGROUP ResourceType = "EKS_NODEGROUP"
+	ECSSERVICE   ResourceType = "ECS_SERVICE"
+
+	// Resource Types of the waste candidates, these are not started or stopped but can be deleted.
+
+	GCPDISK     ResourceType = "GCP_DISK"
+	GCPSNAPSHOT ResourceType = "GCP_SNAPSHOT"
+	GCPADDRESS  ResourceType = "GCP_STATIC_IP"
+	EBSVOLUME   ResourceType = "EBS_VOLUME"
+	EBSSNAPSHOT ResourceType = "EBS_SNAPSHOT"
+	ELASTICIP   ResourceType = "ELASTIC_IP"
+
+	// Resource Types of the managed caches, these are inventoried and checked for idleness but not started or stopped.
+
+	MEMORYSTORE ResourceType = "MEMORYSTORE"
+	ELASTICACHE ResourceType = "ELASTICACHE"
 
 	// Resource State constants.
 
@@ -27,6 +56,51 @@ const (
 
 	RUNNING = "RUNNING"
 	STOPPED = "STOPPED"
+
+	// Transitional states of a resource while a state change is being applied by the cloud provider.
+
+	STARTING = "STARTING"
+	STOPPING = "STOPPING"
+	FAILED   = "FAILED"
+
+	// Attachment states of the waste candidates, a snapshot is attached while its source disk exists.
+
+	ATTACHED   = "ATTACHED"
+	UNATTACHED = "UNATTACHED"
+
+	// State of the managed caches that are ready to serve requests, they are neither running nor stopped.
+
+	AVAILABLE = "AVAILABLE"
+
+	// Resource Operation status constants.
+
+	OperationInProgress = "IN_PROGRESS"
+	OperationSucceeded  = "SUCCEEDED"
+	OperationFailed     = "FAILED"
+
+	// Resource Event types recorded in the change history of a resource.
+
+	EventCreated              = "CREATED"
+	EventRemoved              = "REMOVED"
+	EventStateChangeRequested = "STATE_CHANGE_REQUESTED"
+	EventStatusChanged        = "STATUS_CHANGED"
+	EventDeleted              = "DELETED"
+
+	// Actors of the events that are not requested by a user.
+
+	ActorSync      = "resource-sync"
+	ActorOperation = "resource-operations"
+	ActorRestop    = "resource-restop"
+	ActorSchedule  = "resource-group-schedule"
+	ActorCalendar  = "resource-group-calendar"
+
+	// Sync statuses of a resource type.
+
+	SyncSucceeded = "SUCCEEDED"
+	SyncFailed    = "FAILED"
+
+	// MonthFormat is the layout of the month for which the savings are queried, e.g. 2025-06.
+	MonthFormat = "2006-01"
 )
 
 type CloudDetails struct {
@@ -35,9 +109,10 @@ type CloudDetails struct {
 }
 
 type ResourceDetails struct {
-	ID         int64         `json:"id"`
-	CloudAccID int64         `json:"cloudAccID"`
-	Name       string        `json:"name"`
-	Type       ResourceType  `json:"type"`
-	State      ResourceState `json:"state"`
+	ID          int64         `json:"id"`
+	CloudAccID  int64         `json:"cloudAccID"`
+	Name        string        `json:"name"`
+	Type        ResourceType  `json:"type"`
+	State       ResourceState `json:"state"`
+	RequestedBy string        `json:"requestedBy,omitempty"`
 }
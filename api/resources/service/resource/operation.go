@@ -0,0 +1,213 @@
+package resource
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// operationTimeout is the time after which an operation that has not reached its target state is marked as failed.
+const operationTimeout = 30 * time.Minute
+
+// GetOperations returns the state change operations of a resource belonging to the given cloud account.
+func (s *Service) GetOperations(ctx *gofr.Context, cloudAccID, resourceID int64) ([]models.Operation, error) {
+	res, err := s.store.GetResourceByID(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil || res.CloudAccount.ID != cloudAccID {
+		return nil, gofrHttp.ErrorEntityNotFound{Name: "resource"}
+	}
+
+	return s.store.GetOperations(ctx, resourceID)
+}
+
+// operationInProgress reports whether the provider is still working on a state change requested for a resource.
+// Resources listed in a transitional state, e.g. started outside zopdev, have no operation to wait for.
+func (s *Service) operationInProgress(ctx *gofr.Context, res *models.Resource) (bool, error) {
+	if res.Status != STARTING && res.Status != STOPPING {
+		return false, nil
+	}
+
+	return s.store.HasOperation(ctx, res.ID, OperationInProgress)
+}
+
+// recordOperation persists the state change requested for a resource. When the provider accepted the request,
+// the operation stays in progress and the resource moves to a transitional state until PollOperations completes it.
+func (s *Service) recordOperation(ctx *gofr.Context, resDetails ResourceDetails, opErr error) {
+	op := &models.Operation{
+		ResourceID:     resDetails.ID,
+		CloudAccountID: resDetails.CloudAccID,
+		Action:         string(resDetails.State),
+		TargetState:    getStatus(resDetails.State),
+		Status:         OperationInProgress,
+	}
+
+	if opErr != nil {
+		now := time.Now()
+
+		op.Status = OperationFailed
+		op.Error = opErr.Error()
+		op.CompletedAt = &now
+	}
+
+	err := s.store.InsertOperation(ctx, op)
+	if err != nil {
+		ctx.Errorf("failed to record operation for resource %d: %v", resDetails.ID, err)
+	}
+
+	if opErr != nil {
+		return
+	}
+
+	err = s.store.UpdateStatus(ctx, getTransitionalStatus(resDetails.State), resDetails.ID)
+	if err != nil {
+		ctx.Errorf("failed to update resource status: %v", err)
+	}
+}
+
+// PollOperations is a cron job that checks the operations in progress against the cloud provider and completes
+// the ones whose resource has reached the target state, or fails them once they time out.
+func (s *Service) PollOperations(ctx *gofr.Context) {
+	ops, err := s.store.GetOperationsByStatus(ctx, OperationInProgress)
+	if err != nil {
+		ctx.Errorf("failed to get operations in progress: %v", err)
+		return
+	}
+
+	// The resources are listed once per cloud account, irrespective of the number of operations in progress.
+	accountOps := make(map[int64][]models.Operation)
+
+	for i := range ops {
+		accountOps[ops[i].CloudAccountID] = append(accountOps[ops[i].CloudAccountID], ops[i])
+	}
+
+	for accID, accOps := range accountOps {
+		s.pollAccountOperations(ctx, accID, accOps)
+	}
+}
+
+func (s *Service) pollAccountOperations(ctx *gofr.Context, cloudAccID int64, ops []models.Operation) {
+	ca, err := s.http.GetCloudCredentials(ctx, cloudAccID)
+	if err != nil {
+		ctx.Errorf("failed to get cloud credentials for account %d: %v", cloudAccID, err)
+		return
+	}
+
+	resources := make([]*models.Resource, len(ops))
+	drivers := make(map[ResourceType]Driver)
+	provider := CloudProvider(strings.ToUpper(ca.Provider))
+
+	for i := range ops {
+		res, er := s.store.GetResourceByID(ctx, ops[i].ResourceID)
+		if er != nil || res == nil {
+			ctx.Errorf("failed to get resource %d: %v", ops[i].ResourceID, er)
+			continue
+		}
+
+		resources[i] = res
+
+		// The resources whose driver describes a single resource are checked one by one.
+		if d := s.drivers.get(provider, ResourceType(res.Type)); d != nil {
+			if _, ok := d.(Describer); !ok {
+				drivers[ResourceType(res.Type)] = d
+			}
+		}
+	}
+
+	// Only the resource types of the operations in progress that cannot be described are listed.
+	ins, syncStatuses := listInstances(ctx, ca, drivers)
+
+	failed := failedTypes(syncStatuses)
+	for t := range failed {
+		ctx.Errorf("failed to list %s resources for account %d: %s", t, cloudAccID, syncStatuses[t].Error)
+	}
+
+	statuses := make(map[string]string, len(ins))
+
+	for i := range ins {
+		statuses[ins[i].UID] = ins[i].Status
+	}
+
+	for i := range ops {
+		res := resources[i]
+		if res == nil {
+			continue
+		}
+
+		if d, ok := s.drivers.get(provider, ResourceType(res.Type)).(Describer); ok {
+			s.describeOperation(ctx, &ops[i], d, ca.Credentials, res)
+			continue
+		}
+
+		// The resource could not be listed, the operation is checked again on the next poll.
+		if failed[res.Type] {
+			continue
+		}
+
+		status, found := statuses[res.UID]
+		s.checkOperation(ctx, &ops[i], status, found)
+	}
+}
+
+// describeOperation checks an operation against the state of its resource described by the provider. The operation
+// is checked again on the next poll when the resource could not be described.
+func (s *Service) describeOperation(ctx *gofr.Context, op *models.Operation, d Describer, creds any,
+	res *models.Resource) {
+	current, err := d.Describe(ctx, creds, res)
+
+	var notFound gofrHttp.ErrorEntityNotFound
+
+	switch {
+	case errors.As(err, &notFound):
+		s.checkOperation(ctx, op, "", false)
+	case err != nil:
+		ctx.Errorf("failed to describe resource %d: %v", res.ID, err)
+	default:
+		s.checkOperation(ctx, op, current.Status, true)
+	}
+}
+
+func (s *Service) checkOperation(ctx *gofr.Context, op *models.Operation, status string, found bool) {
+	switch {
+	case !found:
+		s.completeOperation(ctx, op, OperationFailed, "resource not found on the cloud provider")
+	case status == op.TargetState:
+		s.completeOperation(ctx, op, OperationSucceeded, "")
+	case time.Since(op.CreatedAt) > operationTimeout:
+		s.completeOperation(ctx, op, OperationFailed, "timed out waiting for the resource to be "+op.TargetState)
+	}
+}
+
+func (s *Service) completeOperation(ctx *gofr.Context, op *models.Operation, status, errMsg string) {
+	err := s.store.CompleteOperation(ctx, op.ID, status, errMsg)
+	if err != nil {
+		ctx.Errorf("failed to complete operation %d: %v", op.ID, err)
+		return
+	}
+
+	resStatus := op.TargetState
+	if status == OperationFailed {
+		resStatus = FAILED
+
+		if ResourceState(op.Action) == SUSPEND {
+			s.voidOpenSavings(ctx, op.ResourceID)
+		}
+	}
+
+	err = s.store.UpdateStatus(ctx, resStatus, op.ResourceID)
+	if err != nil {
+		ctx.Errorf("failed to update resource status: %v", err)
+		return
+	}
+
+	s.recordEvent(ctx, &models.Event{ResourceID: op.ResourceID, CloudAccountID: op.CloudAccountID,
+		Type: EventStatusChanged, FromStatus: getTransitionalStatus(ResourceState(op.Action)), ToStatus: resStatus,
+		Actor: ActorOperation, Message: errMsg})
+}
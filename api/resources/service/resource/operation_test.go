@@ -0,0 +1,168 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"golang.org/x/oauth2/google"
+
+	"github.com/zopdev/zopdev/api/resources/client"
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
+)
+
+func TestService_GetOperations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background()}
+	s := New(nil, nil, nil, nil, mStore, &pricing.Catalog{}, nil)
+	ops := []models.Operation{{ID: 1, ResourceID: 2, CloudAccountID: 3, Action: "START", TargetState: RUNNING,
+		Status: OperationInProgress}}
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+		Return(&models.Resource{ID: 2, CloudAccount: models.CloudAccount{ID: 3}}, nil)
+	mStore.EXPECT().GetOperations(ctx, int64(2)).Return(ops, nil)
+
+	res, err := s.GetOperations(ctx, 3, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, ops, res)
+
+	// The resource belongs to another cloud account.
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+		Return(&models.Resource{ID: 2, CloudAccount: models.CloudAccount{ID: 4}}, nil)
+
+	res, err = s.GetOperations(ctx, 3, 2)
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "resource"}, err)
+	assert.Nil(t, res)
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(nil, errMock)
+
+	res, err = s.GetOperations(ctx, 3, 2)
+
+	assert.Equal(t, errMock, err)
+	assert.Nil(t, res)
+}
+
+func TestService_PollOperations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mClient := NewMockHTTPClient(ctrl)
+	mStore := NewMockStore(ctrl)
+	mGCP := NewMockGCPClient(ctrl)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	s := New(mGCP, nil, nil, mClient, mStore, &pricing.Catalog{}, nil)
+	mockCreds := &google.Credentials{ProjectID: "test-project"}
+	mockLister := &mockSQLClient{instances: []models.Resource{
+		{Name: "sql-1", UID: "test-project/sql-1", Status: RUNNING},
+		{Name: "sql-2", UID: "test-project/sql-2", Status: RUNNING},
+		{Name: "sql-3", UID: "test-project/sql-3", Status: RUNNING},
+	}}
+
+	mStore.EXPECT().GetOperationsByStatus(ctx, OperationInProgress).Return([]models.Operation{
+		// The resource reached the target state.
+		{ID: 1, ResourceID: 1, CloudAccountID: 10, TargetState: RUNNING, CreatedAt: time.Now()},
+		// The resource is still being stopped.
+		{ID: 2, ResourceID: 2, CloudAccountID: 10, TargetState: STOPPED, CreatedAt: time.Now()},
+		// The resource did not stop in time.
+		{ID: 3, ResourceID: 3, CloudAccountID: 10, TargetState: STOPPED, CreatedAt: time.Now().Add(-time.Hour)},
+		// The resource is no longer present on the cloud.
+		{ID: 4, ResourceID: 4, CloudAccountID: 10, TargetState: STOPPED, CreatedAt: time.Now()},
+		// The compute instance is described on its own and reached the target state.
+		{ID: 5, ResourceID: 5, CloudAccountID: 10, TargetState: STOPPED, CreatedAt: time.Now()},
+	}, nil)
+	mClient.EXPECT().GetCloudCredentials(ctx, int64(10)).
+		Return(&client.CloudAccount{ID: 10, Provider: "GCP"}, nil)
+	mStore.EXPECT().GetResourceByID(ctx, int64(1)).
+		Return(&models.Resource{ID: 1, Type: string(SQL), UID: "test-project/sql-1"}, nil)
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+		Return(&models.Resource{ID: 2, Type: string(SQL), UID: "test-project/sql-2"}, nil)
+	mStore.EXPECT().GetResourceByID(ctx, int64(3)).
+		Return(&models.Resource{ID: 3, Type: string(SQL), UID: "test-project/sql-3"}, nil)
+	mStore.EXPECT().GetResourceByID(ctx, int64(4)).
+		Return(&models.Resource{ID: 4, Type: string(SQL), UID: "test-project/sql-4"}, nil)
+	mStore.EXPECT().GetResourceByID(ctx, int64(5)).Return(&models.Resource{ID: 5, Type: string(GCPCOMPUTE),
+		Name: "vm-1", UID: "test-project/vm-1", Settings: models.Settings{"zone": "us-central1-a"}}, nil)
+
+	// Only the Cloud SQL instances are listed, once for all the operations.
+	mGCP.EXPECT().NewGoogleCredentials(ctx, gomock.Any(), "https://www.googleapis.com/auth/cloud-platform").
+		Return(mockCreds, nil).Times(2)
+	mGCP.EXPECT().NewSQLClient(ctx, gomock.Any()).Return(mockLister, nil)
+	mGCP.EXPECT().NewComputeClient(ctx, gomock.Any()).Return(&mockComputeClient{instances: []models.Resource{
+		{Name: "vm-1", UID: "test-project/vm-1", Status: STOPPED}}}, nil)
+
+	mStore.EXPECT().CompleteOperation(ctx, int64(1), OperationSucceeded, "").Return(nil)
+	mStore.EXPECT().UpdateStatus(ctx, RUNNING, int64(1)).Return(nil)
+	mStore.EXPECT().InsertEvent(ctx, gomock.Any()).Return(nil)
+	mStore.EXPECT().CompleteOperation(ctx, int64(3), OperationFailed,
+		"timed out waiting for the resource to be STOPPED").Return(nil)
+	mStore.EXPECT().UpdateStatus(ctx, FAILED, int64(3)).Return(nil)
+	mStore.EXPECT().InsertEvent(ctx, gomock.Any()).Return(nil)
+	mStore.EXPECT().CompleteOperation(ctx, int64(4), OperationFailed,
+		"resource not found on the cloud provider").Return(nil)
+	mStore.EXPECT().UpdateStatus(ctx, FAILED, int64(4)).Return(nil)
+	mStore.EXPECT().InsertEvent(ctx, gomock.Any()).Return(nil)
+	mStore.EXPECT().CompleteOperation(ctx, int64(5), OperationSucceeded, "").Return(nil)
+	mStore.EXPECT().UpdateStatus(ctx, STOPPED, int64(5)).Return(nil)
+	mStore.EXPECT().InsertEvent(ctx, gomock.Any()).Return(nil)
+
+	s.PollOperations(ctx)
+}
+
+func TestService_PollOperations_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mClient := NewMockHTTPClient(ctrl)
+	mStore := NewMockStore(ctrl)
+	mGCP := NewMockGCPClient(ctrl)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	s := New(mGCP, nil, nil, mClient, mStore, &pricing.Catalog{}, nil)
+
+	// Operations are left in progress when they cannot be fetched or checked.
+	mStore.EXPECT().GetOperationsByStatus(ctx, OperationInProgress).Return(nil, errMock)
+
+	s.PollOperations(ctx)
+
+	mStore.EXPECT().GetOperationsByStatus(ctx, OperationInProgress).
+		Return([]models.Operation{{ID: 1, ResourceID: 1, CloudAccountID: 10, TargetState: RUNNING}}, nil)
+	mClient.EXPECT().GetCloudCredentials(ctx, int64(10)).Return(nil, errMock)
+
+	s.PollOperations(ctx)
+
+	// The compute instance could not be described, the operation is checked again on the next poll.
+	mStore.EXPECT().GetOperationsByStatus(ctx, OperationInProgress).
+		Return([]models.Operation{{ID: 2, ResourceID: 2, CloudAccountID: 10, TargetState: RUNNING}}, nil)
+	mClient.EXPECT().GetCloudCredentials(ctx, int64(10)).
+		Return(&client.CloudAccount{ID: 10, Provider: "GCP"}, nil)
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+		Return(&models.Resource{ID: 2, Type: string(GCPCOMPUTE), Name: "vm-1", UID: "test-project/vm-1",
+			Settings: models.Settings{"zone": "us-central1-a"}}, nil)
+	mGCP.EXPECT().NewGoogleCredentials(ctx, gomock.Any(), "https://www.googleapis.com/auth/cloud-platform").
+		Return(&google.Credentials{ProjectID: "test-project"}, nil)
+	mGCP.EXPECT().NewComputeClient(ctx, gomock.Any()).Return(nil, errMock)
+
+	s.PollOperations(ctx)
+
+	// The resource could not be fetched, its operation is checked again on the next poll.
+	mStore.EXPECT().GetOperationsByStatus(ctx, OperationInProgress).
+		Return([]models.Operation{{ID: 3, ResourceID: 3, CloudAccountID: 10, TargetState: RUNNING}}, nil)
+	mClient.EXPECT().GetCloudCredentials(ctx, int64(10)).
+		Return(&client.CloudAccount{ID: 10, Provider: "GCP"}, nil)
+	mStore.EXPECT().GetResourceByID(ctx, int64(3)).Return(nil, errMock)
+
+	s.PollOperations(ctx)
+}
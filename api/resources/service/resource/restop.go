@@ -0,0 +1,105 @@
+package resource
+
+import (
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/client"
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// autoStartAfter is the time after which AWS automatically starts a stopped RDS instance or Aurora cluster.
+const autoStartAfter = 7 * 24 * time.Hour
+
+// RestopCron is a cron job that stops again the RDS instances and Aurora clusters that AWS started automatically
+// seven days after zopdev stopped them, as long as the last state requested for them is still SUSPEND. A resource
+// started through zopdev no longer records its stop time and is left running.
+func (s *Service) RestopCron(ctx *gofr.Context) {
+	accounts, err := s.http.GetAllCloudAccounts(ctx)
+	if err != nil {
+		ctx.Errorf("failed to get cloud accounts: %v", err)
+		return
+	}
+
+	for i := range accounts {
+		if CloudProvider(strings.ToUpper(accounts[i].Provider)) != AWS {
+			continue
+		}
+
+		s.restopAccount(ctx, &accounts[i])
+	}
+}
+
+func (s *Service) restopAccount(ctx *gofr.Context, account *client.CloudAccount) {
+	stored, err := s.store.GetResources(ctx, account.ID, []string{string(RDS)})
+	if err != nil {
+		ctx.Errorf("failed to get RDS resources for account %d: %v", account.ID, err)
+		return
+	}
+
+	due := make([]models.Resource, 0)
+
+	for i := range stored {
+		if autoStarted(&stored[i]) {
+			due = append(due, stored[i])
+		}
+	}
+
+	// The resources are only listed when AWS may have started some of them.
+	if len(due) == 0 {
+		return
+	}
+
+	ca, err := s.http.GetCloudCredentials(ctx, account.ID)
+	if err != nil {
+		ctx.Errorf("failed to get cloud credentials for account %d: %v", account.ID, err)
+		return
+	}
+
+	listed, err := s.drivers.get(AWS, RDS).List(ctx, ca.Credentials)
+	if err != nil {
+		ctx.Errorf("failed to list RDS resources for account %d: %v", account.ID, err)
+		return
+	}
+
+	statuses := make(map[string]string, len(listed))
+
+	for i := range listed {
+		statuses[listed[i].UID] = listed[i].Status
+	}
+
+	for i := range due {
+		if statuses[due[i].UID] != RUNNING {
+			continue
+		}
+
+		// The stored status is still STOPPED until the next sync, it is corrected before the resource is stopped.
+		if due[i].Status != RUNNING && !s.syncStatus(ctx, &due[i], RUNNING) {
+			continue
+		}
+
+		err = s.ChangeState(ctx, ResourceDetails{ID: due[i].ID, CloudAccID: account.ID, Name: due[i].Name,
+			Type: RDS, State: SUSPEND, RequestedBy: ActorRestop})
+		if err != nil {
+			ctx.Errorf("failed to stop RDS resource %d started by AWS: %v", due[i].ID, err)
+		}
+	}
+}
+
+// autoStarted reports whether AWS may have started a resource stopped by zopdev, i.e. whether the resource was
+// stopped at least seven days ago and has not been started through zopdev since.
+func autoStarted(res *models.Resource) bool {
+	v, _ := res.Settings[stoppedAtKey].(string)
+	if v == "" {
+		return false
+	}
+
+	stoppedAt, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return false
+	}
+
+	return time.Since(stoppedAt) >= autoStartAfter
+}
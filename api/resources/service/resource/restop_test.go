@@ -0,0 +1,137 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/zopdev/zopdev/api/resources/client"
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/database"
+)
+
+// stubAutoStartedRDS implements the RDSAPI interface with two running instances.
+type stubAutoStartedRDS struct {
+	stubRDS
+}
+
+func (*stubAutoStartedRDS) DescribeDBInstancesWithContext(_ aws.Context, _ *rds.DescribeDBInstancesInput,
+	_ ...request.Option) (*rds.DescribeDBInstancesOutput, error) {
+	return &rds.DescribeDBInstancesOutput{DBInstances: []*rds.DBInstance{
+		{DBInstanceIdentifier: aws.String("db-1"), DBInstanceArn: aws.String("arn:db-1"),
+			DBInstanceStatus: aws.String("available"), InstanceCreateTime: aws.Time(time.Now())},
+		{DBInstanceIdentifier: aws.String("db-2"), DBInstanceArn: aws.String("arn:db-2"),
+			DBInstanceStatus: aws.String("available"), InstanceCreateTime: aws.Time(time.Now())},
+	}}, nil
+}
+
+func TestService_RestopCron(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mHTTP := NewMockHTTPClient(ctrl)
+	mStore := NewMockStore(ctrl)
+	mAWS := NewMockAWSClient(ctrl)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	s := New(nil, mAWS, nil, mHTTP, mStore, &pricing.Catalog{}, nil)
+	ca := &client.CloudAccount{ID: 1, Provider: "aws"}
+	settings := func(stoppedAt time.Time) models.Settings {
+		return models.Settings{"engine": "postgres", "cluster_id": "", stoppedAtKey: stoppedAt.Format(time.RFC3339)}
+	}
+	stoppedAt := time.Now().Add(-8 * 24 * time.Hour)
+	autoStarted := models.Resource{ID: 10, CloudAccount: models.CloudAccount{ID: 1}, Name: "db-1", Type: string(RDS),
+		UID: "arn:db-1", Region: "us-east-1", Status: STOPPED, Settings: settings(stoppedAt)}
+
+	mHTTP.EXPECT().GetAllCloudAccounts(ctx).Return([]client.CloudAccount{{ID: 1, Provider: "aws"},
+		{ID: 2, Provider: "gcp"}}, nil)
+	mStore.EXPECT().GetResources(ctx, int64(1), []string{string(RDS)}).Return([]models.Resource{
+		autoStarted,
+		// Stopped recently, AWS has not started it and the instance listed as running was started by a user.
+		{ID: 11, UID: "arn:db-2", Status: STOPPED, Settings: settings(time.Now().Add(-time.Hour))},
+		// Started through zopdev.
+		{ID: 12, UID: "arn:db-3", Status: RUNNING, Settings: models.Settings{"engine": "postgres"}},
+	}, nil)
+	mHTTP.EXPECT().GetCloudCredentials(ctx, int64(1)).Return(ca, nil).Times(2)
+	mAWS.EXPECT().NewRDSClient(ctx, gomock.Any()).
+		Return(&database.Client{RDS: map[string]database.RDSAPI{"us-east-1": &stubAutoStartedRDS{}}}, nil).Times(2)
+
+	// The drift is recorded, then the instance is stopped again.
+	mStore.EXPECT().UpdateStatus(ctx, RUNNING, int64(10)).Return(nil)
+	mStore.EXPECT().InsertEvent(ctx, &models.Event{ResourceID: 10, CloudAccountID: 1, Type: EventStatusChanged,
+		FromStatus: STOPPED, ToStatus: RUNNING, Actor: ActorSync}).Return(nil)
+	mStore.EXPECT().GetOpenSavings(ctx, int64(10)).
+		Return(&models.Savings{ID: 4, ResourceID: 10, StoppedAt: stoppedAt}, nil)
+	mStore.EXPECT().CloseSavings(ctx, int64(4), gomock.Any(), gomock.Any()).Return(nil)
+
+	running := autoStarted
+	running.Status = RUNNING
+	running.Settings = settings(stoppedAt)
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(10)).Return(&running, nil)
+	mStore.EXPECT().GetActiveLock(ctx, int64(10), gomock.Any()).Return(nil, nil)
+	mStore.EXPECT().GetActiveBlackout(ctx, int64(10), gomock.Any()).Return(nil, nil)
+	mStore.EXPECT().UpdateSettings(ctx, gomock.Any(), int64(10)).
+		DoAndReturn(func(_ *gofr.Context, settings models.Settings, _ int64) error {
+			assert.NotEqual(t, stoppedAt.Format(time.RFC3339), settings[stoppedAtKey])
+
+			return nil
+		})
+	mStore.EXPECT().InsertOperation(ctx, gomock.Any()).Return(nil)
+	mStore.EXPECT().UpdateStatus(ctx, STOPPING, int64(10)).Return(nil)
+	mStore.EXPECT().GetOpenSavings(ctx, int64(10)).Return(nil, nil)
+	mStore.EXPECT().InsertSavings(ctx, gomock.Any()).Return(nil)
+	mStore.EXPECT().InsertEvent(ctx, &models.Event{ResourceID: 10, CloudAccountID: 1, Type: EventStateChangeRequested,
+		FromStatus: RUNNING, ToStatus: STOPPED, Actor: ActorRestop}).Return(nil)
+
+	s.RestopCron(ctx)
+}
+
+func TestService_RestopCron_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mHTTP := NewMockHTTPClient(ctrl)
+	mStore := NewMockStore(ctrl)
+	mAWS := NewMockAWSClient(ctrl)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	s := New(nil, mAWS, nil, mHTTP, mStore, &pricing.Catalog{}, nil)
+	due := []models.Resource{{ID: 10, UID: "arn:db-1", Status: STOPPED,
+		Settings: models.Settings{stoppedAtKey: time.Now().Add(-8 * 24 * time.Hour).Format(time.RFC3339)}}}
+
+	mHTTP.EXPECT().GetAllCloudAccounts(ctx).Return(nil, errMock)
+
+	s.RestopCron(ctx)
+
+	// The resources of an account are not listed when none of them is due.
+	mHTTP.EXPECT().GetAllCloudAccounts(ctx).Return([]client.CloudAccount{{ID: 1, Provider: "AWS"}}, nil)
+	mStore.EXPECT().GetResources(ctx, int64(1), []string{string(RDS)}).
+		Return([]models.Resource{{ID: 11, Settings: models.Settings{stoppedAtKey: "yesterday"}}}, nil)
+
+	s.RestopCron(ctx)
+
+	mHTTP.EXPECT().GetAllCloudAccounts(ctx).Return([]client.CloudAccount{{ID: 1, Provider: "aws"}}, nil).Times(3)
+	mStore.EXPECT().GetResources(ctx, int64(1), []string{string(RDS)}).Return(nil, errMock)
+
+	s.RestopCron(ctx)
+
+	mStore.EXPECT().GetResources(ctx, int64(1), []string{string(RDS)}).Return(due, nil).Times(2)
+	mHTTP.EXPECT().GetCloudCredentials(ctx, int64(1)).Return(nil, errMock)
+
+	s.RestopCron(ctx)
+
+	mHTTP.EXPECT().GetCloudCredentials(ctx, int64(1)).Return(&client.CloudAccount{ID: 1, Provider: "aws"}, nil)
+	mAWS.EXPECT().NewRDSClient(ctx, gomock.Any()).Return(nil, errMock)
+
+	s.RestopCron(ctx)
+}
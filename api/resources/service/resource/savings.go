@@ -0,0 +1,154 @@
+package resource
+
+import (
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
+)
+
+// GetSavings returns the amount saved by suspending the resources of a cloud account in the month of the given time,
+// the current month if it is zero, in total and by resource group. A resource that belongs to several resource groups
+// is counted in each of them.
+func (s *Service) GetSavings(ctx *gofr.Context, id int64, month time.Time) (*models.SavingsSummary, error) {
+	if month.IsZero() {
+		month = time.Now().UTC()
+	}
+
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	entries, err := s.store.GetSavings(ctx, id, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.store.GetResourceGroupNames(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &models.SavingsSummary{
+		Month:           from.Format(MonthFormat),
+		ByResourceGroup: make(map[string]models.SavingsBreakdown),
+	}
+
+	// The savings of resources that are still suspended are counted up to now.
+	end := to
+	if now := time.Now(); now.Before(end) {
+		end = now
+	}
+
+	for i := range entries {
+		hours := downtimeHours(&entries[i], from, end)
+		if hours <= 0 {
+			continue
+		}
+
+		summary.Total = addSavings(summary.Total, hours, entries[i].HourlyRate)
+
+		for _, g := range groups[entries[i].ResourceID] {
+			summary.ByResourceGroup[g] = addSavings(summary.ByResourceGroup[g], hours, entries[i].HourlyRate)
+		}
+	}
+
+	return summary, nil
+}
+
+// recordSavings updates the savings ledger for a state change accepted by the cloud provider. Suspending a resource
+// opens a ledger entry priced at what the resource does not cost while it is stopped, starting it again closes the
+// entry with the saved amount. The ledger is best effort, a failure to update it is logged.
+func (s *Service) recordSavings(ctx *gofr.Context, resDetails ResourceDetails, res *models.Resource) {
+	open, err := s.store.GetOpenSavings(ctx, res.ID)
+	if err != nil {
+		ctx.Errorf("failed to get open savings of resource %d: %v", res.ID, err)
+		return
+	}
+
+	now := time.Now()
+
+	switch {
+	case resDetails.State == SUSPEND && open == nil:
+		savings := &models.Savings{ResourceID: res.ID, CloudAccountID: resDetails.CloudAccID, StoppedAt: now}
+
+		if cost := s.pricing.Estimate(res); cost != nil {
+			savings.HourlyRate = cost.Hourly - cost.StoppedMonthly/pricing.HoursPerMonth
+			savings.Currency = cost.Currency
+		}
+
+		err = s.store.InsertSavings(ctx, savings)
+	case resDetails.State == START && open != nil:
+		err = s.store.CloseSavings(ctx, open.ID, now, downtimeHours(open, open.StoppedAt, now)*open.HourlyRate)
+	default:
+		return
+	}
+
+	if err != nil {
+		ctx.Errorf("failed to update savings ledger of resource %d: %v", res.ID, err)
+	}
+}
+
+// closeOpenSavings closes the open ledger entry of a resource that is running again without being started through
+// zopdev, e.g. started from the cloud console or by AWS after seven days.
+func (s *Service) closeOpenSavings(ctx *gofr.Context, resourceID int64) {
+	open, err := s.store.GetOpenSavings(ctx, resourceID)
+	if err != nil {
+		ctx.Errorf("failed to get open savings of resource %d: %v", resourceID, err)
+		return
+	}
+
+	if open == nil {
+		return
+	}
+
+	now := time.Now()
+
+	err = s.store.CloseSavings(ctx, open.ID, now, downtimeHours(open, open.StoppedAt, now)*open.HourlyRate)
+	if err != nil {
+		ctx.Errorf("failed to update savings ledger of resource %d: %v", resourceID, err)
+	}
+}
+
+// voidOpenSavings voids the ledger entry opened when the suspension of a resource was accepted, once the suspension
+// failed as the resource saved nothing.
+func (s *Service) voidOpenSavings(ctx *gofr.Context, resourceID int64) {
+	open, err := s.store.GetOpenSavings(ctx, resourceID)
+	if err != nil {
+		ctx.Errorf("failed to get open savings of resource %d: %v", resourceID, err)
+		return
+	}
+
+	if open == nil {
+		return
+	}
+
+	err = s.store.VoidSavings(ctx, open.ID)
+	if err != nil {
+		ctx.Errorf("failed to update savings ledger of resource %d: %v", resourceID, err)
+	}
+}
+
+// downtimeHours returns the hours a resource was suspended within the time range, open entries are suspended
+// until the end of the range.
+func downtimeHours(entry *models.Savings, from, to time.Time) float64 {
+	start := entry.StoppedAt
+	if start.Before(from) {
+		start = from
+	}
+
+	end := to
+	if entry.StartedAt != nil && entry.StartedAt.Before(end) {
+		end = *entry.StartedAt
+	}
+
+	return end.Sub(start).Hours()
+}
+
+func addSavings(b models.SavingsBreakdown, hours, rate float64) models.SavingsBreakdown {
+	b.DowntimeHours += hours
+	b.Amount += hours * rate
+
+	return b
+}
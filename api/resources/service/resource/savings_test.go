@@ -0,0 +1,239 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
+)
+
+func TestService_GetSavings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background()}
+	s := New(nil, nil, nil, nil, mStore, &pricing.Catalog{}, nil)
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	startedAt := from.Add(2 * time.Hour)
+	entries := []models.Savings{
+		// Suspended before the month, only the downtime within the month is counted.
+		{ID: 1, ResourceID: 1, HourlyRate: 0.5, StoppedAt: from.Add(-2 * time.Hour), StartedAt: &startedAt},
+		// Still suspended, counted up to the end of the month.
+		{ID: 2, ResourceID: 2, HourlyRate: 0.25, StoppedAt: to.Add(-4 * time.Hour)},
+	}
+
+	mStore.EXPECT().GetSavings(ctx, int64(1), from, to).Return(entries, nil)
+	mStore.EXPECT().GetResourceGroupNames(ctx, int64(1)).Return(map[int64][]string{1: {"dev"}, 2: {"dev", "payments"}}, nil)
+
+	summary, err := s.GetSavings(ctx, 1, from.Add(10*24*time.Hour))
+	require.NoError(t, err)
+
+	assert.Equal(t, "2025-06", summary.Month)
+	assertSavings(t, models.SavingsBreakdown{DowntimeHours: 6, Amount: 2}, summary.Total)
+	assertSavings(t, models.SavingsBreakdown{DowntimeHours: 6, Amount: 2}, summary.ByResourceGroup["dev"])
+	assertSavings(t, models.SavingsBreakdown{DowntimeHours: 4, Amount: 1}, summary.ByResourceGroup["payments"])
+
+	mStore.EXPECT().GetSavings(ctx, int64(2), from, to).Return(nil, errMock)
+
+	_, err = s.GetSavings(ctx, 2, from)
+	require.ErrorIs(t, err, errMock)
+
+	mStore.EXPECT().GetSavings(ctx, int64(3), from, to).Return(nil, nil)
+	mStore.EXPECT().GetResourceGroupNames(ctx, int64(3)).Return(nil, errMock)
+
+	_, err = s.GetSavings(ctx, 3, from)
+	require.ErrorIs(t, err, errMock)
+}
+
+func TestService_GetSavings_CurrentMonth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background()}
+	s := New(nil, nil, nil, nil, mStore, &pricing.Catalog{}, nil)
+	now := time.Now().UTC()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	mStore.EXPECT().GetSavings(ctx, int64(1), from, from.AddDate(0, 1, 0)).
+		Return([]models.Savings{{ID: 1, ResourceID: 1, HourlyRate: 1, StoppedAt: from}}, nil)
+	mStore.EXPECT().GetResourceGroupNames(ctx, int64(1)).Return(nil, nil)
+
+	summary, err := s.GetSavings(ctx, 1, time.Time{})
+	require.NoError(t, err)
+
+	// Open entries of the current month are only counted up to now.
+	assert.Equal(t, from.Format(MonthFormat), summary.Month)
+	assert.InDelta(t, time.Since(from).Hours(), summary.Total.DowntimeHours, 0.01)
+}
+
+func TestService_recordSavings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mStore := NewMockStore(ctrl)
+	mPricing := NewMockPricing(ctrl)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	s := New(nil, nil, nil, nil, mStore, mPricing, nil)
+	res := &models.Resource{ID: 1, Type: string(SQL), Status: RUNNING}
+	open := &models.Savings{ID: 5, ResourceID: 1, CloudAccountID: 2, HourlyRate: 0.5,
+		StoppedAt: time.Now().Add(-2 * time.Hour)}
+
+	t.Run("Suspend opens an entry", func(_ *testing.T) {
+		mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(nil, nil)
+		mPricing.EXPECT().Estimate(res).Return(&models.Cost{Hourly: 0.2, StoppedMonthly: 7.3, Currency: "USD"})
+		mStore.EXPECT().InsertSavings(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, savings *models.Savings) error {
+			assert.Equal(t, int64(1), savings.ResourceID)
+			assert.Equal(t, int64(2), savings.CloudAccountID)
+			assert.InDelta(t, 0.19, savings.HourlyRate, 1e-9)
+			assert.Equal(t, "USD", savings.Currency)
+
+			return nil
+		})
+
+		s.recordSavings(ctx, ResourceDetails{CloudAccID: 2, State: SUSPEND}, res)
+	})
+
+	t.Run("Suspend keeps an open entry", func(_ *testing.T) {
+		mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(open, nil)
+
+		s.recordSavings(ctx, ResourceDetails{CloudAccID: 2, State: SUSPEND}, res)
+	})
+
+	t.Run("Start closes the open entry", func(_ *testing.T) {
+		mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(open, nil)
+		mStore.EXPECT().CloseSavings(ctx, int64(5), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ *gofr.Context, _ int64, _ time.Time, amount float64) error {
+				assert.InDelta(t, 1, amount, 0.01)
+
+				return nil
+			})
+
+		s.recordSavings(ctx, ResourceDetails{CloudAccID: 2, State: START}, res)
+	})
+
+	t.Run("Start without an open entry", func(_ *testing.T) {
+		mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(nil, nil)
+
+		s.recordSavings(ctx, ResourceDetails{CloudAccID: 2, State: START}, res)
+	})
+
+	// Failures to update the ledger are only logged.
+	t.Run("GetOpenSavings error", func(_ *testing.T) {
+		mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(nil, errMock)
+
+		s.recordSavings(ctx, ResourceDetails{CloudAccID: 2, State: SUSPEND}, res)
+	})
+
+	t.Run("InsertSavings error", func(_ *testing.T) {
+		mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(nil, nil)
+		mPricing.EXPECT().Estimate(res).Return(nil)
+		mStore.EXPECT().InsertSavings(ctx, gomock.Any()).Return(errMock)
+
+		s.recordSavings(ctx, ResourceDetails{CloudAccID: 2, State: SUSPEND}, res)
+	})
+}
+
+func assertSavings(t *testing.T, expected, actual models.SavingsBreakdown) {
+	t.Helper()
+
+	assert.InDelta(t, expected.DowntimeHours, actual.DowntimeHours, 1e-9)
+	assert.InDelta(t, expected.Amount, actual.Amount, 1e-9)
+}
+
+func TestService_closeOpenSavings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	s := New(nil, nil, nil, nil, mStore, &pricing.Catalog{}, nil)
+	open := &models.Savings{ID: 5, ResourceID: 1, HourlyRate: 0.5, StoppedAt: time.Now().Add(-2 * time.Hour)}
+
+	// A resource started outside zopdev closes the entry at the time the sync noticed it.
+	mStore.EXPECT().UpdateStatus(ctx, RUNNING, int64(1)).Return(nil)
+	mStore.EXPECT().InsertEvent(ctx, gomock.Any()).Return(nil)
+	mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(open, nil)
+	mStore.EXPECT().CloseSavings(ctx, int64(5), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ *gofr.Context, _ int64, _ time.Time, amount float64) error {
+			assert.InDelta(t, 1, amount, 0.01)
+
+			return nil
+		})
+
+	assert.True(t, s.syncStatus(ctx, &models.Resource{ID: 1, Status: STOPPED}, RUNNING))
+
+	// Resources that were not stopped have no entry to close.
+	mStore.EXPECT().UpdateStatus(ctx, STOPPED, int64(1)).Return(nil)
+	mStore.EXPECT().InsertEvent(ctx, gomock.Any()).Return(nil)
+
+	assert.True(t, s.syncStatus(ctx, &models.Resource{ID: 1, Status: RUNNING}, STOPPED))
+
+	mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(nil, nil)
+
+	s.closeOpenSavings(ctx, 1)
+
+	// Failures to update the ledger are only logged.
+	mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(nil, errMock)
+
+	s.closeOpenSavings(ctx, 1)
+
+	mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(open, nil)
+	mStore.EXPECT().CloseSavings(ctx, int64(5), gomock.Any(), gomock.Any()).Return(errMock)
+
+	s.closeOpenSavings(ctx, 1)
+}
+
+func TestService_voidOpenSavings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	s := New(nil, nil, nil, nil, mStore, &pricing.Catalog{}, nil)
+	open := &models.Savings{ID: 5, ResourceID: 1, HourlyRate: 0.5, StoppedAt: time.Now()}
+
+	// A failed suspension voids the entry opened when it was accepted.
+	mStore.EXPECT().CompleteOperation(ctx, int64(3), OperationFailed, "timed out").Return(nil)
+	mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(open, nil)
+	mStore.EXPECT().VoidSavings(ctx, int64(5)).Return(nil)
+	mStore.EXPECT().UpdateStatus(ctx, FAILED, int64(1)).Return(nil)
+	mStore.EXPECT().InsertEvent(ctx, gomock.Any()).Return(nil)
+
+	s.completeOperation(ctx, &models.Operation{ID: 3, ResourceID: 1, Action: string(SUSPEND), TargetState: STOPPED},
+		OperationFailed, "timed out")
+
+	// A failed start keeps the ledger as is.
+	mStore.EXPECT().CompleteOperation(ctx, int64(4), OperationFailed, "timed out").Return(nil)
+	mStore.EXPECT().UpdateStatus(ctx, FAILED, int64(1)).Return(nil)
+	mStore.EXPECT().InsertEvent(ctx, gomock.Any()).Return(nil)
+
+	s.completeOperation(ctx, &models.Operation{ID: 4, ResourceID: 1, Action: string(START), TargetState: RUNNING},
+		OperationFailed, "timed out")
+
+	mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(nil, nil)
+
+	s.voidOpenSavings(ctx, 1)
+
+	mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(nil, errMock)
+
+	s.voidOpenSavings(ctx, 1)
+
+	mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(open, nil)
+	mStore.EXPECT().VoidSavings(ctx, int64(5)).Return(errMock)
+
+	s.voidOpenSavings(ctx, 1)
+}
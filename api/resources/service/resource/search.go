@@ -0,0 +1,107 @@
+package resource
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const (
+	// defaultPageSize is the number of resources of a page when the query sets no limit.
+	defaultPageSize = 50
+	// maxPageSize is the largest number of resources of a page.
+	maxPageSize = 200
+)
+
+// Search returns a page of the resources of all the cloud accounts matching the query with their estimated cost.
+// The next page is fetched by repeating the query with the cursor of the page.
+func (s *Service) Search(ctx *gofr.Context, q *models.ResourceQuery) (*models.ResourcePage, error) {
+	switch q.SortBy {
+	case "":
+		q.SortBy = models.SortByName
+	case models.SortByName, models.SortByStatus, models.SortByRegion, models.SortByType, models.SortByProvider,
+		models.SortByCreatedAt:
+	default:
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"sort"}}
+	}
+
+	switch {
+	case q.Limit == 0:
+		q.Limit = defaultPageSize
+	case q.Limit < 0 || q.Limit > maxPageSize:
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"limit"}}
+	}
+
+	if q.Cursor != "" {
+		after, err := decodeCursor(q.Cursor)
+		if err != nil {
+			return nil, gofrHttp.ErrorInvalidParam{Params: []string{"cursor"}}
+		}
+
+		q.After = after
+	}
+
+	// One more resource than the page holds is fetched to know whether there is a next page.
+	fetch := *q
+	fetch.Limit++
+
+	res, err := s.store.SearchResources(ctx, &fetch)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.ResourcePage{Resources: res}
+
+	if len(res) > q.Limit {
+		page.Resources = res[:q.Limit]
+		page.NextCursor = encodeCursor(&page.Resources[q.Limit-1], q.SortBy)
+	}
+
+	for i := range page.Resources {
+		page.Resources[i].Cost = s.pricing.Estimate(&page.Resources[i])
+	}
+
+	return page, nil
+}
+
+// encodeCursor encodes the position of a resource in the sort order into an opaque cursor.
+func encodeCursor(res *models.Resource, sortBy string) string {
+	c := models.PageCursor{ID: res.ID}
+
+	switch sortBy {
+	case models.SortByName:
+		c.Value = res.Name
+	case models.SortByStatus:
+		c.Value = res.Status
+	case models.SortByRegion:
+		c.Value = res.Region
+	case models.SortByType:
+		c.Value = res.Type
+	case models.SortByProvider:
+		c.Value = res.CloudAccount.Type
+	}
+
+	b, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor decodes a cursor returned with a page.
+func decodeCursor(cursor string) (*models.PageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var c models.PageCursor
+
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,92 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
+)
+
+func TestService_Search(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background()}
+	s := New(nil, nil, nil, nil, mStore, &pricing.Catalog{}, nil)
+	resources := []models.Resource{
+		{ID: 4, Name: "db-1", Type: string(RDS), CloudAccount: models.CloudAccount{ID: 1, Type: "aws"}},
+		{ID: 2, Name: "db-2", Type: string(RDS), CloudAccount: models.CloudAccount{ID: 2, Type: "aws"}},
+		{ID: 9, Name: "db-3", Type: string(RDS), CloudAccount: models.CloudAccount{ID: 2, Type: "aws"}},
+	}
+
+	// One more resource than the page holds is fetched, the next page starts after the last resource of the page.
+	mStore.EXPECT().SearchResources(ctx, &models.ResourceQuery{Types: []string{string(RDS)},
+		SortBy: models.SortByName, Limit: 3}).Return(resources, nil)
+
+	page, err := s.Search(ctx, &models.ResourceQuery{Types: []string{string(RDS)}, Limit: 2})
+
+	require.NoError(t, err)
+	assert.Equal(t, resources[:2], page.Resources)
+	require.NotEmpty(t, page.NextCursor)
+
+	after, err := decodeCursor(page.NextCursor)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.PageCursor{Value: "db-2", ID: 2}, after)
+
+	mStore.EXPECT().SearchResources(ctx, &models.ResourceQuery{Types: []string{string(RDS)},
+		SortBy: models.SortByName, Cursor: page.NextCursor, After: after, Limit: 3}).Return(resources[2:], nil)
+
+	page, err = s.Search(ctx, &models.ResourceQuery{Types: []string{string(RDS)}, Cursor: page.NextCursor, Limit: 2})
+
+	require.NoError(t, err)
+	assert.Equal(t, resources[2:], page.Resources)
+	assert.Empty(t, page.NextCursor)
+
+	mStore.EXPECT().SearchResources(ctx, &models.ResourceQuery{SortBy: models.SortByCreatedAt, Descending: true,
+		Limit: defaultPageSize + 1}).Return(nil, errMock)
+
+	page, err = s.Search(ctx, &models.ResourceQuery{SortBy: models.SortByCreatedAt, Descending: true})
+
+	require.ErrorIs(t, err, errMock)
+	assert.Nil(t, page)
+}
+
+func TestService_Search_InvalidQuery(t *testing.T) {
+	s := New(nil, nil, nil, nil, nil, &pricing.Catalog{}, nil)
+	ctx := &gofr.Context{Context: context.Background()}
+
+	_, err := s.Search(ctx, &models.ResourceQuery{SortBy: "cost"})
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"sort"}}, err)
+
+	_, err = s.Search(ctx, &models.ResourceQuery{Limit: maxPageSize + 1})
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"limit"}}, err)
+
+	_, err = s.Search(ctx, &models.ResourceQuery{Cursor: "not a cursor"})
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"cursor"}}, err)
+}
+
+func TestEncodeCursor(t *testing.T) {
+	res := &models.Resource{ID: 7, Name: "vm-1", Status: RUNNING, Region: "us-east-1", Type: string(AWSCOMPUTE),
+		CloudAccount: models.CloudAccount{Type: "aws"}}
+
+	for sortBy, value := range map[string]string{models.SortByName: "vm-1", models.SortByStatus: RUNNING,
+		models.SortByRegion: "us-east-1", models.SortByType: string(AWSCOMPUTE), models.SortByProvider: "aws",
+		models.SortByCreatedAt: ""} {
+		c, err := decodeCursor(encodeCursor(res, sortBy))
+
+		require.NoError(t, err)
+		assert.Equal(t, &models.PageCursor{Value: value, ID: 7}, c, sortBy)
+	}
+}
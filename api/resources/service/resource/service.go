@@ -1,30 +1,52 @@
 package resource
 
 import (
+	"crypto/rand"
+	"maps"
+	"reflect"
+	"strings"
+	"time"
+
 	"gofr.dev/pkg/gofr"
 	gofrHttp "gofr.dev/pkg/gofr/http"
 
-	"github.com/zopdev/zopdev/api/resources/client"
 	"github.com/zopdev/zopdev/api/resources/models"
 )
 
 type Service struct {
-	gcp   GCPClient
-	aws   AWSClient
-	http  HTTPClient
-	store Store
+	drivers    Drivers
+	http       HTTPClient
+	store      Store
+	pricing    Pricing
+	confirmKey []byte
 }
 
-func New(gcp GCPClient, aws AWSClient, http HTTPClient, store Store) *Service {
-	return &Service{gcp: gcp, aws: aws, http: http, store: store}
+// New creates the resource service. The deletions are confirmed with tokens signed with the confirm key, a random key
+// is used when it is empty and the tokens are then only valid on the instance that issued them.
+func New(gcp GCPClient, aws AWSClient, oci OCIClient, http HTTPClient, store Store, pricing Pricing,
+	confirmKey []byte) *Service {
+	if len(confirmKey) == 0 {
+		confirmKey = make([]byte, confirmKeySize)
+		_, _ = rand.Read(confirmKey)
+	}
+
+	return &Service{drivers: newDrivers(gcp, aws, oci), http: http, store: store, pricing: pricing,
+		confirmKey: confirmKey}
 }
 
-func (s *Service) GetAll(ctx *gofr.Context, id int64, resourceType []string) ([]models.Resource, error) {
-	res, err := s.store.GetResources(ctx, id, resourceType)
+// GetAll returns the resources of a cloud account with their estimated cost, optionally filtered by resource types
+// and label selectors.
+func (s *Service) GetAll(ctx *gofr.Context, id int64, resourceType []string,
+	selectors ...models.LabelSelector) ([]models.Resource, error) {
+	res, err := s.store.GetResources(ctx, id, resourceType, selectors...)
 	if err != nil {
 		return nil, err
 	}
 
+	for i := range res {
+		res[i].Cost = s.pricing.Estimate(&res[i])
+	}
+
 	return res, nil
 }
 
@@ -38,12 +60,12 @@ func (s *Service) GetByID(ctx *gofr.Context, id int64) (*models.Resource, error)
 		return nil, gofrHttp.ErrorEntityNotFound{Name: "resource"}
 	}
 
+	res.Cost = s.pricing.Estimate(res)
+
 	return res, nil
 }
 
-// TODO : This function should be generic such that we dont need to call anything specific to a particular cloud provider type.
-// and the error returned in case when the resource is starting or stopping or other operation, error should be returned carefully.
-// or state should be managed.
+// ChangeState starts or suspends a resource through the driver of its cloud provider and resource type.
 func (s *Service) ChangeState(ctx *gofr.Context, resDetails ResourceDetails) error {
 	res, err := s.store.GetResourceByID(ctx, resDetails.ID)
 	if err != nil {
@@ -54,64 +76,75 @@ func (s *Service) ChangeState(ctx *gofr.Context, resDetails ResourceDetails) err
 		return nil
 	}
 
-	ca, err := s.http.GetCloudCredentials(ctx, resDetails.CloudAccID)
+	// The provider is still working on a previous request, it is completed or failed by the operations poller.
+	inProgress, err := s.operationInProgress(ctx, res)
 	if err != nil {
 		return err
 	}
 
-	switch resDetails.Type {
-	case SQL, "RDS":
-		return s.handleSQLChangeState(ctx, ca, resDetails)
-	case AWSCOMPUTE:
-		return s.handleAWSComputeChangeState(ctx, ca, resDetails, res)
-	default:
-		return gofrHttp.ErrorInvalidParam{Params: []string{"req.Type"}}
+	if inProgress {
+		return &ErrOperationInProgress{ResourceID: res.ID}
 	}
-}
 
-func (s *Service) handleSQLChangeState(ctx *gofr.Context, ca *client.CloudAccount, resDetails ResourceDetails) error {
-	err := s.changeSQLState(ctx, ca, resDetails)
+	if resDetails.State == SUSPEND {
+		if err = s.checkSuspend(ctx, res.ID); err != nil {
+			return err
+		}
+	}
+
+	ca, err := s.http.GetCloudCredentials(ctx, resDetails.CloudAccID)
 	if err != nil {
-		ctx.Errorf("failed to change SQL state: %v", err)
 		return err
 	}
 
-	err = s.store.UpdateStatus(ctx, getStatus(resDetails.State), resDetails.ID)
-	if err != nil {
-		ctx.Errorf("failed to update resource status: %v", err)
+	d := s.drivers.get(CloudProvider(strings.ToUpper(ca.Provider)), resDetails.Type)
+	if d == nil {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"req.Type"}}
 	}
 
-	return nil
-}
+	// Waste candidates such as unattached disks are deleted and caches are only inventoried, they are not started or
+	// stopped.
+	if _, ok := d.(interface{ inventoryOnly() }); ok {
+		return &ErrNotChangeable{ResourceID: res.ID, Type: string(resDetails.Type)}
+	}
+
+	// Drivers record in the settings of a resource what they need to restore it, e.g. the size of a node pool.
+	settings := maps.Clone(res.Settings)
+
+	switch resDetails.State {
+	case START:
+		err = d.Start(ctx, ca.Credentials, res)
+	case SUSPEND:
+		err = d.Stop(ctx, ca.Credentials, res)
+	default:
+		return gofrHttp.ErrorInvalidParam{Params: []string{"req.State"}}
+	}
 
-func (s *Service) handleAWSComputeChangeState(ctx *gofr.Context, ca *client.CloudAccount, resDetails ResourceDetails,
-	res *models.Resource) error {
-	cl, err := s.aws.NewEC2Client(ctx, ca.Credentials)
 	if err != nil {
-		ctx.Errorf("failed to create AWS EC2 client: %v", err)
-		return err
+		ctx.Errorf("failed to change the state of %s resource %d: %v", resDetails.Type, res.ID, err)
 	}
 
-	if resDetails.State == START {
-		err = cl.StartInstance(ctx, res.UID)
-		if err != nil {
-			ctx.Errorf("failed to start EC2 instance: %v", err)
-			return err
-		}
-	} else {
-		err = cl.StopInstance(ctx, res.UID)
-		if err != nil {
-			ctx.Errorf("failed to start EC2 instance: %v", err)
-			return err
+	if !reflect.DeepEqual(settings, res.Settings) {
+		if er := s.store.UpdateSettings(ctx, res.Settings, res.ID); er != nil {
+			ctx.Errorf("failed to update the settings of resource %d: %v", res.ID, er)
 		}
 	}
 
-	err = s.store.UpdateStatus(ctx, getStatus(resDetails.State), resDetails.ID)
+	s.recordOperation(ctx, resDetails, err)
+
+	if err == nil {
+		s.recordSavings(ctx, resDetails, res)
+	}
+
+	event := &models.Event{ResourceID: res.ID, CloudAccountID: resDetails.CloudAccID, Type: EventStateChangeRequested,
+		FromStatus: res.Status, ToStatus: getStatus(resDetails.State), Actor: resDetails.RequestedBy}
 	if err != nil {
-		ctx.Errorf("failed to update resource status: %v", err)
+		event.Message = err.Error()
 	}
 
-	return nil
+	s.recordEvent(ctx, event)
+
+	return err
 }
 
 func getStatus(action ResourceState) string {
@@ -125,21 +158,50 @@ func getStatus(action ResourceState) string {
 	}
 }
 
-func (s *Service) SyncResources(ctx *gofr.Context, id int64) ([]models.Resource, error) {
+// getTransitionalStatus returns the status of a resource while the provider is applying the action.
+func getTransitionalStatus(action ResourceState) string {
+	switch action {
+	case START:
+		return STARTING
+	case SUSPEND:
+		return STOPPING
+	default:
+		return ""
+	}
+}
+
+// SyncResources brings the stored resources of a cloud account in line with the cloud provider. Only the differences
+// are written, and every sync is recorded as a sync run. The listers of the resource types run independently, the
+// stored resources of a type whose lister failed are kept and the failure is reported in its sync status.
+func (s *Service) SyncResources(ctx *gofr.Context, id int64) (*models.SyncResult, error) {
 	ca, err := s.http.GetCloudCredentials(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	ins, err := s.getAllInstances(ctx, ca)
-	if err != nil {
-		ctx.Errorf("failed to get all instances: %v", err)
-		return nil, err
+	run := &models.SyncRun{CloudAccountID: id, StartedAt: time.Now(), Counts: make(models.SyncCounts)}
+	s.startSyncRun(ctx, run)
+
+	defer s.completeSyncRun(ctx, run)
+
+	ins, statuses := s.getAllInstances(ctx, ca)
+	run.Statuses = statuses
+
+	failed := failedTypes(statuses)
+	for t := range failed {
+		ctx.Errorf("failed to list %s resources of cloud account %d: %s", t, id, statuses[t].Error)
+	}
+
+	// Nothing could be listed, there is nothing to sync.
+	if len(failed) > 0 && len(failed) == len(statuses) {
+		return nil, &ErrSyncFailed{CloudAccountID: id, Statuses: statuses}
 	}
 
 	res, err := s.store.GetResources(ctx, id, nil)
 	if err != nil {
 		ctx.Errorf("failed to get existing resources: %v", err)
+		run.Errors = append(run.Errors, err.Error())
+
 		return nil, err
 	}
 
@@ -154,27 +216,116 @@ func (s *Service) SyncResources(ctx *gofr.Context, id int64) ([]models.Resource,
 			err = s.store.InsertResource(ctx, &ins[i])
 			if err != nil {
 				ctx.Errorf("failed to insert resource: %v", err)
+				continue
+			}
+
+			s.recordEvent(ctx, &models.Event{ResourceID: ins[i].ID, CloudAccountID: id, Type: EventCreated,
+				ToStatus: ins[i].Status, Actor: ActorSync})
+
+			if len(ins[i].Labels) > 0 {
+				s.syncLabels(ctx, ins[i].ID, ins[i].Labels)
 			}
+
+			addSyncCount(run.Counts, ins[i].Type, models.SyncCount{Added: 1})
 		} else {
 			// else update the existing resource and mark the resource as visited.
 			visited[idx] = true
 			ins[i].ID = res[idx].ID
-			err = s.store.UpdateStatus(ctx, ins[i].Status, ins[i].ID)
 
-			if err != nil {
-				ctx.Errorf("failed to update resource: %v", err)
+			if s.updateResource(ctx, &res[idx], &ins[i]) {
+				addSyncCount(run.Counts, ins[i].Type, models.SyncCount{Updated: 1})
 			}
 		}
 	}
 
-	s.removeStale(ctx, visited, res)
+	s.removeStale(ctx, visited, res, failed, run.Counts)
+
+	all, err := s.GetAll(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SyncResult{Resources: all, Statuses: statuses}, nil
+}
+
+// updateResource writes the attributes of a listed resource that differ from the stored resource and reports
+// whether the stored resource was updated.
+func (s *Service) updateResource(ctx *gofr.Context, stored, listed *models.Resource) bool {
+	updated := false
+
+	// Resources with an operation in progress are updated by the operations poller once the operation completes.
+	if stored.Status != listed.Status {
+		inProgress, err := s.operationInProgress(ctx, stored)
+		if err != nil {
+			ctx.Errorf("failed to get the operations of resource %d: %v", stored.ID, err)
+		}
+
+		if err == nil && !inProgress {
+			updated = s.syncStatus(ctx, stored, listed.Status) || updated
+		}
+	}
+
+	if !maps.Equal(listed.Labels, stored.Labels) {
+		updated = s.syncLabels(ctx, stored.ID, listed.Labels) || updated
+	}
+
+	if listed.Spec != stored.Spec {
+		err := s.store.UpdateSpec(ctx, listed.Spec, stored.ID)
+		if err != nil {
+			ctx.Errorf("failed to update resource spec: %v", err)
+		}
+
+		updated = err == nil || updated
+	}
+
+	if listed.Region != stored.Region {
+		err := s.store.UpdateRegion(ctx, listed.Region, stored.ID)
+		if err != nil {
+			ctx.Errorf("failed to update resource region: %v", err)
+		}
 
-	return s.GetAll(ctx, id, nil)
+		updated = err == nil || updated
+	}
+
+	return updated
 }
 
-func (s *Service) removeStale(ctx *gofr.Context, visited []bool, res []models.Resource) {
+// syncStatus updates the status of a stored resource to the one reported by the cloud provider and records
+// the drift in the history of the resource, as the status was changed outside zopdev.
+func (s *Service) syncStatus(ctx *gofr.Context, res *models.Resource, status string) bool {
+	err := s.store.UpdateStatus(ctx, status, res.ID)
+	if err != nil {
+		ctx.Errorf("failed to update resource: %v", err)
+		return false
+	}
+
+	s.recordEvent(ctx, &models.Event{ResourceID: res.ID, CloudAccountID: res.CloudAccount.ID,
+		Type: EventStatusChanged, FromStatus: res.Status, ToStatus: status, Actor: ActorSync})
+
+	// A resource suspended through zopdev no longer saves anything once it left the stopped state.
+	if res.Status == STOPPED {
+		s.closeOpenSavings(ctx, res.ID)
+	}
+
+	return true
+}
+
+func (s *Service) syncLabels(ctx *gofr.Context, id int64, labels models.Labels) bool {
+	err := s.store.UpdateLabels(ctx, id, labels)
+	if err != nil {
+		ctx.Errorf("failed to update resource labels: %v", err)
+		return false
+	}
+
+	return true
+}
+
+// removeStale removes the stored resources that were not listed, except the ones of the resource types whose lister
+// failed as their absence does not mean that they were deleted.
+func (s *Service) removeStale(ctx *gofr.Context, visited []bool, res []models.Resource, failed map[string]bool,
+	counts models.SyncCounts) {
 	for i, v := range visited {
-		if v {
+		if v || failed[res[i].Type] {
 			continue
 		}
 
@@ -182,25 +333,13 @@ func (s *Service) removeStale(ctx *gofr.Context, visited []bool, res []models.Re
 		err := s.store.RemoveResource(ctx, res[i].ID)
 		if err != nil {
 			ctx.Errorf("failed to remove resource: %v", err)
+			continue
 		}
-	}
-}
 
-func (s *Service) getALLComputeInstances(ctx *gofr.Context, details CloudDetails) ([]models.Resource, error) {
-	switch details.CloudType {
-	case AWS:
-		ec2Client, err := s.aws.NewEC2Client(ctx, details.Creds)
-		if err != nil {
-			return nil, err
-		}
+		s.recordEvent(ctx, &models.Event{ResourceID: res[i].ID, CloudAccountID: res[i].CloudAccount.ID,
+			Type: EventRemoved, FromStatus: res[i].Status, Actor: ActorSync})
 
-		return ec2Client.GetAllInstances(ctx)
-	case GCP:
-		return nil, nil
-	default:
-		// We are not returning any error because the sync process is completely internal, works on the cloud Account ID,
-		// if we are getting an unknown cloud type, then this feature is not implemented and we simply return nil.
-		return nil, nil
+		addSyncCount(counts, res[i].Type, models.SyncCount{Removed: 1})
 	}
 }
 
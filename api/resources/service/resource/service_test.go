@@ -3,8 +3,10 @@ package resource
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"gofr.dev/pkg/gofr"
 	"gofr.dev/pkg/gofr/container"
@@ -14,6 +16,8 @@ import (
 
 	"github.com/zopdev/zopdev/api/resources/client"
 	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp/gke"
 )
 
 func TestService_SyncResources(t *testing.T) {
@@ -32,7 +36,7 @@ func TestService_SyncResources(t *testing.T) {
 		Credentials: map[string]any{"project_id": "test-project", "region": "us-central1"}}
 	mockCreds := &google.Credentials{ProjectID: "test-project"}
 
-	s := New(mGCP, mAWS, mClient, mStore)
+	s := New(mGCP, mAWS, nil, mClient, mStore, &pricing.Catalog{}, nil)
 
 	req := CloudDetails{
 		CloudType: GCP,
@@ -42,7 +46,8 @@ func TestService_SyncResources(t *testing.T) {
 		},
 	}
 	mockInst := []models.Resource{
-		{Name: "sql-instance-1", UID: "zopdev/sql-instance-1", Type: "SQL", Status: "RUNNING"},
+		{Name: "sql-instance-1", UID: "zopdev/sql-instance-1", Type: "SQL", Status: "RUNNING",
+			Labels: models.Labels{"team": "payments"}},
 		{Name: "sql-instance-2", UID: "zopdev/sql-instance-2", Type: "SQL", Status: "SUSPENDED"},
 	}
 	mStrResp := []models.Resource{
@@ -57,13 +62,18 @@ func TestService_SyncResources(t *testing.T) {
 		isError:   false,
 		instances: mockInst,
 	}
+	statuses := models.SyncStatuses{string(SQL): {Status: SyncSucceeded}, string(GCPCOMPUTE): {Status: SyncSucceeded},
+		string(GKENODEPOOL): {Status: SyncSucceeded}, string(CLOUDRUN): {Status: SyncSucceeded},
+		string(CLOUDFUNCTION): {Status: SyncSucceeded}, string(GCPDISK): {Status: SyncSucceeded},
+		string(GCPSNAPSHOT): {Status: SyncSucceeded}, string(GCPADDRESS): {Status: SyncSucceeded},
+		string(MEMORYSTORE): {Status: SyncSucceeded}}
 
 	testCases := []struct {
 		name      string
 		id        int64
 		resources []string
 		expErr    error
-		expResp   []models.Resource
+		expResp   *models.SyncResult
 		mockCalls func()
 	}{
 		{
@@ -71,13 +81,41 @@ func TestService_SyncResources(t *testing.T) {
 			id:        123,
 			resources: []string{},
 			expErr:    nil,
-			expResp:   mStrResp,
+			expResp:   &models.SyncResult{Resources: mStrResp, Statuses: statuses},
 			mockCalls: func() {
 				mClient.EXPECT().GetCloudCredentials(ctx, int64(123)).Return(ca, nil)
 				mGCP.EXPECT().NewGoogleCredentials(ctx, req.Creds, "https://www.googleapis.com/auth/cloud-platform").
-					Return(mockCreds, nil)
+					Return(mockCreds, nil).Times(9)
 				mGCP.EXPECT().NewSQLClient(ctx, option.WithCredentials(mockCreds)).
 					Return(mockLister, nil)
+				mGCP.EXPECT().NewComputeClient(ctx, option.WithCredentials(mockCreds)).
+					Return(&mockComputeClient{}, nil)
+				mGCP.EXPECT().NewGKEClient(ctx, option.WithCredentials(mockCreds)).
+					Return(&mockGKEClient{}, nil)
+				mGCP.EXPECT().NewCloudRunClient(ctx, option.WithCredentials(mockCreds)).
+					Return(&mockCloudRunClient{}, nil)
+				mGCP.EXPECT().NewCloudFunctionsClient(ctx, option.WithCredentials(mockCreds)).
+					Return(&mockCloudFunctionsClient{}, nil)
+				mGCP.EXPECT().NewDiskClient(ctx, option.WithCredentials(mockCreds)).
+					Return(&mockDiskClient{}, nil).Times(2)
+				mGCP.EXPECT().NewAddressClient(ctx, option.WithCredentials(mockCreds)).
+					Return(&mockAddressClient{}, nil)
+				mGCP.EXPECT().NewMemorystoreClient(ctx, option.WithCredentials(mockCreds)).
+					Return(&mockSQLClient{}, nil)
+				mStore.EXPECT().InsertSyncRun(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, run *models.SyncRun) error {
+					run.ID = 7
+
+					return nil
+				})
+				mStore.EXPECT().CompleteSyncRun(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, run *models.SyncRun) error {
+					assert.Equal(t, int64(7), run.ID)
+					assert.NotNil(t, run.FinishedAt)
+					assert.Equal(t, models.SyncCounts{string(SQL): {Added: 1, Updated: 1, Removed: 1}}, run.Counts)
+					assert.Equal(t, statuses, run.Statuses)
+					assert.Empty(t, run.Errors)
+
+					return nil
+				})
 				gomock.InOrder(
 					mStore.EXPECT().GetResources(gomock.Any(), int64(123), nil).
 						Return([]models.Resource{
@@ -87,8 +125,20 @@ func TestService_SyncResources(t *testing.T) {
 								Name: "sql-instance-3", Type: string(SQL), UID: "zopdev/sql-instance-3"},
 						}, nil),
 					mStore.EXPECT().UpdateStatus(gomock.Any(), RUNNING, int64(1)).Return(nil),
-					mStore.EXPECT().InsertResource(gomock.Any(), gomock.Any()).Return(nil),
-					mStore.EXPECT().RemoveResource(gomock.Any(), gomock.Any()).Return(nil),
+					mStore.EXPECT().InsertEvent(gomock.Any(), &models.Event{ResourceID: 1, CloudAccountID: 123,
+						Type: EventStatusChanged, ToStatus: RUNNING, Actor: ActorSync}).Return(nil),
+					mStore.EXPECT().UpdateLabels(gomock.Any(), int64(1), models.Labels{"team": "payments"}).Return(nil),
+					mStore.EXPECT().InsertResource(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ *gofr.Context, res *models.Resource) error {
+							res.ID = 3
+
+							return nil
+						}),
+					mStore.EXPECT().InsertEvent(gomock.Any(), &models.Event{ResourceID: 3, CloudAccountID: 123,
+						Type: EventCreated, ToStatus: "SUSPENDED", Actor: ActorSync}).Return(nil),
+					mStore.EXPECT().RemoveResource(gomock.Any(), int64(2)).Return(nil),
+					mStore.EXPECT().InsertEvent(gomock.Any(), &models.Event{ResourceID: 2, CloudAccountID: 123,
+						Type: EventRemoved, Actor: ActorSync}).Return(nil),
 					mStore.EXPECT().GetResources(gomock.Any(), int64(123), nil).Return([]models.Resource{
 						{ID: 1, CloudAccount: models.CloudAccount{ID: 123, Type: string(GCP)},
 							Name: "sql-instance-1", Type: string(SQL), UID: "zopdev/sql-instance-1", Status: "RUNNING"},
@@ -119,11 +169,35 @@ func TestService_SyncResources_Errors(t *testing.T) {
 
 	mClient := NewMockHTTPClient(ctrl)
 	mGCP := NewMockGCPClient(ctrl)
+	mStore := NewMockStore(ctrl)
 	ct, _ := container.NewMockContainer(t)
 	ctx := &gofr.Context{Context: context.Background(), Container: ct}
 	ca := &client.CloudAccount{ID: 123, Name: "MyCloud", Provider: string(GCP),
 		Credentials: map[string]any{"project_id": "test-project", "region": "us-central1"}}
-	s := New(mGCP, nil, mClient, nil)
+	s := New(mGCP, nil, nil, mClient, mStore, &pricing.Catalog{}, nil)
+	statuses := models.SyncStatuses{
+		string(SQL):           {Status: SyncFailed, Error: errMock.Error()},
+		string(GCPCOMPUTE):    {Status: SyncFailed, Error: errMock.Error()},
+		string(GKENODEPOOL):   {Status: SyncFailed, Error: errMock.Error()},
+		string(CLOUDRUN):      {Status: SyncFailed, Error: errMock.Error()},
+		string(CLOUDFUNCTION): {Status: SyncFailed, Error: errMock.Error()},
+		string(GCPDISK):       {Status: SyncFailed, Error: errMock.Error()},
+		string(GCPSNAPSHOT):   {Status: SyncFailed, Error: errMock.Error()},
+		string(GCPADDRESS):    {Status: SyncFailed, Error: errMock.Error()},
+		string(MEMORYSTORE):   {Status: SyncFailed, Error: errMock.Error()},
+	}
+	expectFailedRun := func() {
+		mStore.EXPECT().InsertSyncRun(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, run *models.SyncRun) error {
+			run.ID = 7
+
+			return nil
+		})
+		mStore.EXPECT().CompleteSyncRun(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, run *models.SyncRun) error {
+			assert.Equal(t, statuses, run.Statuses)
+
+			return nil
+		})
+	}
 	req := CloudDetails{
 		CloudType: GCP,
 		Creds: map[string]any{
@@ -137,18 +211,19 @@ func TestService_SyncResources_Errors(t *testing.T) {
 		id        int64
 		resources []string
 		expErr    error
-		expResp   []models.Resource
+		expResp   *models.SyncResult
 		mockCalls func()
 	}{
 		{
 			name:      "error getting SQL resources",
 			id:        123,
 			resources: []string{string(SQL)},
-			expErr:    errMock,
+			expErr:    &ErrSyncFailed{CloudAccountID: 123, Statuses: statuses},
 			mockCalls: func() {
 				mClient.EXPECT().GetCloudCredentials(ctx, int64(123)).Return(ca, nil)
 				mGCP.EXPECT().NewGoogleCredentials(ctx, req.Creds, "https://www.googleapis.com/auth/cloud-platform").
-					Return(nil, errMock)
+					Return(nil, errMock).Times(9)
+				expectFailedRun()
 			},
 		},
 		{
@@ -160,14 +235,33 @@ func TestService_SyncResources_Errors(t *testing.T) {
 			},
 		},
 		{
-			name:      "error from GetAllInstances",
+			name:      "error from GetResources",
 			id:        123,
 			resources: []string{},
 			expErr:    errMock,
 			mockCalls: func() {
 				mClient.EXPECT().GetCloudCredentials(ctx, int64(123)).Return(ca, nil)
 				mGCP.EXPECT().NewGoogleCredentials(ctx, req.Creds, "https://www.googleapis.com/auth/cloud-platform").
-					Return(nil, errMock)
+					Return(&google.Credentials{ProjectID: "test-project"}, nil).Times(9)
+				mGCP.EXPECT().NewSQLClient(ctx, gomock.Any()).Return(&mockSQLClient{}, nil)
+				mGCP.EXPECT().NewComputeClient(ctx, gomock.Any()).Return(&mockComputeClient{}, nil)
+				mGCP.EXPECT().NewGKEClient(ctx, gomock.Any()).Return(&mockGKEClient{}, nil)
+				mGCP.EXPECT().NewCloudRunClient(ctx, gomock.Any()).Return(&mockCloudRunClient{}, nil)
+				mGCP.EXPECT().NewCloudFunctionsClient(ctx, gomock.Any()).Return(&mockCloudFunctionsClient{}, nil)
+				mGCP.EXPECT().NewDiskClient(ctx, gomock.Any()).Return(&mockDiskClient{}, nil).Times(2)
+				mGCP.EXPECT().NewAddressClient(ctx, gomock.Any()).Return(&mockAddressClient{}, nil)
+				mGCP.EXPECT().NewMemorystoreClient(ctx, gomock.Any()).Return(&mockSQLClient{}, nil)
+				mStore.EXPECT().InsertSyncRun(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, run *models.SyncRun) error {
+					run.ID = 7
+
+					return nil
+				})
+				mStore.EXPECT().GetResources(ctx, int64(123), nil).Return(nil, errMock)
+				mStore.EXPECT().CompleteSyncRun(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, run *models.SyncRun) error {
+					assert.Equal(t, models.SyncErrors{errMock.Error()}, run.Errors)
+
+					return nil
+				})
 			},
 		},
 	}
@@ -184,6 +278,122 @@ func TestService_SyncResources_Errors(t *testing.T) {
 	}
 }
 
+func TestService_SyncResources_PartialFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mClient := NewMockHTTPClient(ctrl)
+	mStore := NewMockStore(ctrl)
+	mGCP := NewMockGCPClient(ctrl)
+	ct, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{Context: context.Background(), Container: ct}
+	ca := &client.CloudAccount{ID: 123, Provider: string(GCP)}
+	mockCreds := &google.Credentials{ProjectID: "test-project"}
+	s := New(mGCP, nil, nil, mClient, mStore, &pricing.Catalog{}, nil)
+	stored := []models.Resource{
+		{ID: 1, CloudAccount: models.CloudAccount{ID: 123}, Type: string(SQL), UID: "p/sql-1", Status: RUNNING},
+		{ID: 2, CloudAccount: models.CloudAccount{ID: 123}, Type: string(SQL), UID: "p/sql-2", Status: RUNNING},
+		{ID: 3, CloudAccount: models.CloudAccount{ID: 123}, Type: string(GCPCOMPUTE), UID: "p/vm-1", Status: RUNNING},
+	}
+	statuses := models.SyncStatuses{
+		string(SQL):           {Status: SyncSucceeded},
+		string(GCPCOMPUTE):    {Status: SyncFailed, Error: errMock.Error()},
+		string(GKENODEPOOL):   {Status: SyncSucceeded},
+		string(CLOUDRUN):      {Status: SyncSucceeded},
+		string(CLOUDFUNCTION): {Status: SyncSucceeded},
+		string(GCPDISK):       {Status: SyncSucceeded},
+		string(GCPSNAPSHOT):   {Status: SyncSucceeded},
+		string(GCPADDRESS):    {Status: SyncSucceeded},
+		string(MEMORYSTORE):   {Status: SyncSucceeded},
+	}
+
+	mClient.EXPECT().GetCloudCredentials(ctx, int64(123)).Return(ca, nil)
+	mGCP.EXPECT().NewGoogleCredentials(ctx, gomock.Any(), "https://www.googleapis.com/auth/cloud-platform").
+		Return(mockCreds, nil).Times(9)
+	mGCP.EXPECT().NewSQLClient(ctx, gomock.Any()).Return(&mockSQLClient{instances: []models.Resource{
+		{Type: string(SQL), UID: "p/sql-1", Status: RUNNING},
+	}}, nil)
+	mGCP.EXPECT().NewComputeClient(ctx, gomock.Any()).Return(&mockComputeClient{isError: true}, nil)
+	mGCP.EXPECT().NewGKEClient(ctx, gomock.Any()).Return(&mockGKEClient{}, nil)
+	mGCP.EXPECT().NewCloudRunClient(ctx, gomock.Any()).Return(&mockCloudRunClient{}, nil)
+	mGCP.EXPECT().NewCloudFunctionsClient(ctx, gomock.Any()).Return(&mockCloudFunctionsClient{}, nil)
+	mGCP.EXPECT().NewDiskClient(ctx, gomock.Any()).Return(&mockDiskClient{}, nil).Times(2)
+	mGCP.EXPECT().NewAddressClient(ctx, gomock.Any()).Return(&mockAddressClient{}, nil)
+	mGCP.EXPECT().NewMemorystoreClient(ctx, gomock.Any()).Return(&mockSQLClient{}, nil)
+	mStore.EXPECT().InsertSyncRun(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, run *models.SyncRun) error {
+		run.ID = 7
+
+		return nil
+	})
+	mStore.EXPECT().GetResources(ctx, int64(123), nil).Return(stored, nil).Times(2)
+	// The unchanged resource is not written and the compute instance whose listing failed is not removed.
+	mStore.EXPECT().RemoveResource(ctx, int64(2)).Return(nil)
+	mStore.EXPECT().InsertEvent(ctx, &models.Event{ResourceID: 2, CloudAccountID: 123, Type: EventRemoved,
+		FromStatus: RUNNING, Actor: ActorSync}).Return(nil)
+	mStore.EXPECT().CompleteSyncRun(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, run *models.SyncRun) error {
+		assert.Equal(t, models.SyncCounts{string(SQL): {Removed: 1}}, run.Counts)
+		assert.Equal(t, statuses, run.Statuses)
+
+		return nil
+	})
+
+	res, err := s.SyncResources(ctx, 123)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.SyncResult{Resources: stored, Statuses: statuses}, res)
+}
+
+func TestService_UpdateResource_Transitional(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mStore := NewMockStore(ctrl)
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	s := New(nil, nil, nil, nil, mStore, &pricing.Catalog{}, nil)
+	listed := &models.Resource{Name: "vm-1", UID: "vm-1", Status: STOPPED}
+
+	testCases := []struct {
+		name       string
+		expUpdated bool
+		mockCalls  func()
+	}{
+		{
+			name: "Operation in progress",
+			mockCalls: func() {
+				mStore.EXPECT().HasOperation(ctx, int64(1), OperationInProgress).Return(true, nil)
+			},
+		},
+		{
+			name:       "Stopped outside zopdev",
+			expUpdated: true,
+			mockCalls: func() {
+				mStore.EXPECT().HasOperation(ctx, int64(1), OperationInProgress).Return(false, nil)
+				mStore.EXPECT().UpdateStatus(ctx, STOPPED, int64(1)).Return(nil)
+				mStore.EXPECT().InsertEvent(ctx, &models.Event{ResourceID: 1, CloudAccountID: 123,
+					Type: EventStatusChanged, FromStatus: STOPPING, ToStatus: STOPPED, Actor: ActorSync}).Return(nil)
+			},
+		},
+		{
+			name: "Error - HasOperation",
+			mockCalls: func() {
+				mStore.EXPECT().HasOperation(ctx, int64(1), OperationInProgress).Return(false, errMock)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCalls()
+
+			stored := &models.Resource{ID: 1, CloudAccount: models.CloudAccount{ID: 123}, Name: "vm-1", UID: "vm-1",
+				Status: STOPPING}
+
+			assert.Equal(t, tc.expUpdated, s.updateResource(ctx, stored, listed))
+		})
+	}
+}
+
 func TestService_ChangeState(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -192,12 +402,13 @@ func TestService_ChangeState(t *testing.T) {
 	mStore := NewMockStore(ctrl)
 	mGCP := NewMockGCPClient(ctrl)
 	mAWS := NewMockAWSClient(ctrl)
-	ctx := &gofr.Context{Context: context.Background()}
+	mockContainer, _ := container.NewMockContainer(t)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
 	ca := &client.CloudAccount{ID: 123, Name: "MyCloud", Provider: string(GCP),
 		Credentials: map[string]any{"project_id": "test-project", "region": "us-central1"}}
 	mockCreds := &google.Credentials{ProjectID: "test-project"}
 	mockStopper := &mockSQLClient{}
-	s := New(mGCP, mAWS, mClient, mStore)
+	s := New(mGCP, mAWS, nil, mClient, mStore, &pricing.Catalog{}, nil)
 
 	testCases := []struct {
 		name      string
@@ -206,8 +417,9 @@ func TestService_ChangeState(t *testing.T) {
 		mockCalls func()
 	}{
 		{
-			name:  "Success - Start",
-			input: ResourceDetails{ID: 1, CloudAccID: 123, Name: "test-instance", Type: SQL, State: START},
+			name: "Success - Start",
+			input: ResourceDetails{ID: 1, CloudAccID: 123, Name: "test-instance", Type: SQL, State: START,
+				RequestedBy: "jane"},
 			mockCalls: func() {
 				mStore.EXPECT().GetResourceByID(ctx, int64(1)).
 					Return(&models.Resource{ID: 1, CloudAccount: models.CloudAccount{ID: 1}, Status: STOPPED}, nil)
@@ -217,8 +429,14 @@ func TestService_ChangeState(t *testing.T) {
 					Return(mockCreds, nil)
 				mGCP.EXPECT().NewSQLClient(ctx, option.WithCredentials(mockCreds)).
 					Return(mockStopper, nil)
-				mStore.EXPECT().UpdateStatus(ctx, RUNNING, int64(1)).
+				mStore.EXPECT().InsertOperation(ctx, &models.Operation{ResourceID: 1, CloudAccountID: 123,
+					Action: "START", TargetState: RUNNING, Status: OperationInProgress}).Return(nil)
+				mStore.EXPECT().UpdateStatus(ctx, STARTING, int64(1)).
+					Return(nil)
+				mStore.EXPECT().InsertEvent(ctx, &models.Event{ResourceID: 1, CloudAccountID: 123,
+					Type: EventStateChangeRequested, FromStatus: STOPPED, ToStatus: RUNNING, Actor: "jane"}).
 					Return(nil)
+				mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(nil, nil)
 			},
 		},
 		{
@@ -227,13 +445,167 @@ func TestService_ChangeState(t *testing.T) {
 			mockCalls: func() {
 				mStore.EXPECT().GetResourceByID(ctx, int64(1)).
 					Return(&models.Resource{ID: 1, CloudAccount: models.CloudAccount{ID: 1}, Status: RUNNING}, nil)
+				mStore.EXPECT().GetActiveLock(ctx, int64(1), gomock.Any()).Return(nil, nil)
+				mStore.EXPECT().GetActiveBlackout(ctx, int64(1), gomock.Any()).Return(nil, nil)
 				mClient.EXPECT().GetCloudCredentials(ctx, int64(123)).Return(ca, nil)
 				mGCP.EXPECT().NewGoogleCredentials(ctx, gomock.Any(), "https://www.googleapis.com/auth/cloud-platform").
 					Return(mockCreds, nil)
 				mGCP.EXPECT().NewSQLClient(ctx, option.WithCredentials(mockCreds)).
 					Return(mockStopper, nil)
-				mStore.EXPECT().UpdateStatus(ctx, STOPPED, int64(1)).
+				mStore.EXPECT().InsertOperation(ctx, &models.Operation{ResourceID: 1, CloudAccountID: 123,
+					Action: "SUSPEND", TargetState: STOPPED, Status: OperationInProgress}).Return(nil)
+				mStore.EXPECT().UpdateStatus(ctx, STOPPING, int64(1)).
 					Return(nil)
+				mStore.EXPECT().InsertEvent(ctx, gomock.Any()).Return(nil)
+				mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(nil, nil)
+				mStore.EXPECT().InsertSavings(ctx, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:  "Success - Start GCE instance",
+			input: ResourceDetails{ID: 2, CloudAccID: 123, Name: "vm-1", Type: GCPCOMPUTE, State: START},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+					Return(&models.Resource{ID: 2, Name: "vm-1", Status: STOPPED,
+						Settings: models.Settings{"zone": "us-central1-a"}}, nil)
+				mClient.EXPECT().GetCloudCredentials(ctx, int64(123)).Return(ca, nil)
+				mGCP.EXPECT().NewGoogleCredentials(ctx, gomock.Any(), "https://www.googleapis.com/auth/cloud-platform").
+					Return(mockCreds, nil)
+				mGCP.EXPECT().NewComputeClient(ctx, option.WithCredentials(mockCreds)).
+					Return(&mockComputeClient{}, nil)
+				mStore.EXPECT().InsertOperation(ctx, &models.Operation{ResourceID: 2, CloudAccountID: 123,
+					Action: "START", TargetState: RUNNING, Status: OperationInProgress}).Return(nil)
+				mStore.EXPECT().UpdateStatus(ctx, STARTING, int64(2)).
+					Return(nil)
+				mStore.EXPECT().InsertEvent(ctx, gomock.Any()).Return(nil)
+				mStore.EXPECT().GetOpenSavings(ctx, int64(2)).Return(nil, nil)
+			},
+		},
+		{
+			name:  "Success - Suspend GKE node pool records its size",
+			input: ResourceDetails{ID: 3, CloudAccID: 123, Name: "dev/pool-1", Type: GKENODEPOOL, State: SUSPEND},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(3)).
+					Return(&models.Resource{ID: 3, Name: "dev/pool-1", Status: RUNNING, Settings: models.Settings{
+						"cluster": "dev", "location": "us-central1-a", "node_pool": "pool-1"}}, nil)
+				mStore.EXPECT().GetActiveLock(ctx, int64(3), gomock.Any()).Return(nil, nil)
+				mStore.EXPECT().GetActiveBlackout(ctx, int64(3), gomock.Any()).Return(nil, nil)
+				mClient.EXPECT().GetCloudCredentials(ctx, int64(123)).Return(ca, nil)
+				mGCP.EXPECT().NewGoogleCredentials(ctx, gomock.Any(), "https://www.googleapis.com/auth/cloud-platform").
+					Return(mockCreds, nil)
+				mGCP.EXPECT().NewGKEClient(ctx, option.WithCredentials(mockCreds)).
+					Return(&mockGKEClient{size: &gke.Size{NodeCount: 3}}, nil)
+				mStore.EXPECT().UpdateSettings(ctx, models.Settings{"cluster": "dev", "location": "us-central1-a",
+					"node_pool": "pool-1", "suspended_size": &gke.Size{NodeCount: 3}}, int64(3)).Return(nil)
+				mStore.EXPECT().InsertOperation(ctx, gomock.Any()).Return(nil)
+				mStore.EXPECT().UpdateStatus(ctx, STOPPING, int64(3)).Return(nil)
+				mStore.EXPECT().InsertEvent(ctx, gomock.Any()).Return(nil)
+				mStore.EXPECT().GetOpenSavings(ctx, int64(3)).Return(nil, nil)
+				mStore.EXPECT().InsertSavings(ctx, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:   "Error - GCE instance without zone",
+			input:  ResourceDetails{ID: 2, CloudAccID: 123, Name: "vm-1", Type: GCPCOMPUTE, State: SUSPEND},
+			expErr: gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.zone"}},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+					Return(&models.Resource{ID: 2, Name: "vm-1", Status: RUNNING}, nil)
+				mStore.EXPECT().GetActiveLock(ctx, int64(2), gomock.Any()).Return(nil, nil)
+				mStore.EXPECT().GetActiveBlackout(ctx, int64(2), gomock.Any()).Return(nil, nil)
+				mClient.EXPECT().GetCloudCredentials(ctx, int64(123)).Return(ca, nil)
+				mStore.EXPECT().InsertOperation(ctx, gomock.Any()).
+					DoAndReturn(func(_ *gofr.Context, op *models.Operation) error {
+						assert.Equal(t, OperationFailed, op.Status)
+						assert.NotEmpty(t, op.Error)
+						assert.NotNil(t, op.CompletedAt)
+
+						return nil
+					})
+				mStore.EXPECT().InsertEvent(ctx, gomock.Any()).
+					DoAndReturn(func(_ *gofr.Context, event *models.Event) error {
+						assert.Equal(t, EventStateChangeRequested, event.Type)
+						assert.NotEmpty(t, event.Message)
+
+						return nil
+					})
+			},
+		},
+		{
+			name:   "Error - Operation in progress",
+			input:  ResourceDetails{ID: 2, CloudAccID: 123, Name: "vm-1", Type: GCPCOMPUTE, State: START},
+			expErr: &ErrOperationInProgress{ResourceID: 2},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+					Return(&models.Resource{ID: 2, Name: "vm-1", Status: STOPPING}, nil)
+				mStore.EXPECT().HasOperation(ctx, int64(2), OperationInProgress).Return(true, nil)
+			},
+		},
+		{
+			name:  "Success - Start resource stopping outside zopdev",
+			input: ResourceDetails{ID: 1, CloudAccID: 123, Name: "test-instance", Type: SQL, State: START},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(1)).
+					Return(&models.Resource{ID: 1, CloudAccount: models.CloudAccount{ID: 1}, Status: STOPPING}, nil)
+				mStore.EXPECT().HasOperation(ctx, int64(1), OperationInProgress).Return(false, nil)
+				mClient.EXPECT().GetCloudCredentials(ctx, int64(123)).Return(ca, nil)
+				mGCP.EXPECT().NewGoogleCredentials(ctx, gomock.Any(), "https://www.googleapis.com/auth/cloud-platform").
+					Return(mockCreds, nil)
+				mGCP.EXPECT().NewSQLClient(ctx, option.WithCredentials(mockCreds)).
+					Return(mockStopper, nil)
+				mStore.EXPECT().InsertOperation(ctx, &models.Operation{ResourceID: 1, CloudAccountID: 123,
+					Action: "START", TargetState: RUNNING, Status: OperationInProgress}).Return(nil)
+				mStore.EXPECT().UpdateStatus(ctx, STARTING, int64(1)).Return(nil)
+				mStore.EXPECT().InsertEvent(ctx, gomock.Any()).Return(nil)
+				mStore.EXPECT().GetOpenSavings(ctx, int64(1)).Return(nil, nil)
+			},
+		},
+		{
+			name:   "Error - HasOperation",
+			input:  ResourceDetails{ID: 2, CloudAccID: 123, Name: "vm-1", Type: GCPCOMPUTE, State: START},
+			expErr: errMock,
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+					Return(&models.Resource{ID: 2, Name: "vm-1", Status: STARTING}, nil)
+				mStore.EXPECT().HasOperation(ctx, int64(2), OperationInProgress).Return(false, errMock)
+			},
+		},
+		{
+			name:  "Error - Suspend resource kept awake",
+			input: ResourceDetails{ID: 1, CloudAccID: 123, Name: "test-instance", Type: SQL, State: SUSPEND},
+			expErr: &ErrResourceLocked{ResourceID: 1, Until: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				Author: "jane", Reason: "demo"},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(1)).
+					Return(&models.Resource{ID: 1, CloudAccount: models.CloudAccount{ID: 1}, Status: RUNNING}, nil)
+				mStore.EXPECT().GetActiveLock(ctx, int64(1), gomock.Any()).
+					Return(&models.Lock{ID: 5, GroupID: 7, Until: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+						Author: "jane", Reason: "demo"}, nil)
+			},
+		},
+		{
+			name:  "Error - Suspend resource during blackout",
+			input: ResourceDetails{ID: 1, CloudAccID: 123, Name: "test-instance", Type: SQL, State: SUSPEND},
+			expErr: &ErrBlackout{ResourceID: 1, Calendar: "quarter-end", Period: "Q3 close",
+				Until: time.Date(2030, 10, 3, 0, 0, 0, 0, time.UTC)},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(1)).
+					Return(&models.Resource{ID: 1, CloudAccount: models.CloudAccount{ID: 1}, Status: RUNNING}, nil)
+				mStore.EXPECT().GetActiveLock(ctx, int64(1), gomock.Any()).Return(nil, nil)
+				mStore.EXPECT().GetActiveBlackout(ctx, int64(1), gomock.Any()).
+					Return(&models.Calendar{ID: 4, Name: "quarter-end", Periods: []models.CalendarPeriod{{
+						Name: "Q3 close", Start: time.Date(2030, 9, 28, 0, 0, 0, 0, time.UTC),
+						End: time.Date(2030, 10, 3, 0, 0, 0, 0, time.UTC)}}}, nil)
+			},
+		},
+		{
+			name:   "Error - GetActiveLock",
+			input:  ResourceDetails{ID: 1, CloudAccID: 123, Name: "test-instance", Type: SQL, State: SUSPEND},
+			expErr: errMock,
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(1)).
+					Return(&models.Resource{ID: 1, CloudAccount: models.CloudAccount{ID: 1}, Status: RUNNING}, nil)
+				mStore.EXPECT().GetActiveLock(ctx, int64(1), gomock.Any()).Return(nil, errMock)
 			},
 		},
 		{
@@ -0,0 +1,61 @@
+package resource
+
+import (
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// maxSyncRuns is the number of most recent sync runs returned for a cloud account.
+const maxSyncRuns = 100
+
+// GetSyncRuns returns the most recent sync runs of a cloud account, the most recent run first.
+func (s *Service) GetSyncRuns(ctx *gofr.Context, id int64) ([]models.SyncRun, error) {
+	return s.store.GetSyncRuns(ctx, id, maxSyncRuns)
+}
+
+// startSyncRun records the start of a sync. The sync runs are best effort, a failure to record them is logged and
+// does not fail the sync.
+func (s *Service) startSyncRun(ctx *gofr.Context, run *models.SyncRun) {
+	err := s.store.InsertSyncRun(ctx, run)
+	if err != nil {
+		ctx.Errorf("failed to record sync run of cloud account %d: %v", run.CloudAccountID, err)
+	}
+}
+
+func (s *Service) completeSyncRun(ctx *gofr.Context, run *models.SyncRun) {
+	if run.ID == 0 {
+		return
+	}
+
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+
+	err := s.store.CompleteSyncRun(ctx, run)
+	if err != nil {
+		ctx.Errorf("failed to complete sync run %d: %v", run.ID, err)
+	}
+}
+
+func addSyncCount(counts models.SyncCounts, resourceType string, delta models.SyncCount) {
+	c := counts[resourceType]
+	c.Added += delta.Added
+	c.Updated += delta.Updated
+	c.Removed += delta.Removed
+	counts[resourceType] = c
+}
+
+// failedTypes returns the resource types whose lister failed.
+func failedTypes(statuses models.SyncStatuses) map[string]bool {
+	failed := make(map[string]bool)
+
+	for t, status := range statuses {
+		if status.Status == SyncFailed {
+			failed[t] = true
+		}
+	}
+
+	return failed
+}
@@ -0,0 +1,56 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
+)
+
+func TestService_GetSyncRuns(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background()}
+	s := New(nil, nil, nil, nil, mStore, &pricing.Catalog{}, nil)
+	runs := []models.SyncRun{{ID: 1, CloudAccountID: 3, StartedAt: time.Now(),
+		Counts: models.SyncCounts{string(SQL): {Added: 1}}}}
+
+	mStore.EXPECT().GetSyncRuns(ctx, int64(3), maxSyncRuns).Return(runs, nil)
+
+	res, err := s.GetSyncRuns(ctx, 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, runs, res)
+}
+
+func TestService_syncRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	s := New(nil, nil, nil, nil, mStore, &pricing.Catalog{}, nil)
+	run := &models.SyncRun{CloudAccountID: 3}
+
+	// A run that could not be recorded is not completed, the failures are only logged.
+	mStore.EXPECT().InsertSyncRun(ctx, run).Return(errMock)
+
+	s.startSyncRun(ctx, run)
+	s.completeSyncRun(ctx, run)
+
+	run.ID = 5
+	mStore.EXPECT().CompleteSyncRun(ctx, run).Return(errMock)
+
+	s.completeSyncRun(ctx, run)
+	assert.NotNil(t, run.FinishedAt)
+}
@@ -0,0 +1,336 @@
+package resource
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/providers/gcp"
+)
+
+const (
+	// deleteTokenTTL is the time during which a delete confirmation token is valid.
+	deleteTokenTTL = 5 * time.Minute
+	// confirmKeySize is the size of the random key that signs the delete confirmation tokens when none is configured.
+	confirmKeySize = 32
+)
+
+// ConfirmDelete issues the token that confirms the deletion of a waste candidate, e.g. an unattached disk. The token
+// is only valid for the resource and expires after deleteTokenTTL.
+func (s *Service) ConfirmDelete(ctx *gofr.Context, cloudAccID, resourceID int64) (*models.DeleteConfirmation, error) {
+	res, err := s.store.GetResourceByID(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil || res.CloudAccount.ID != cloudAccID {
+		return nil, gofrHttp.ErrorEntityNotFound{Name: "resource"}
+	}
+
+	ca, err := s.http.GetCloudCredentials(ctx, cloudAccID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := s.drivers.get(CloudProvider(strings.ToUpper(ca.Provider)), ResourceType(res.Type)).(Deleter); !ok {
+		return nil, &ErrNotDeletable{ResourceID: res.ID, Type: res.Type}
+	}
+
+	expiresAt := time.Now().Add(deleteTokenTTL).Truncate(time.Second)
+
+	return &models.DeleteConfirmation{ResourceID: res.ID, Token: s.deleteToken(res, expiresAt),
+		ExpiresAt: expiresAt}, nil
+}
+
+// Delete deletes a waste candidate from the cloud provider and removes it from the store. The deletion is guarded:
+// the token issued by ConfirmDelete must be valid, and the resource is described against the cloud provider so that
+// a resource attached since the last sync is not deleted.
+func (s *Service) Delete(ctx *gofr.Context, cloudAccID, resourceID int64, req *models.DeleteRequest) error {
+	res, err := s.store.GetResourceByID(ctx, resourceID)
+	if err != nil {
+		return err
+	}
+
+	if res == nil || res.CloudAccount.ID != cloudAccID {
+		return gofrHttp.ErrorEntityNotFound{Name: "resource"}
+	}
+
+	if req.Token == "" {
+		return gofrHttp.ErrorMissingParam{Params: []string{"token"}}
+	}
+
+	if !s.validDeleteToken(req.Token, res) {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"token"}}
+	}
+
+	ca, err := s.http.GetCloudCredentials(ctx, cloudAccID)
+	if err != nil {
+		return err
+	}
+
+	drv := s.drivers.get(CloudProvider(strings.ToUpper(ca.Provider)), ResourceType(res.Type))
+
+	d, ok := drv.(Deleter)
+	if !ok {
+		return &ErrNotDeletable{ResourceID: res.ID, Type: res.Type}
+	}
+
+	current, err := describe(ctx, drv, ca.Credentials, res)
+	if err != nil {
+		ctx.Errorf("failed to describe %s resource %d: %v", res.Type, res.ID, err)
+
+		return err
+	}
+
+	if current.Status == ATTACHED {
+		return &ErrResourceInUse{ResourceID: res.ID}
+	}
+
+	err = d.Delete(ctx, ca.Credentials, res)
+	if err != nil {
+		ctx.Errorf("failed to delete %s resource %d: %v", res.Type, res.ID, err)
+
+		return err
+	}
+
+	if er := s.store.RemoveResource(ctx, res.ID); er != nil {
+		ctx.Errorf("failed to remove deleted resource %d: %v", res.ID, er)
+	}
+
+	s.recordEvent(ctx, &models.Event{ResourceID: res.ID, CloudAccountID: cloudAccID, Type: EventDeleted,
+		FromStatus: current.Status, Actor: req.RequestedBy})
+
+	return nil
+}
+
+// deleteToken signs the ID and the UID of a resource with the expiry of the token. The token is the expiry in Unix
+// seconds followed by the signature.
+func (s *Service) deleteToken(res *models.Resource, expiresAt time.Time) string {
+	expiry := strconv.FormatInt(expiresAt.Unix(), 10)
+
+	mac := hmac.New(sha256.New, s.confirmKey)
+	_, _ = mac.Write([]byte(strconv.FormatInt(res.ID, 10) + "/" + res.UID + "/" + expiry))
+
+	return expiry + "." + hex.EncodeToString(mac.Sum(nil))
+}
+
+// validDeleteToken reports whether a token was issued for the resource and has not expired.
+func (s *Service) validDeleteToken(token string, res *models.Resource) bool {
+	expiry, _, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+
+	unix, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	expiresAt := time.Unix(unix, 0)
+	if time.Now().After(expiresAt) {
+		return false
+	}
+
+	return hmac.Equal([]byte(token), []byte(s.deleteToken(res, expiresAt)))
+}
+
+// gcpDiskClient creates the client of the Compute Engine disks and snapshots with the credentials of the account.
+func gcpDiskClient(ctx *gofr.Context, gcpClient GCPClient, creds any) (gcp.DiskClient, *google.Credentials, error) {
+	c, err := gcpClient.NewGoogleCredentials(ctx, creds, cloudPlatformScope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cl, err := gcpClient.NewDiskClient(ctx, option.WithCredentials(c))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cl, c, nil
+}
+
+// gcpDiskDriver manages Compute Engine persistent disks.
+type gcpDiskDriver struct {
+	inventoryDriver
+
+	gcp GCPClient
+}
+
+func (d *gcpDiskDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, c, err := gcpDiskClient(ctx, d.gcp, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllDisks(ctx, c.ProjectID)
+}
+
+func (d *gcpDiskDriver) Delete(ctx *gofr.Context, creds any, res *models.Resource) error {
+	// Zonal disks have a zone and regional disks a region.
+	zone, _ := res.Settings["zone"].(string)
+	region, _ := res.Settings["region"].(string)
+
+	if zone == "" && region == "" {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.zone"}}
+	}
+
+	cl, c, err := gcpDiskClient(ctx, d.gcp, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.DeleteDisk(ctx, c.ProjectID, zone, region, res.Name)
+}
+
+// gcpSnapshotDriver manages Compute Engine disk snapshots.
+type gcpSnapshotDriver struct {
+	inventoryDriver
+
+	gcp GCPClient
+}
+
+func (d *gcpSnapshotDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, c, err := gcpDiskClient(ctx, d.gcp, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllSnapshots(ctx, c.ProjectID)
+}
+
+func (d *gcpSnapshotDriver) Delete(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, c, err := gcpDiskClient(ctx, d.gcp, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.DeleteSnapshot(ctx, c.ProjectID, res.Name)
+}
+
+// gcpAddressDriver manages reserved static external IP addresses.
+type gcpAddressDriver struct {
+	inventoryDriver
+
+	gcp GCPClient
+}
+
+func (d *gcpAddressDriver) client(ctx *gofr.Context, creds any) (gcp.AddressClient, *google.Credentials, error) {
+	c, err := d.gcp.NewGoogleCredentials(ctx, creds, cloudPlatformScope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cl, err := d.gcp.NewAddressClient(ctx, option.WithCredentials(c))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cl, c, nil
+}
+
+func (d *gcpAddressDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllAddresses(ctx, c.ProjectID)
+}
+
+func (d *gcpAddressDriver) Delete(ctx *gofr.Context, creds any, res *models.Resource) error {
+	region, ok := res.Settings["region"].(string)
+	if !ok {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.region"}}
+	}
+
+	cl, c, err := d.client(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.DeleteAddress(ctx, c.ProjectID, region, res.Name)
+}
+
+// ebsVolumeDriver manages EBS volumes.
+type ebsVolumeDriver struct {
+	inventoryDriver
+
+	aws AWSClient
+}
+
+func (d *ebsVolumeDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, err := d.aws.NewEBSClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllVolumes(ctx)
+}
+
+func (d *ebsVolumeDriver) Delete(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, err := d.aws.NewEBSClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.DeleteVolume(ctx, res.Region, res.UID)
+}
+
+// ebsSnapshotDriver manages the EBS snapshots owned by the account.
+type ebsSnapshotDriver struct {
+	inventoryDriver
+
+	aws AWSClient
+}
+
+func (d *ebsSnapshotDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, err := d.aws.NewEBSClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllSnapshots(ctx)
+}
+
+func (d *ebsSnapshotDriver) Delete(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, err := d.aws.NewEBSClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.DeleteSnapshot(ctx, res.Region, res.UID)
+}
+
+// elasticIPDriver manages Elastic IP addresses, an address is deleted by releasing it.
+type elasticIPDriver struct {
+	inventoryDriver
+
+	aws AWSClient
+}
+
+func (d *elasticIPDriver) List(ctx *gofr.Context, creds any) ([]models.Resource, error) {
+	cl, err := d.aws.NewElasticIPClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return cl.GetAllAddresses(ctx)
+}
+
+func (d *elasticIPDriver) Delete(ctx *gofr.Context, creds any, res *models.Resource) error {
+	cl, err := d.aws.NewElasticIPClient(ctx, creds)
+	if err != nil {
+		return err
+	}
+
+	return cl.ReleaseAddress(ctx, res.Region, res.UID)
+}
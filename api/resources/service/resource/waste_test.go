@@ -0,0 +1,408 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+
+	"github.com/zopdev/zopdev/api/resources/client"
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/pricing"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/ebs"
+	"github.com/zopdev/zopdev/api/resources/providers/aws/eip"
+)
+
+// stubEBS implements the EBS API interface with a single unattached volume and no snapshots.
+type stubEBS struct{}
+
+func (*stubEBS) DescribeVolumesWithContext(_ aws.Context, _ *ec2.DescribeVolumesInput,
+	_ ...request.Option) (*ec2.DescribeVolumesOutput, error) {
+	return &ec2.DescribeVolumesOutput{Volumes: []*ec2.Volume{
+		{VolumeId: aws.String("vol-1"), State: aws.String(ec2.VolumeStateAvailable), Size: aws.Int64(8)},
+	}}, nil
+}
+
+func (*stubEBS) DescribeSnapshotsWithContext(_ aws.Context, _ *ec2.DescribeSnapshotsInput,
+	_ ...request.Option) (*ec2.DescribeSnapshotsOutput, error) {
+	return &ec2.DescribeSnapshotsOutput{}, nil
+}
+
+func (*stubEBS) DeleteVolumeWithContext(_ aws.Context, _ *ec2.DeleteVolumeInput,
+	_ ...request.Option) (*ec2.DeleteVolumeOutput, error) {
+	return &ec2.DeleteVolumeOutput{}, nil
+}
+
+func (*stubEBS) DeleteSnapshotWithContext(_ aws.Context, _ *ec2.DeleteSnapshotInput,
+	_ ...request.Option) (*ec2.DeleteSnapshotOutput, error) {
+	return nil, errMock
+}
+
+// stubEIP implements the Elastic IP API interface with a single unassociated address.
+type stubEIP struct{}
+
+func (*stubEIP) DescribeAddressesWithContext(_ aws.Context, _ *ec2.DescribeAddressesInput,
+	_ ...request.Option) (*ec2.DescribeAddressesOutput, error) {
+	return &ec2.DescribeAddressesOutput{Addresses: []*ec2.Address{
+		{AllocationId: aws.String("eipalloc-1"), PublicIp: aws.String("3.1.2.3")},
+	}}, nil
+}
+
+func (*stubEIP) ReleaseAddressWithContext(_ aws.Context, _ *ec2.ReleaseAddressInput,
+	_ ...request.Option) (*ec2.ReleaseAddressOutput, error) {
+	return &ec2.ReleaseAddressOutput{}, nil
+}
+
+func TestService_ConfirmDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mClient := NewMockHTTPClient(ctrl)
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background()}
+	s := New(nil, nil, nil, mClient, mStore, &pricing.Catalog{}, []byte("key"))
+	ca := &client.CloudAccount{ID: 3, Provider: string(GCP)}
+	disk := &models.Resource{ID: 2, CloudAccount: models.CloudAccount{ID: 3}, Name: "data", Type: string(GCPDISK),
+		UID: "test-project/us-central1-a/data", Status: UNATTACHED}
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(disk, nil)
+	mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).Return(ca, nil)
+
+	confirmation, err := s.ConfirmDelete(ctx, 3, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), confirmation.ResourceID)
+	assert.WithinDuration(t, time.Now().Add(deleteTokenTTL), confirmation.ExpiresAt, time.Second)
+	assert.True(t, s.validDeleteToken(confirmation.Token, disk))
+	// The token is only valid for the resource it was issued for, and with the key that signed it.
+	assert.False(t, s.validDeleteToken(confirmation.Token, &models.Resource{ID: 2, UID: "test-project/data"}))
+	assert.False(t, New(nil, nil, nil, nil, nil, &pricing.Catalog{}, nil).validDeleteToken(confirmation.Token, disk))
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(&models.Resource{ID: 5,
+		CloudAccount: models.CloudAccount{ID: 3}, Type: string(SQL)}, nil)
+	mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).Return(ca, nil)
+
+	confirmation, err = s.ConfirmDelete(ctx, 3, 2)
+
+	assert.Equal(t, &ErrNotDeletable{ResourceID: 5, Type: string(SQL)}, err)
+	assert.Nil(t, confirmation)
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(disk, nil)
+	mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).Return(nil, errMock)
+
+	_, err = s.ConfirmDelete(ctx, 3, 2)
+
+	require.ErrorIs(t, err, errMock)
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+		Return(&models.Resource{ID: 2, CloudAccount: models.CloudAccount{ID: 4}}, nil)
+
+	_, err = s.ConfirmDelete(ctx, 3, 2)
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "resource"}, err)
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(nil, errMock)
+
+	_, err = s.ConfirmDelete(ctx, 3, 2)
+
+	require.ErrorIs(t, err, errMock)
+}
+
+func TestService_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mClient := NewMockHTTPClient(ctrl)
+	mStore := NewMockStore(ctrl)
+	mGCP := NewMockGCPClient(ctrl)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	s := New(mGCP, nil, nil, mClient, mStore, &pricing.Catalog{}, nil)
+	mockCreds := &google.Credentials{ProjectID: "test-project"}
+	ca := &client.CloudAccount{ID: 3, Provider: string(GCP), Credentials: map[string]any{"project_id": "test-project"}}
+	disk := &models.Resource{ID: 2, CloudAccount: models.CloudAccount{ID: 3}, Name: "data", Type: string(GCPDISK),
+		UID: "test-project/us-central1-a/data", Status: UNATTACHED, Settings: models.Settings{"zone": "us-central1-a"}}
+	sql := &models.Resource{ID: 5, CloudAccount: models.CloudAccount{ID: 3}, Type: string(SQL),
+		UID: "test-project/sql-1"}
+	token := s.deleteToken(disk, time.Now().Add(time.Minute))
+	attached := *disk
+	attached.Status = ATTACHED
+
+	testCases := []struct {
+		name      string
+		token     string
+		expErr    error
+		mockCalls func()
+	}{
+		{
+			name:  "Success",
+			token: token,
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(disk, nil)
+				mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).Return(ca, nil)
+				mGCP.EXPECT().NewGoogleCredentials(ctx, ca.Credentials, cloudPlatformScope).Return(mockCreds, nil).Times(2)
+				mGCP.EXPECT().NewDiskClient(ctx, option.WithCredentials(mockCreds)).
+					Return(&mockDiskClient{disks: []models.Resource{*disk}}, nil).Times(2)
+				mStore.EXPECT().RemoveResource(ctx, int64(2)).Return(nil)
+				mStore.EXPECT().InsertEvent(ctx, &models.Event{ResourceID: 2, CloudAccountID: 3, Type: EventDeleted,
+					FromStatus: UNATTACHED, Actor: "jane"}).Return(nil)
+			},
+		},
+		{
+			name:   "Missing token",
+			expErr: gofrHttp.ErrorMissingParam{Params: []string{"token"}},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(disk, nil)
+			},
+		},
+		{
+			name:   "Malformed token",
+			token:  disk.UID,
+			expErr: gofrHttp.ErrorInvalidParam{Params: []string{"token"}},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(disk, nil)
+			},
+		},
+		{
+			name:   "Expired token",
+			token:  s.deleteToken(disk, time.Now().Add(-time.Second)),
+			expErr: gofrHttp.ErrorInvalidParam{Params: []string{"token"}},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(disk, nil)
+			},
+		},
+		{
+			name:   "Token of another resource",
+			token:  s.deleteToken(sql, time.Now().Add(time.Minute)),
+			expErr: gofrHttp.ErrorInvalidParam{Params: []string{"token"}},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(disk, nil)
+			},
+		},
+		{
+			name:   "Resource of another cloud account",
+			token:  token,
+			expErr: gofrHttp.ErrorEntityNotFound{Name: "resource"},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+					Return(&models.Resource{ID: 2, CloudAccount: models.CloudAccount{ID: 4}}, nil)
+			},
+		},
+		{
+			// The disk was attached since the last sync.
+			name:   "Attached resource",
+			token:  token,
+			expErr: &ErrResourceInUse{ResourceID: 2},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(disk, nil)
+				mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).Return(ca, nil)
+				mGCP.EXPECT().NewGoogleCredentials(ctx, ca.Credentials, cloudPlatformScope).Return(mockCreds, nil)
+				mGCP.EXPECT().NewDiskClient(ctx, option.WithCredentials(mockCreds)).
+					Return(&mockDiskClient{disks: []models.Resource{attached}}, nil)
+			},
+		},
+		{
+			name:   "Resource no longer on the cloud provider",
+			token:  token,
+			expErr: gofrHttp.ErrorEntityNotFound{Name: "resource", Value: disk.UID},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(disk, nil)
+				mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).Return(ca, nil)
+				mGCP.EXPECT().NewGoogleCredentials(ctx, ca.Credentials, cloudPlatformScope).Return(mockCreds, nil)
+				mGCP.EXPECT().NewDiskClient(ctx, option.WithCredentials(mockCreds)).Return(&mockDiskClient{}, nil)
+			},
+		},
+		{
+			name:   "Resource type that is not deleted",
+			token:  s.deleteToken(sql, time.Now().Add(time.Minute)),
+			expErr: &ErrNotDeletable{ResourceID: 5, Type: string(SQL)},
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(sql, nil)
+				mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).Return(ca, nil)
+			},
+		},
+		{
+			name:   "Error describing the resource",
+			token:  token,
+			expErr: errMock,
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(disk, nil)
+				mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).Return(ca, nil)
+				mGCP.EXPECT().NewGoogleCredentials(ctx, ca.Credentials, cloudPlatformScope).Return(nil, errMock)
+			},
+		},
+		{
+			name:   "Error deleting the resource",
+			token:  token,
+			expErr: errMock,
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(disk, nil)
+				mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).Return(ca, nil)
+				mGCP.EXPECT().NewGoogleCredentials(ctx, ca.Credentials, cloudPlatformScope).Return(mockCreds, nil).Times(2)
+				mGCP.EXPECT().NewDiskClient(ctx, option.WithCredentials(mockCreds)).
+					Return(&mockDiskClient{disks: []models.Resource{*disk}}, nil)
+				mGCP.EXPECT().NewDiskClient(ctx, option.WithCredentials(mockCreds)).
+					Return(&mockDiskClient{isError: true}, nil)
+			},
+		},
+		{
+			name:   "Error getting the credentials",
+			token:  token,
+			expErr: errMock,
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(disk, nil)
+				mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).Return(nil, errMock)
+			},
+		},
+		{
+			name:   "Error getting the resource",
+			token:  token,
+			expErr: errMock,
+			mockCalls: func() {
+				mStore.EXPECT().GetResourceByID(ctx, int64(2)).Return(nil, errMock)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCalls()
+
+			err := s.Delete(ctx, 3, 2, &models.DeleteRequest{Token: tc.token, RequestedBy: "jane"})
+
+			assert.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestService_ChangeState_WasteCandidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mClient := NewMockHTTPClient(ctrl)
+	mStore := NewMockStore(ctrl)
+	ctx := &gofr.Context{Context: context.Background()}
+	s := New(nil, nil, nil, mClient, mStore, &pricing.Catalog{}, nil)
+
+	mStore.EXPECT().GetResourceByID(ctx, int64(2)).
+		Return(&models.Resource{ID: 2, Type: string(EBSVOLUME), Status: UNATTACHED}, nil)
+	mStore.EXPECT().GetActiveLock(ctx, int64(2), gomock.Any()).Return(nil, nil)
+	mStore.EXPECT().GetActiveBlackout(ctx, int64(2), gomock.Any()).Return(nil, nil)
+	mClient.EXPECT().GetCloudCredentials(ctx, int64(3)).
+		Return(&client.CloudAccount{ID: 3, Provider: string(AWS)}, nil)
+
+	err := s.ChangeState(ctx, ResourceDetails{CloudAccID: 3, ID: 2, Type: EBSVOLUME, State: SUSPEND})
+
+	assert.Equal(t, &ErrNotChangeable{ResourceID: 2, Type: string(EBSVOLUME)}, err)
+}
+
+func TestGCPWasteDrivers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	creds := map[string]any{"project_id": "test-project"}
+	mockCreds := &google.Credentials{ProjectID: "test-project"}
+	mGCP := NewMockGCPClient(ctrl)
+	disk := models.Resource{Name: "data", UID: "test-project/us-central1-a/data",
+		Settings: models.Settings{"zone": "us-central1-a"}}
+	snapshot := models.Resource{Name: "data-daily", UID: "test-project/data-daily"}
+	address := models.Resource{Name: "spare", UID: "test-project/us-central1/spare",
+		Settings: models.Settings{"region": "us-central1"}}
+	diskClient := &mockDiskClient{disks: []models.Resource{disk}, snapshots: []models.Resource{snapshot}}
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(mockCreds, nil).Times(6)
+	mGCP.EXPECT().NewDiskClient(ctx, option.WithCredentials(mockCreds)).Return(diskClient, nil).Times(4)
+	mGCP.EXPECT().NewAddressClient(ctx, option.WithCredentials(mockCreds)).
+		Return(&mockAddressClient{addresses: []models.Resource{address}}, nil).Times(2)
+
+	disks, err := (&gcpDiskDriver{gcp: mGCP}).List(ctx, creds)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{disk}, disks)
+	require.NoError(t, (&gcpDiskDriver{gcp: mGCP}).Delete(ctx, creds, &disk))
+
+	snapshots, err := (&gcpSnapshotDriver{gcp: mGCP}).List(ctx, creds)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{snapshot}, snapshots)
+	require.NoError(t, (&gcpSnapshotDriver{gcp: mGCP}).Delete(ctx, creds, &snapshot))
+
+	addresses, err := (&gcpAddressDriver{gcp: mGCP}).List(ctx, creds)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{address}, addresses)
+	require.NoError(t, (&gcpAddressDriver{gcp: mGCP}).Delete(ctx, creds, &address))
+
+	// The location of the resource is required to delete it.
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.zone"}},
+		(&gcpDiskDriver{gcp: mGCP}).Delete(ctx, creds, &models.Resource{Name: "data"}))
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"resource.Settings.region"}},
+		(&gcpAddressDriver{gcp: mGCP}).Delete(ctx, creds, &models.Resource{Name: "spare"}))
+
+	// The waste candidates are not started or stopped.
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"req.Type"}},
+		(&gcpDiskDriver{gcp: mGCP}).Stop(ctx, creds, &disk))
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"req.Type"}},
+		(&gcpAddressDriver{gcp: mGCP}).Start(ctx, creds, &address))
+
+	mGCP.EXPECT().NewGoogleCredentials(ctx, creds, cloudPlatformScope).Return(nil, errMock).Times(2)
+
+	snapshots, err = (&gcpSnapshotDriver{gcp: mGCP}).List(ctx, creds)
+
+	require.ErrorIs(t, err, errMock)
+	assert.Nil(t, snapshots)
+	require.ErrorIs(t, (&gcpAddressDriver{gcp: mGCP}).Delete(ctx, creds, &address), errMock)
+}
+
+func TestAWSWasteDrivers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := &gofr.Context{Context: context.Background()}
+	mAWS := NewMockAWSClient(ctrl)
+
+	mAWS.EXPECT().NewEBSClient(ctx, gomock.Any()).
+		Return(&ebs.Client{EC2: map[string]ebs.EBSAPI{"us-east-1": &stubEBS{}}}, nil).Times(4)
+	mAWS.EXPECT().NewElasticIPClient(ctx, gomock.Any()).
+		Return(&eip.Client{EC2: map[string]eip.EIPAPI{"us-east-1": &stubEIP{}}}, nil).Times(2)
+
+	volumes, err := (&ebsVolumeDriver{aws: mAWS}).List(ctx, nil)
+
+	require.NoError(t, err)
+	require.Len(t, volumes, 1)
+	assert.Equal(t, UNATTACHED, volumes[0].Status)
+	require.NoError(t, (&ebsVolumeDriver{aws: mAWS}).Delete(ctx, nil, &volumes[0]))
+
+	snapshots, err := (&ebsSnapshotDriver{aws: mAWS}).List(ctx, nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, snapshots)
+	require.ErrorIs(t, (&ebsSnapshotDriver{aws: mAWS}).Delete(ctx, nil,
+		&models.Resource{UID: "snap-1", Region: "us-east-1"}), errMock)
+
+	addresses, err := (&elasticIPDriver{aws: mAWS}).List(ctx, nil)
+
+	require.NoError(t, err)
+	require.Len(t, addresses, 1)
+	assert.Equal(t, "eipalloc-1", addresses[0].UID)
+	require.NoError(t, (&elasticIPDriver{aws: mAWS}).Delete(ctx, nil, &addresses[0]))
+
+	mAWS.EXPECT().NewEBSClient(ctx, gomock.Any()).Return(nil, errMock)
+
+	volumes, err = (&ebsVolumeDriver{aws: mAWS}).List(ctx, nil)
+
+	require.ErrorIs(t, err, errMock)
+	assert.Nil(t, volumes)
+}
@@ -0,0 +1,288 @@
+package resourcegroup
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/service/resource"
+)
+
+// CreateCalendar creates a named calendar of a cloud account from its periods and the events of its iCalendar file.
+func (s *Service) CreateCalendar(ctx *gofr.Context, cloudAccID int64, cal *models.Calendar) (*models.Calendar, error) {
+	if err := validateCalendar(cal); err != nil {
+		return nil, err
+	}
+
+	cal.CloudAccountID = cloudAccID
+
+	if err := s.grpStore.CreateCalendar(ctx, cal); err != nil {
+		return nil, &errInternalServer{}
+	}
+
+	return s.GetCalendar(ctx, cloudAccID, cal.ID)
+}
+
+// GetCalendars returns the calendars of a cloud account without their periods.
+func (s *Service) GetCalendars(ctx *gofr.Context, cloudAccID int64) ([]models.Calendar, error) {
+	calendars, err := s.grpStore.GetCalendars(ctx, cloudAccID)
+	if err != nil {
+		return nil, &errInternalServer{}
+	}
+
+	return calendars, nil
+}
+
+// GetCalendar returns a calendar of a cloud account with its periods. The calendars of the other cloud accounts are
+// not found.
+func (s *Service) GetCalendar(ctx *gofr.Context, cloudAccID, id int64) (*models.Calendar, error) {
+	cal, err := s.grpStore.GetCalendarByID(ctx, cloudAccID, id)
+	if err != nil {
+		return nil, &errInternalServer{}
+	}
+
+	if cal == nil {
+		return nil, gofrHttp.ErrorEntityNotFound{Name: "calendar", Value: strconv.FormatInt(id, 10)}
+	}
+
+	return cal, nil
+}
+
+// UpdateCalendar renames a calendar of a cloud account and replaces its periods.
+func (s *Service) UpdateCalendar(ctx *gofr.Context, cloudAccID int64, cal *models.Calendar) (*models.Calendar, error) {
+	if err := validateCalendar(cal); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.GetCalendar(ctx, cloudAccID, cal.ID); err != nil {
+		return nil, err
+	}
+
+	cal.CloudAccountID = cloudAccID
+
+	if err := s.grpStore.UpdateCalendar(ctx, cal); err != nil {
+		return nil, &errInternalServer{}
+	}
+
+	return s.GetCalendar(ctx, cloudAccID, cal.ID)
+}
+
+// DeleteCalendar deletes a calendar of a cloud account and detaches it from the resource groups, the members keep
+// their current state.
+func (s *Service) DeleteCalendar(ctx *gofr.Context, cloudAccID, id int64) error {
+	if _, err := s.GetCalendar(ctx, cloudAccID, id); err != nil {
+		return err
+	}
+
+	if err := s.grpStore.DeleteCalendar(ctx, id); err != nil {
+		return &errInternalServer{}
+	}
+
+	return nil
+}
+
+// GetGroupCalendars returns the calendars attached to a resource group.
+func (s *Service) GetGroupCalendars(ctx *gofr.Context, cloudAccID, groupID int64) ([]models.GroupCalendar, error) {
+	if err := s.checkGroup(ctx, cloudAccID, groupID); err != nil {
+		return nil, err
+	}
+
+	calendars, err := s.grpStore.GetGroupCalendars(ctx, groupID, time.Now().UTC())
+	if err != nil {
+		return nil, &errInternalServer{}
+	}
+
+	return calendars, nil
+}
+
+// AttachCalendar attaches a calendar to a resource group as an off or a blackout calendar. The off periods are
+// applied to the members of the group by the next calendar run.
+func (s *Service) AttachCalendar(ctx *gofr.Context, cloudAccID, groupID int64,
+	gc *models.GroupCalendar) ([]models.GroupCalendar, error) {
+	gc.Kind = strings.ToUpper(gc.Kind)
+
+	if gc.Kind != models.CalendarOff && gc.Kind != models.CalendarBlackout {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"kind"}}
+	}
+
+	if err := s.checkGroup(ctx, cloudAccID, groupID); err != nil {
+		return nil, err
+	}
+
+	// Only the calendars of the cloud account of the group can be attached.
+	if _, err := s.GetCalendar(ctx, cloudAccID, gc.CalendarID); err != nil {
+		return nil, err
+	}
+
+	gc.GroupID = groupID
+
+	if err := s.grpStore.AttachCalendar(ctx, gc); err != nil {
+		return nil, &errInternalServer{}
+	}
+
+	return s.GetGroupCalendars(ctx, cloudAccID, groupID)
+}
+
+// DetachCalendar detaches a calendar from a resource group, the members keep their current state.
+func (s *Service) DetachCalendar(ctx *gofr.Context, cloudAccID, groupID, calendarID int64) error {
+	if err := s.checkGroup(ctx, cloudAccID, groupID); err != nil {
+		return err
+	}
+
+	detached, err := s.grpStore.DetachCalendar(ctx, groupID, calendarID)
+	if err != nil {
+		return &errInternalServer{}
+	}
+
+	if !detached {
+		return gofrHttp.ErrorEntityNotFound{Name: "calendar", Value: strconv.FormatInt(calendarID, 10)}
+	}
+
+	return nil
+}
+
+// CalendarCron is a cron job that suspends the members of the resource groups with an off calendar when an off
+// period begins and restarts them when it ends. A scheduled group returns to its schedule instead of being started.
+// As with schedules, a group is only acted on when its state changes.
+func (s *Service) CalendarCron(ctx *gofr.Context) {
+	calendars, err := s.grpStore.GetOffCalendars(ctx, time.Now().UTC())
+	if err != nil {
+		ctx.Errorf("failed to get the off calendars of resource groups: %v", err)
+		return
+	}
+
+	groups := mergeOffCalendars(calendars)
+
+	for i := range groups {
+		grp := &groups[i]
+
+		var state resource.ResourceState
+
+		switch {
+		case grp.Active && grp.LastState != string(resource.SUSPEND):
+			state = resource.SUSPEND
+		case !grp.Active && grp.LastState == string(resource.SUSPEND):
+			state = resource.START
+		default:
+			continue
+		}
+
+		// A keep-awake lock on the group defers the suspend until it expires.
+		if state == resource.SUSPEND && s.groupLocked(ctx, grp.CloudAccountID, grp.GroupID) {
+			continue
+		}
+
+		s.applyCalendar(ctx, grp, state)
+	}
+}
+
+// applyCalendar applies the state of the off calendars to the members of a resource group, the state is recorded
+// once the change of the members completes. When an off period ends the schedule of a scheduled group is applied
+// again by the next scheduler run.
+func (s *Service) applyCalendar(ctx *gofr.Context, grp *models.GroupCalendar, state resource.ResourceState) {
+	if state == resource.START {
+		sch, err := s.grpStore.GetSchedule(ctx, grp.GroupID)
+		if err != nil {
+			ctx.Errorf("failed to get the schedule of resource group %d: %v", grp.GroupID, err)
+			return
+		}
+
+		if sch != nil {
+			if err = s.grpStore.UpdateScheduleState(ctx, grp.GroupID, "", ""); err != nil {
+				ctx.Errorf("failed to reset the schedule of resource group %d: %v", grp.GroupID, err)
+				return
+			}
+
+			if err = s.grpStore.UpdateCalendarState(ctx, grp.GroupID, string(state)); err != nil {
+				ctx.Errorf("failed to record the calendar state of resource group %d: %v", grp.GroupID, err)
+			}
+
+			return
+		}
+	}
+
+	s.applyGroupState(ctx, grp.CloudAccountID, grp.GroupID, state, resource.ActorCalendar)
+}
+
+// offGroups returns the resource groups in an off period of one of their calendars at a time.
+func (s *Service) offGroups(ctx *gofr.Context, at time.Time) (map[int64]bool, error) {
+	calendars, err := s.grpStore.GetOffCalendars(ctx, at.UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	off := make(map[int64]bool)
+
+	for i := range calendars {
+		if calendars[i].Active {
+			off[calendars[i].GroupID] = true
+		}
+	}
+
+	return off, nil
+}
+
+// mergeOffCalendars merges the consecutive off calendars of each resource group. A group is in an off period when
+// one of its calendars is, and is suspended when one of its calendars suspended it.
+func mergeOffCalendars(calendars []models.GroupCalendar) []models.GroupCalendar {
+	groups := make([]models.GroupCalendar, 0, len(calendars))
+
+	for i := range calendars {
+		if n := len(groups); n > 0 && groups[n-1].GroupID == calendars[i].GroupID {
+			groups[n-1].Active = groups[n-1].Active || calendars[i].Active
+
+			if calendars[i].LastState == string(resource.SUSPEND) {
+				groups[n-1].LastState = calendars[i].LastState
+			}
+
+			continue
+		}
+
+		groups = append(groups, calendars[i])
+	}
+
+	return groups
+}
+
+func validateCalendar(cal *models.Calendar) error {
+	if strings.TrimSpace(cal.Name) == "" {
+		return gofrHttp.ErrorMissingParam{Params: []string{"name"}}
+	}
+
+	if cal.Timezone == "" {
+		cal.Timezone = "UTC"
+	}
+
+	loc, err := time.LoadLocation(cal.Timezone)
+	if err != nil {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"timezone"}}
+	}
+
+	if cal.ICS != "" {
+		periods, er := parseICS(cal.ICS, loc, time.Now().AddDate(icsHorizonYears, 0, 0))
+		if er != nil {
+			return gofrHttp.ErrorInvalidParam{Params: []string{"ics"}}
+		}
+
+		cal.Periods, cal.ICS = append(cal.Periods, periods...), ""
+	}
+
+	for i := range cal.Periods {
+		if cal.Periods[i].Start.IsZero() || !cal.Periods[i].End.After(cal.Periods[i].Start) {
+			return gofrHttp.ErrorInvalidParam{Params: []string{"periods"}}
+		}
+
+		cal.Periods[i].Start, cal.Periods[i].End = cal.Periods[i].Start.UTC(), cal.Periods[i].End.UTC()
+	}
+
+	sort.SliceStable(cal.Periods, func(i, j int) bool {
+		return cal.Periods[i].Start.Before(cal.Periods[j].Start)
+	})
+
+	return nil
+}
@@ -0,0 +1,324 @@
+package resourcegroup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/service/resource"
+)
+
+func TestValidateCalendar(t *testing.T) {
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	require.NoError(t, err)
+
+	cal := &models.Calendar{Name: "India holidays", Timezone: "Asia/Kolkata",
+		Periods: []models.CalendarPeriod{{Name: "Diwali", Start: time.Date(2025, 10, 20, 0, 0, 0, 0, kolkata),
+			End: time.Date(2025, 10, 21, 0, 0, 0, 0, kolkata)}},
+		ICS: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20250815\nSUMMARY:Independence Day\nEND:VEVENT\n"}
+
+	require.NoError(t, validateCalendar(cal))
+	assert.Empty(t, cal.ICS)
+	assert.Equal(t, []models.CalendarPeriod{
+		{Name: "Independence Day", Start: time.Date(2025, 8, 14, 18, 30, 0, 0, time.UTC),
+			End: time.Date(2025, 8, 15, 18, 30, 0, 0, time.UTC)},
+		{Name: "Diwali", Start: time.Date(2025, 10, 19, 18, 30, 0, 0, time.UTC),
+			End: time.Date(2025, 10, 20, 18, 30, 0, 0, time.UTC)},
+	}, cal.Periods)
+
+	cal = &models.Calendar{Name: "Quarter-end"}
+
+	require.NoError(t, validateCalendar(cal))
+	assert.Equal(t, "UTC", cal.Timezone)
+
+	start := time.Date(2025, 9, 29, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		cal    *models.Calendar
+		expErr error
+	}{
+		{&models.Calendar{Name: " "}, gofrHttp.ErrorMissingParam{Params: []string{"name"}}},
+		{&models.Calendar{Name: "q", Timezone: "Nowhere/Invalid"},
+			gofrHttp.ErrorInvalidParam{Params: []string{"timezone"}}},
+		{&models.Calendar{Name: "q", ICS: "BEGIN:VCALENDAR\nEND:VCALENDAR\n"},
+			gofrHttp.ErrorInvalidParam{Params: []string{"ics"}}},
+		{&models.Calendar{Name: "q", Periods: []models.CalendarPeriod{{Start: start, End: start}}},
+			gofrHttp.ErrorInvalidParam{Params: []string{"periods"}}},
+		{&models.Calendar{Name: "q", Periods: []models.CalendarPeriod{{End: start}}},
+			gofrHttp.ErrorInvalidParam{Params: []string{"periods"}}},
+	} {
+		assert.Equal(t, tc.expErr, validateCalendar(tc.cal), tc.cal.Name)
+	}
+}
+
+func TestService_CreateCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{}
+	start := time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)
+	periods := []models.CalendarPeriod{{Name: "Christmas", Start: start, End: start.AddDate(0, 0, 1)}}
+	expected := &models.Calendar{ID: 4, CloudAccountID: 1, Name: "Holidays", Timezone: "UTC", Periods: periods}
+	created := &models.Calendar{CloudAccountID: 1, Name: "Holidays", Timezone: "UTC", Periods: periods}
+
+	mockStore.EXPECT().CreateCalendar(ctx, created).
+		DoAndReturn(func(_ *gofr.Context, cal *models.Calendar) error {
+			cal.ID = 4
+			return nil
+		})
+	mockStore.EXPECT().GetCalendarByID(ctx, int64(1), int64(4)).Return(expected, nil)
+
+	cal, err := svc.CreateCalendar(ctx, 1, &models.Calendar{Name: "Holidays", Periods: periods})
+
+	require.NoError(t, err)
+	assert.Equal(t, expected, cal)
+
+	mockStore.EXPECT().CreateCalendar(ctx, gomock.Any()).Return(assert.AnError)
+
+	cal, err = svc.CreateCalendar(ctx, 1, &models.Calendar{Name: "Holidays"})
+
+	assert.Equal(t, &errInternalServer{}, err)
+	assert.Nil(t, cal)
+
+	cal, err = svc.CreateCalendar(ctx, 1, &models.Calendar{})
+
+	assert.Equal(t, gofrHttp.ErrorMissingParam{Params: []string{"name"}}, err)
+	assert.Nil(t, cal)
+}
+
+func TestService_UpdateCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{}
+	updated := &models.Calendar{ID: 4, CloudAccountID: 1, Name: "Holidays 2026", Timezone: "UTC"}
+
+	mockStore.EXPECT().GetCalendarByID(ctx, int64(1), int64(4)).
+		Return(&models.Calendar{ID: 4, CloudAccountID: 1, Name: "Holidays"}, nil)
+	mockStore.EXPECT().UpdateCalendar(ctx, updated).Return(nil)
+	mockStore.EXPECT().GetCalendarByID(ctx, int64(1), int64(4)).Return(updated, nil)
+
+	cal, err := svc.UpdateCalendar(ctx, 1, &models.Calendar{ID: 4, Name: "Holidays 2026"})
+
+	require.NoError(t, err)
+	assert.Equal(t, updated, cal)
+
+	// The calendar belongs to another cloud account.
+	mockStore.EXPECT().GetCalendarByID(ctx, int64(2), int64(4)).Return(nil, nil)
+
+	cal, err = svc.UpdateCalendar(ctx, 2, &models.Calendar{ID: 4, Name: "Holidays 2026"})
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "calendar", Value: "4"}, err)
+	assert.Nil(t, cal)
+
+	mockStore.EXPECT().GetCalendarByID(ctx, int64(1), int64(5)).Return(nil, nil)
+
+	cal, err = svc.UpdateCalendar(ctx, 1, &models.Calendar{ID: 5, Name: "Holidays 2026"})
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "calendar", Value: "5"}, err)
+	assert.Nil(t, cal)
+}
+
+func TestService_GetCalendars(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{}
+	calendars := []models.Calendar{{ID: 4, CloudAccountID: 1, Name: "Holidays", Timezone: "UTC"}}
+
+	mockStore.EXPECT().GetCalendars(ctx, int64(1)).Return(calendars, nil)
+
+	res, err := svc.GetCalendars(ctx, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, calendars, res)
+
+	mockStore.EXPECT().GetCalendars(ctx, int64(1)).Return(nil, assert.AnError)
+
+	res, err = svc.GetCalendars(ctx, 1)
+
+	assert.Equal(t, &errInternalServer{}, err)
+	assert.Nil(t, res)
+}
+
+func TestService_DeleteCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{}
+
+	mockStore.EXPECT().GetCalendarByID(ctx, int64(1), int64(4)).Return(&models.Calendar{ID: 4, CloudAccountID: 1}, nil).
+		Times(2)
+	mockStore.EXPECT().DeleteCalendar(ctx, int64(4)).Return(nil)
+
+	require.NoError(t, svc.DeleteCalendar(ctx, 1, 4))
+
+	mockStore.EXPECT().DeleteCalendar(ctx, int64(4)).Return(assert.AnError)
+
+	assert.Equal(t, &errInternalServer{}, svc.DeleteCalendar(ctx, 1, 4))
+
+	// The calendar of another cloud account is not deleted.
+	mockStore.EXPECT().GetCalendarByID(ctx, int64(2), int64(4)).Return(nil, nil)
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "calendar", Value: "4"}, svc.DeleteCalendar(ctx, 2, 4))
+
+	mockStore.EXPECT().GetCalendarByID(ctx, int64(1), int64(5)).Return(nil, assert.AnError)
+
+	assert.Equal(t, &errInternalServer{}, svc.DeleteCalendar(ctx, 1, 5))
+}
+
+func TestService_AttachCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{}
+	attached := []models.GroupCalendar{{GroupID: 2, CloudAccountID: 1, CalendarID: 4, CalendarName: "Holidays",
+		Kind: models.CalendarOff}}
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil).
+		Times(3)
+	mockStore.EXPECT().GetCalendarByID(ctx, int64(1), int64(4)).
+		Return(&models.Calendar{ID: 4, CloudAccountID: 1, Name: "Holidays"}, nil)
+	mockStore.EXPECT().AttachCalendar(ctx, &models.GroupCalendar{GroupID: 2, CalendarID: 4,
+		Kind: models.CalendarOff}).Return(nil)
+	mockStore.EXPECT().GetGroupCalendars(ctx, int64(2), gomock.Any()).Return(attached, nil)
+
+	res, err := svc.AttachCalendar(ctx, 1, 2, &models.GroupCalendar{CalendarID: 4, Kind: "off"})
+
+	require.NoError(t, err)
+	assert.Equal(t, attached, res)
+
+	// The calendar belongs to another cloud account, or does not exist.
+	mockStore.EXPECT().GetCalendarByID(ctx, int64(1), int64(5)).Return(nil, nil)
+
+	res, err = svc.AttachCalendar(ctx, 1, 2, &models.GroupCalendar{CalendarID: 5, Kind: models.CalendarBlackout})
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "calendar", Value: "5"}, err)
+	assert.Nil(t, res)
+
+	res, err = svc.AttachCalendar(ctx, 1, 2, &models.GroupCalendar{CalendarID: 4, Kind: "holiday"})
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"kind"}}, err)
+	assert.Nil(t, res)
+}
+
+func TestService_DetachCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{}
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil).
+		Times(3)
+	mockStore.EXPECT().DetachCalendar(ctx, int64(2), int64(4)).Return(true, nil)
+
+	require.NoError(t, svc.DetachCalendar(ctx, 1, 2, 4))
+
+	mockStore.EXPECT().DetachCalendar(ctx, int64(2), int64(5)).Return(false, nil)
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "calendar", Value: "5"}, svc.DetachCalendar(ctx, 1, 2, 5))
+
+	mockStore.EXPECT().DetachCalendar(ctx, int64(2), int64(4)).Return(false, assert.AnError)
+
+	assert.Equal(t, &errInternalServer{}, svc.DetachCalendar(ctx, 1, 2, 4))
+}
+
+func TestMergeOffCalendars(t *testing.T) {
+	groups := mergeOffCalendars([]models.GroupCalendar{
+		{GroupID: 1, CalendarID: 1, Active: false, LastState: string(resource.SUSPEND)},
+		{GroupID: 1, CalendarID: 2, Active: true},
+		{GroupID: 2, CalendarID: 1},
+	})
+
+	assert.Equal(t, []models.GroupCalendar{
+		{GroupID: 1, CalendarID: 1, Active: true, LastState: string(resource.SUSPEND)},
+		{GroupID: 2, CalendarID: 1},
+	}, groups)
+}
+
+func TestService_CalendarCron(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mockStore := NewMockRGStore(ctrl)
+	mockResSvc := NewMockResourceService(ctrl)
+	svc := New(mockStore, mockResSvc)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	suspended := string(resource.SUSPEND)
+
+	mockStore.EXPECT().GetOffCalendars(ctx, gomock.Any()).Return([]models.GroupCalendar{
+		// An off period begins.
+		{GroupID: 1, CloudAccountID: 3, CalendarID: 1, Active: true},
+		// The off period is already applied.
+		{GroupID: 2, CloudAccountID: 3, CalendarID: 1, Active: true, LastState: suspended},
+		// The off period ends, the group is started.
+		{GroupID: 4, CloudAccountID: 3, CalendarID: 1, LastState: suspended},
+		// The off period ends, the group returns to its schedule.
+		{GroupID: 5, CloudAccountID: 3, CalendarID: 1, LastState: suspended},
+		// The group is kept awake.
+		{GroupID: 6, CloudAccountID: 3, CalendarID: 1, Active: true},
+		// A member of the group is in a blackout period, the suspend is retried on the next run.
+		{GroupID: 7, CloudAccountID: 3, CalendarID: 1, Active: true},
+	}, nil)
+
+	mockStore.EXPECT().GetGroupChange(ctx, gomock.Any()).Return(nil, nil).Times(3)
+	mockStore.EXPECT().InsertGroupChange(ctx, gomock.Any()).Return(nil).Times(3)
+
+	mockResSvc.EXPECT().GetLocks(ctx, int64(3), int64(0), int64(1)).Return(nil, nil)
+	mockStore.EXPECT().GetResourceIDs(ctx, int64(1)).Return([]int64{10}, nil)
+	mockStore.EXPECT().GetResourceTiers(ctx, int64(1)).Return(map[int64]int{}, nil)
+	mockResSvc.EXPECT().GetByID(ctx, int64(10)).Return(&models.Resource{ID: 10, Name: "db", Type: "SQL"}, nil).Times(2)
+	mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 10, CloudAccID: 3, Name: "db", Type: "SQL",
+		State: resource.SUSPEND, RequestedBy: resource.ActorCalendar}).Return(nil)
+	mockStore.EXPECT().UpdateCalendarState(ctx, int64(1), suspended).Return(nil)
+
+	mockStore.EXPECT().GetSchedule(ctx, int64(4)).Return(nil, nil)
+	mockStore.EXPECT().GetResourceIDs(ctx, int64(4)).Return([]int64{11}, nil)
+	mockStore.EXPECT().GetResourceTiers(ctx, int64(4)).Return(map[int64]int{}, nil)
+	mockResSvc.EXPECT().GetByID(ctx, int64(11)).Return(&models.Resource{ID: 11, Name: "vm", Type: "GCE"}, nil).Times(2)
+	mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 11, CloudAccID: 3, Name: "vm", Type: "GCE",
+		State: resource.START, RequestedBy: resource.ActorCalendar}).Return(nil)
+	mockStore.EXPECT().UpdateCalendarState(ctx, int64(4), string(resource.START)).Return(nil)
+
+	mockStore.EXPECT().GetSchedule(ctx, int64(5)).Return(&models.Schedule{GroupID: 5}, nil)
+	mockStore.EXPECT().UpdateScheduleState(ctx, int64(5), "", "").Return(nil)
+	mockStore.EXPECT().UpdateCalendarState(ctx, int64(5), string(resource.START)).Return(assert.AnError)
+
+	mockResSvc.EXPECT().GetLocks(ctx, int64(3), int64(0), int64(6)).
+		Return([]models.Lock{{ID: 1, CloudAccountID: 3, GroupID: 6, Author: "jane"}}, nil)
+
+	mockResSvc.EXPECT().GetLocks(ctx, int64(3), int64(0), int64(7)).Return(nil, nil)
+	mockStore.EXPECT().GetResourceIDs(ctx, int64(7)).Return([]int64{12}, nil)
+	mockStore.EXPECT().GetResourceTiers(ctx, int64(7)).Return(map[int64]int{}, nil)
+	mockResSvc.EXPECT().GetByID(ctx, int64(12)).Return(&models.Resource{ID: 12, Name: "api", Type: "GCE"}, nil)
+	mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 12, CloudAccID: 3, Name: "api", Type: "GCE",
+		State: resource.SUSPEND, RequestedBy: resource.ActorCalendar}).
+		Return(&resource.ErrBlackout{ResourceID: 12, Calendar: "release freeze", Until: time.Now().Add(time.Hour)})
+
+	svc.CalendarCron(ctx)
+
+	mockStore.EXPECT().GetOffCalendars(ctx, gomock.Any()).Return(nil, assert.AnError)
+
+	svc.CalendarCron(ctx)
+}
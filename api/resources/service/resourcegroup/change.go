@@ -0,0 +1,260 @@
+package resourcegroup
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/service/resource"
+)
+
+const (
+	// maxChangeAttempts is the number of times a cron job changes a resource group to a state while members fail to
+	// change, it then records the state along with the failure and leaves the group until its next change of state.
+	maxChangeAttempts = 5
+	// retryDelay is the time a cron job waits before it retries a failed change, it doubles with every attempt.
+	retryDelay = time.Minute
+)
+
+// GetGroupState returns the progress of the last state change of a resource group.
+func (s *Service) GetGroupState(ctx *gofr.Context, cloudAccID, groupID int64) (*models.RGStateResult, error) {
+	if err := s.checkGroup(ctx, cloudAccID, groupID); err != nil {
+		return nil, err
+	}
+
+	ch, err := s.grpStore.GetGroupChange(ctx, groupID)
+	if err != nil {
+		return nil, &errInternalServer{}
+	}
+
+	if ch == nil {
+		return nil, gofrHttp.ErrorEntityNotFound{Name: "resource group change", Value: strconv.FormatInt(groupID, 10)}
+	}
+
+	return changeResult(ch), nil
+}
+
+// ChangeCron is a cron job that moves the state changes of resource groups in progress to their next tier once the
+// members of their current tier reached the state, and fails the changes whose current tier timed out.
+func (s *Service) ChangeCron(ctx *gofr.Context) {
+	changes, err := s.grpStore.GetGroupChangesByStatus(ctx, resource.OperationInProgress)
+	if err != nil {
+		ctx.Errorf("failed to get the resource group changes in progress: %v", err)
+		return
+	}
+
+	for i := range changes {
+		s.continueChange(ctx, &changes[i])
+	}
+}
+
+// startChange changes the state of the first tier of a resource group and records the change, its next tiers are
+// changed by ChangeCron. It returns an error when the group has a change in progress, or when a cron job retries a
+// failed change before the retry delay is over.
+func (s *Service) startChange(ctx *gofr.Context, cloudAccID, groupID int64, state resource.ResourceState,
+	requestedBy string, timeout time.Duration) (*models.RGChange, error) {
+	if _, busy := s.inFlight.LoadOrStore(groupID, struct{}{}); busy {
+		return nil, &ErrChangeInProgress{GroupID: groupID}
+	}
+
+	defer s.inFlight.Delete(groupID)
+
+	last, err := s.grpStore.GetGroupChange(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if last != nil && last.Status == resource.OperationInProgress {
+		return nil, &ErrChangeInProgress{GroupID: groupID}
+	}
+
+	attempt, err := changeAttempt(last, requestedBy, state)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers, err := s.groupTiers(ctx, groupID, state)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(models.MemberOutcomes, 0)
+
+	for _, ids := range tiers {
+		for _, id := range ids {
+			members = append(members, models.MemberOutcome{ResourceID: id, Pending: true})
+		}
+	}
+
+	ch := &models.RGChange{GroupID: groupID, CloudAccountID: cloudAccID, State: string(state),
+		RequestedBy: requestedBy, Tiers: tiers, Tier: -1, TierTimeout: timeout, Members: members,
+		Status: resource.OperationInProgress, Attempt: attempt}
+
+	s.advanceChange(ctx, ch)
+
+	if err = s.grpStore.InsertGroupChange(ctx, ch); err != nil {
+		return nil, err
+	}
+
+	s.finishChange(ctx, ch)
+
+	return ch, nil
+}
+
+// continueChange advances a state change of a resource group in progress and records its progress. A change being
+// advanced by an overlapping run is skipped.
+func (s *Service) continueChange(ctx *gofr.Context, ch *models.RGChange) {
+	if _, busy := s.inFlight.LoadOrStore(ch.GroupID, struct{}{}); busy {
+		return
+	}
+
+	defer s.inFlight.Delete(ch.GroupID)
+
+	s.advanceChange(ctx, ch)
+
+	if err := s.grpStore.UpdateGroupChange(ctx, ch); err != nil {
+		ctx.Errorf("failed to update the change %d of resource group %d: %v", ch.ID, ch.GroupID, err)
+		return
+	}
+
+	s.finishChange(ctx, ch)
+}
+
+// finishChange reports the members of a completed change applied by a cron job that failed to change or were
+// deferred. The state is recorded for the cron job once all the members changed, the members that are not started
+// or suspended aside. A failed change is retried by the next runs of the cron job, the state is recorded along with
+// the failure once the members failed to change maxChangeAttempts times in a row. Deferred members are retried
+// until they change.
+func (s *Service) finishChange(ctx *gofr.Context, ch *models.RGChange) {
+	if ch.Status == resource.OperationInProgress {
+		return
+	}
+
+	var record func(ctx *gofr.Context, groupID int64, state, lastError string) error
+
+	switch ch.RequestedBy {
+	case resource.ActorSchedule:
+		record = s.grpStore.UpdateScheduleState
+	case resource.ActorCalendar:
+		// The failure is reported by the change of the group.
+		record = func(ctx *gofr.Context, groupID int64, state, _ string) error {
+			return s.grpStore.UpdateCalendarState(ctx, groupID, state)
+		}
+	default:
+		// The outcome of the members is reported to the user that requested the change.
+		return
+	}
+
+	action := strings.ToLower(ch.State)
+
+	for i := range ch.Members {
+		m := &ch.Members[i]
+
+		switch {
+		case m.Succeeded || m.Skipped:
+		case m.Deferred:
+			ctx.Infof("deferred the %s of resource %d of resource group %d: %s", action, m.ResourceID, ch.GroupID,
+				m.Error)
+		default:
+			ctx.Errorf("failed to %s resource %d of resource group %d: %s", action, m.ResourceID, ch.GroupID, m.Error)
+		}
+	}
+
+	var lastError string
+
+	if ch.Status != resource.OperationSucceeded {
+		if !failed(ch) || ch.Attempt < maxChangeAttempts {
+			return
+		}
+
+		lastError = changeError(ch)
+
+		ctx.Errorf("gave up the %s of resource group %d: %s", action, ch.GroupID, lastError)
+	}
+
+	if err := record(ctx, ch.GroupID, ch.State, lastError); err != nil {
+		ctx.Errorf("failed to record the state applied by %s to resource group %d: %v", ch.RequestedBy, ch.GroupID,
+			err)
+	}
+}
+
+// changeAttempt returns the attempt of a change of a resource group to a state. A cron job that changes a group to
+// the state its last change failed to apply retries it once the retry delay of the last attempt is over, the
+// attempts whose members were only deferred do not count and are retried right away.
+func changeAttempt(last *models.RGChange, requestedBy string, state resource.ResourceState) (int, error) {
+	if requestedBy != resource.ActorSchedule && requestedBy != resource.ActorCalendar {
+		return 1, nil
+	}
+
+	if last == nil || last.Status != resource.OperationFailed || last.RequestedBy != requestedBy ||
+		last.State != string(state) {
+		return 1, nil
+	}
+
+	attempt := max(last.Attempt, 1)
+
+	if !failed(last) {
+		return attempt, nil
+	}
+
+	if last.CompletedAt != nil {
+		retryAt := last.CompletedAt.Add(retryDelay << (attempt - 1))
+
+		if time.Now().Before(retryAt) {
+			return 0, &errRetryLater{GroupID: last.GroupID, RetryAt: retryAt}
+		}
+	}
+
+	return attempt + 1, nil
+}
+
+// failed reports whether a member of the tiers changed by a change failed to change, the deferred members aside.
+// The members of the tiers skipped after the failure do not count.
+func failed(ch *models.RGChange) bool {
+	for _, m := range ch.Members[:tierOffset(ch, ch.Tier+1)] {
+		if !m.Succeeded && !m.Skipped && !m.Deferred {
+			return true
+		}
+	}
+
+	return false
+}
+
+// changeError returns the failure of the members of a change.
+func changeError(ch *models.RGChange) string {
+	errs := make([]string, 0)
+
+	for _, m := range ch.Members[:tierOffset(ch, ch.Tier+1)] {
+		if !m.Succeeded && !m.Skipped && !m.Deferred {
+			errs = append(errs, fmt.Sprintf("resource %d: %s", m.ResourceID, m.Error))
+		}
+	}
+
+	return fmt.Sprintf("failed to %s after %d attempts: %s", strings.ToLower(ch.State), ch.Attempt,
+		strings.Join(errs, "; "))
+}
+
+// changeResult returns the outcome of a state change of a resource group.
+func changeResult(ch *models.RGChange) *models.RGStateResult {
+	statuses := make([]string, 0, len(ch.Members))
+
+	for i := range ch.Members {
+		statuses = append(statuses, ch.Members[i].Status)
+	}
+
+	return &models.RGStateResult{GroupID: ch.GroupID, State: ch.State, Status: groupStatus(statuses),
+		ChangeID: ch.ID, ChangeStatus: ch.Status, Members: ch.Members}
+}
+
+// errChangeInProgress reports whether an error is returned for a resource group with a change in progress.
+func errChangeInProgress(err error) bool {
+	var inProgress *ErrChangeInProgress
+
+	return errors.As(err, &inProgress)
+}
@@ -1,8 +1,39 @@
 package resourcegroup
 
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
 type errInternalServer struct {
 }
 
 func (*errInternalServer) Error() string {
 	return "internal server error"
 }
+
+// ErrChangeInProgress is returned when the state of a resource group is changed while the tiers of a previous change
+// are still being changed.
+type ErrChangeInProgress struct {
+	GroupID int64 `json:"groupID"`
+}
+
+func (e *ErrChangeInProgress) Error() string {
+	return fmt.Sprintf("a state change is already in progress for resource group %d", e.GroupID)
+}
+
+func (*ErrChangeInProgress) StatusCode() int {
+	return http.StatusConflict
+}
+
+// errRetryLater is returned when a cron job retries a failed change of a resource group before its retry delay is
+// over.
+type errRetryLater struct {
+	GroupID int64
+	RetryAt time.Time
+}
+
+func (e *errRetryLater) Error() string {
+	return fmt.Sprintf("the change of resource group %d is retried at %s", e.GroupID, e.RetryAt.Format(time.RFC3339))
+}
@@ -0,0 +1,433 @@
+package resourcegroup
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// Layouts of the dates and times of an iCalendar file.
+const (
+	icsDate      = "20060102"
+	icsLocalTime = "20060102T150405"
+	icsUTCTime   = "20060102T150405Z"
+
+	// icsHorizonYears is how far ahead of the import the occurrences of a recurring event are read.
+	icsHorizonYears = 2
+	// maxOccurrences is the number of occurrences of a recurring event above which the calendar file is rejected.
+	maxOccurrences = 1000
+)
+
+var (
+	errNoEvents    = errors.New("the calendar file has no events")
+	errInvalidDate = errors.New("invalid date of a calendar event")
+	errBadEvent    = errors.New("calendar event without an end after its start")
+	errBadRule     = errors.New("unsupported recurrence of a calendar event")
+	errTooMany     = errors.New("too many occurrences of a recurring calendar event")
+)
+
+// icsEvent holds the properties of a VEVENT component that are read as calendar periods.
+type icsEvent struct {
+	summary    string
+	start, end time.Time
+	allDay     bool
+	rule       string
+	exdates    []time.Time
+}
+
+// recurrence is a recurrence rule (RRULE) of an event. The rule parts other than FREQ, INTERVAL, COUNT, UNTIL, WKST
+// and the plain week days of a weekly rule are not supported.
+type recurrence struct {
+	freq     string
+	interval int
+	count    int
+	until    time.Time
+	days     []time.Weekday
+}
+
+// icsWeekdays are the week days of a recurrence rule.
+var icsWeekdays = map[string]time.Weekday{"MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday, "SU": time.Sunday}
+
+// parseICS reads the events of an iCalendar (.ics) file as calendar periods. The dates, and the times without a time
+// zone, are read in loc. An all-day event without an end lasts one day, a timed event without an end is skipped.
+// The occurrences of a recurring event are read up to horizon, the events with a recurrence that cannot be expanded
+// are rejected. The properties of the components nested in an event, e.g. its alarms, are ignored.
+func parseICS(data string, loc *time.Location, horizon time.Time) ([]models.CalendarPeriod, error) {
+	var (
+		periods []models.CalendarPeriod
+		event   *icsEvent
+		depth   int
+		err     error
+	)
+
+	for _, line := range unfoldICS(data) {
+		name, params, value := splitICSLine(line)
+
+		switch {
+		case name == "BEGIN" && event != nil:
+			depth++
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			event = &icsEvent{}
+		case name == "END" && event != nil && depth > 0:
+			depth--
+		case name == "END" && strings.EqualFold(value, "VEVENT") && event != nil:
+			p, er := event.periods(horizon)
+			if er != nil {
+				return nil, er
+			}
+
+			periods = append(periods, p...)
+			event = nil
+		case event == nil || depth > 0:
+		case name == "SUMMARY":
+			event.summary = unescapeICS(value)
+		case name == "DTSTART":
+			event.start, event.allDay, err = parseICSTime(params, value, loc)
+		case name == "DTEND":
+			event.end, _, err = parseICSTime(params, value, loc)
+		case name == "RRULE":
+			event.rule = value
+		case name == "EXDATE":
+			err = event.exclude(params, value, loc)
+		case name == "RDATE":
+			err = errBadRule
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(periods) == 0 {
+		return nil, errNoEvents
+	}
+
+	return periods, nil
+}
+
+// exclude adds the dates of an EXDATE property to the occurrences excluded from the recurrence of an event.
+func (e *icsEvent) exclude(params map[string]string, value string, loc *time.Location) error {
+	for _, v := range strings.Split(value, ",") {
+		t, _, err := parseICSTime(params, v, loc)
+		if err != nil {
+			return err
+		}
+
+		e.exdates = append(e.exdates, t)
+	}
+
+	return nil
+}
+
+// periods returns the calendar periods of the occurrences of an event starting before horizon, a single period when
+// the event does not recur.
+func (e *icsEvent) periods(horizon time.Time) ([]models.CalendarPeriod, error) {
+	first, ok, err := e.period()
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	if e.rule == "" {
+		return []models.CalendarPeriod{first}, nil
+	}
+
+	rule, err := parseRRule(e.rule, e.start.Location())
+	if err != nil {
+		return nil, err
+	}
+
+	var periods []models.CalendarPeriod
+
+	err = rule.each(e.start, horizon, func(start time.Time) error {
+		if e.excluded(start) {
+			return nil
+		}
+
+		if len(periods) == maxOccurrences {
+			return errTooMany
+		}
+
+		p := models.CalendarPeriod{Name: first.Name, Start: start, End: start.Add(first.End.Sub(first.Start))}
+		if e.allDay {
+			days := int(math.Round(first.End.Sub(first.Start).Hours() / 24))
+			p.End = start.AddDate(0, 0, days)
+		}
+
+		periods = append(periods, p)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return periods, nil
+}
+
+func (e *icsEvent) excluded(start time.Time) bool {
+	for _, t := range e.exdates {
+		if t.Equal(start) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// period returns the calendar period of an event. It reports false for a timed event without an end.
+func (e *icsEvent) period() (models.CalendarPeriod, bool, error) {
+	if e.start.IsZero() {
+		return models.CalendarPeriod{}, false, errInvalidDate
+	}
+
+	end := e.end
+
+	if end.IsZero() {
+		if !e.allDay {
+			return models.CalendarPeriod{}, false, nil
+		}
+
+		end = e.start.AddDate(0, 0, 1)
+	}
+
+	if !end.After(e.start) {
+		return models.CalendarPeriod{}, false, errBadEvent
+	}
+
+	return models.CalendarPeriod{Name: e.summary, Start: e.start, End: end}, true, nil
+}
+
+// parseRRule parses the value of an RRULE property, e.g. FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TU. A date without a time
+// zone ending the recurrence is read in loc.
+func parseRRule(value string, loc *time.Location) (*recurrence, error) {
+	r := &recurrence{interval: 1}
+
+	for _, part := range strings.Split(value, ";") {
+		k, v, _ := strings.Cut(part, "=")
+
+		var err error
+
+		switch strings.ToUpper(k) {
+		case "FREQ":
+			r.freq = strings.ToUpper(v)
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(v)
+			if r.interval < 1 {
+				err = errBadRule
+			}
+		case "COUNT":
+			r.count, err = strconv.Atoi(v)
+			if r.count < 1 {
+				err = errBadRule
+			}
+		case "UNTIL":
+			var date bool
+
+			r.until, date, err = parseICSTime(nil, v, loc)
+			if date {
+				// A date ends the recurrence at the end of the day.
+				r.until = r.until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			}
+		case "WKST":
+		case "BYDAY":
+			err = r.parseDays(v)
+		default:
+			err = errBadRule
+		}
+
+		if err != nil {
+			return nil, errBadRule
+		}
+	}
+
+	switch r.freq {
+	case "DAILY", "MONTHLY", "YEARLY":
+		if len(r.days) > 0 {
+			return nil, errBadRule
+		}
+	case "WEEKLY":
+	default:
+		return nil, errBadRule
+	}
+
+	return r, nil
+}
+
+// parseDays parses the week days of a BYDAY rule part, the days with an ordinal, e.g. 1MO, are not supported.
+func (r *recurrence) parseDays(value string) error {
+	for _, d := range strings.Split(value, ",") {
+		day, ok := icsWeekdays[strings.ToUpper(d)]
+		if !ok {
+			return errBadRule
+		}
+
+		r.days = append(r.days, day)
+	}
+
+	return nil
+}
+
+// each calls fn with the start of every occurrence of a recurrence starting at start, up to horizon. The dates that
+// do not exist, e.g. the 31st of a shorter month, are skipped.
+func (r *recurrence) each(start, horizon time.Time, fn func(time.Time) error) error {
+	n := 0
+
+	for i := 0; ; i++ {
+		for _, t := range r.occurrences(start, i) {
+			if t.Before(start) {
+				continue
+			}
+
+			if !t.Before(horizon) || (!r.until.IsZero() && t.After(r.until)) || (r.count > 0 && n == r.count) {
+				return nil
+			}
+
+			n++
+
+			if err := fn(t); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// occurrences returns the starts of the occurrences of the i-th period of a recurrence, e.g. its i-th week.
+func (r *recurrence) occurrences(start time.Time, i int) []time.Time {
+	step := i * r.interval
+
+	var t time.Time
+
+	switch r.freq {
+	case "DAILY":
+		return []time.Time{start.AddDate(0, 0, step)}
+	case "MONTHLY":
+		t = start.AddDate(0, step, 0)
+	case "YEARLY":
+		t = start.AddDate(step, 0, 0)
+	default:
+		if len(r.days) == 0 {
+			return []time.Time{start.AddDate(0, 0, 7*step)}
+		}
+
+		// The days of a week are counted from its Monday.
+		monday := start.AddDate(0, 0, 7*step-(int(start.Weekday())+6)%7)
+		starts := make([]time.Time, 0, len(r.days))
+
+		for offset := 0; offset < 7; offset++ {
+			for _, d := range r.days {
+				if (int(d)+6)%7 == offset {
+					starts = append(starts, monday.AddDate(0, 0, offset))
+					break
+				}
+			}
+		}
+
+		return starts
+	}
+
+	if t.Day() != start.Day() {
+		return nil
+	}
+
+	return []time.Time{t}
+}
+
+// unfoldICS splits the content of an iCalendar file into lines, joining the lines folded by a leading space or tab.
+func unfoldICS(data string) []string {
+	raw := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+	lines := make([]string, 0, len(raw))
+
+	for _, l := range raw {
+		if (strings.HasPrefix(l, " ") || strings.HasPrefix(l, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += l[1:]
+			continue
+		}
+
+		if l = strings.TrimRight(l, "\r"); l != "" {
+			lines = append(lines, l)
+		}
+	}
+
+	return lines
+}
+
+// splitICSLine splits a content line, e.g. DTSTART;TZID=Europe/Berlin:20251224T090000, into its upper case name,
+// its parameters and its value. The colons of the quoted parameter values do not end the parameters.
+func splitICSLine(line string) (name string, params map[string]string, value string) {
+	quoted := false
+	end := len(line)
+
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		}
+
+		if r == ':' && !quoted {
+			end = i
+			break
+		}
+	}
+
+	if end < len(line) {
+		value = line[end+1:]
+	}
+
+	parts := strings.Split(line[:end], ";")
+	params = make(map[string]string, len(parts)-1)
+
+	for _, p := range parts[1:] {
+		k, v, _ := strings.Cut(p, "=")
+		params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+
+	return strings.ToUpper(parts[0]), params, strings.TrimSpace(value)
+}
+
+// parseICSTime parses a DATE or DATE-TIME value. It reports whether the value is a date.
+func parseICSTime(params map[string]string, value string, loc *time.Location) (time.Time, bool, error) {
+	if strings.EqualFold(params["VALUE"], "DATE") || len(value) == len(icsDate) {
+		t, err := time.ParseInLocation(icsDate, value, loc)
+		if err != nil {
+			return time.Time{}, false, errInvalidDate
+		}
+
+		return t, true, nil
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(icsUTCTime, value)
+		if err != nil {
+			return time.Time{}, false, errInvalidDate
+		}
+
+		return t, false, nil
+	}
+
+	if tzid := params["TZID"]; tzid != "" {
+		l, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, false, errInvalidDate
+		}
+
+		loc = l
+	}
+
+	t, err := time.ParseInLocation(icsLocalTime, value, loc)
+	if err != nil {
+		return time.Time{}, false, errInvalidDate
+	}
+
+	return t, false, nil
+}
+
+// icsUnescaper unescapes the special characters of an iCalendar text value.
+var icsUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, "\n", `\N`, "\n")
+
+func unescapeICS(value string) string {
+	return icsUnescaper.Replace(value)
+}
@@ -0,0 +1,130 @@
+package resourcegroup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func TestParseICS(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	require.NoError(t, err)
+
+	data := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART;VALUE=DATE:20251225\r\n" +
+		"DTEND;VALUE=DATE:20251227\r\n" +
+		"SUMMARY:Christmas\\, Boxing Day\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART;VALUE=DATE:20260101\r\n" +
+		"SUMMARY:New Year\r\n" +
+		" 's Day\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART;TZID=\"Europe/Berlin\":20250929T180000\r\n" +
+		"DTEND:20251003T060000Z\r\n" +
+		"SUMMARY:Quarter-end close\r\n" +
+		"BEGIN:VALARM\r\n" +
+		"ACTION:DISPLAY\r\n" +
+		"SUMMARY:Reminder\r\n" +
+		"DTSTART:20250929T170000Z\r\n" +
+		"END:VALARM\r\n" +
+		"END:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART:20250301T100000\r\n" +
+		"SUMMARY:Reminder without an end\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	periods, err := parseICS(data, kolkata, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.CalendarPeriod{
+		{Name: "Christmas, Boxing Day", Start: time.Date(2025, 12, 25, 0, 0, 0, 0, kolkata),
+			End: time.Date(2025, 12, 27, 0, 0, 0, 0, kolkata)},
+		{Name: "New Year's Day", Start: time.Date(2026, 1, 1, 0, 0, 0, 0, kolkata),
+			End: time.Date(2026, 1, 2, 0, 0, 0, 0, kolkata)},
+		{Name: "Quarter-end close", Start: time.Date(2025, 9, 29, 18, 0, 0, 0, berlin),
+			End: time.Date(2025, 10, 3, 6, 0, 0, 0, time.UTC)},
+	}, periods)
+}
+
+func TestParseICS_Invalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"no events":        "BEGIN:VCALENDAR\nEND:VCALENDAR\n",
+		"invalid date":     "BEGIN:VEVENT\nDTSTART;VALUE=DATE:2025-12-25\nEND:VEVENT\n",
+		"unknown zone":     "BEGIN:VEVENT\nDTSTART;TZID=Nowhere/Invalid:20251225T090000\nEND:VEVENT\n",
+		"missing start":    "BEGIN:VEVENT\nSUMMARY:Holiday\nEND:VEVENT\n",
+		"end before start": "BEGIN:VEVENT\nDTSTART:20251225T090000Z\nDTEND:20251225T080000Z\nEND:VEVENT\n",
+		"ordinal day":      "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251124\nRRULE:FREQ=MONTHLY;BYDAY=4TH\nEND:VEVENT\n",
+		"by month":         "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251225\nRRULE:FREQ=YEARLY;BYMONTH=12\nEND:VEVENT\n",
+		"hourly":           "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251225\nRRULE:FREQ=HOURLY\nEND:VEVENT\n",
+		"recurrence dates": "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20251225\nRDATE;VALUE=DATE:20261225\nEND:VEVENT\n",
+		"too many":         "BEGIN:VEVENT\nDTSTART:20200101T000000Z\nDTEND:20200101T010000Z\nRRULE:FREQ=DAILY\nEND:VEVENT\n",
+	} {
+		periods, err := parseICS(data, time.UTC, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		require.Error(t, err, name)
+		assert.Nil(t, periods, name)
+	}
+}
+
+func TestParseICS_Recurring(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	data := "BEGIN:VEVENT\n" +
+		"DTSTART;VALUE=DATE:20251225\n" +
+		"DTEND;VALUE=DATE:20251227\n" +
+		"RRULE:FREQ=YEARLY\n" +
+		"SUMMARY:Christmas\n" +
+		"END:VEVENT\n" +
+		// The weekly release freeze, except in the second week of November.
+		"BEGIN:VEVENT\n" +
+		"DTSTART;TZID=Europe/Berlin:20251024T180000\n" +
+		"DTEND;TZID=Europe/Berlin:20251024T200000\n" +
+		"RRULE:FREQ=WEEKLY;BYDAY=MO,FR;UNTIL=20251110T235959Z\n" +
+		"EXDATE;TZID=Europe/Berlin:20251107T180000\n" +
+		"SUMMARY:Freeze\n" +
+		"END:VEVENT\n" +
+		// The 31st of the months that have one.
+		"BEGIN:VEVENT\n" +
+		"DTSTART:20251031T220000Z\n" +
+		"DTEND:20251031T230000Z\n" +
+		"RRULE:FREQ=MONTHLY;COUNT=2\n" +
+		"SUMMARY:Month-end\n" +
+		"END:VEVENT\n"
+
+	periods, err := parseICS(data, time.UTC, time.Date(2027, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.CalendarPeriod{
+		{Name: "Christmas", Start: time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC),
+			End: time.Date(2025, 12, 27, 0, 0, 0, 0, time.UTC)},
+		{Name: "Christmas", Start: time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC),
+			End: time.Date(2026, 12, 27, 0, 0, 0, 0, time.UTC)},
+		{Name: "Freeze", Start: time.Date(2025, 10, 24, 18, 0, 0, 0, berlin),
+			End: time.Date(2025, 10, 24, 20, 0, 0, 0, berlin)},
+		{Name: "Freeze", Start: time.Date(2025, 10, 27, 18, 0, 0, 0, berlin),
+			End: time.Date(2025, 10, 27, 20, 0, 0, 0, berlin)},
+		{Name: "Freeze", Start: time.Date(2025, 10, 31, 18, 0, 0, 0, berlin),
+			End: time.Date(2025, 10, 31, 20, 0, 0, 0, berlin)},
+		{Name: "Freeze", Start: time.Date(2025, 11, 3, 18, 0, 0, 0, berlin),
+			End: time.Date(2025, 11, 3, 20, 0, 0, 0, berlin)},
+		{Name: "Freeze", Start: time.Date(2025, 11, 10, 18, 0, 0, 0, berlin),
+			End: time.Date(2025, 11, 10, 20, 0, 0, 0, berlin)},
+		{Name: "Month-end", Start: time.Date(2025, 10, 31, 22, 0, 0, 0, time.UTC),
+			End: time.Date(2025, 10, 31, 23, 0, 0, 0, time.UTC)},
+		{Name: "Month-end", Start: time.Date(2025, 12, 31, 22, 0, 0, 0, time.UTC),
+			End: time.Date(2025, 12, 31, 23, 0, 0, 0, time.UTC)},
+	}, periods)
+}
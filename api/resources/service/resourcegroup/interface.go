@@ -1,9 +1,12 @@
 package resourcegroup
 
 import (
+	"time"
+
 	"gofr.dev/pkg/gofr"
 
 	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/service/resource"
 )
 
 type RGStore interface {
@@ -16,8 +19,35 @@ type RGStore interface {
 	GetResourceIDs(ctx *gofr.Context, id int64) ([]int64, error)
 	AddResourcesToGroup(ctx *gofr.Context, groupID int64, resourceID []int64) error
 	RemoveResourceFromGroup(ctx *gofr.Context, groupID, resourceID int64) error
+	GetResourceTiers(ctx *gofr.Context, groupID int64) (map[int64]int, error)
+	SetResourceTiers(ctx *gofr.Context, groupID int64, tiers map[int64]int) error
+
+	InsertGroupChange(ctx *gofr.Context, ch *models.RGChange) error
+	UpdateGroupChange(ctx *gofr.Context, ch *models.RGChange) error
+	GetGroupChange(ctx *gofr.Context, groupID int64) (*models.RGChange, error)
+	GetGroupChangesByStatus(ctx *gofr.Context, status string) ([]models.RGChange, error)
+
+	GetSchedule(ctx *gofr.Context, groupID int64) (*models.Schedule, error)
+	GetAllSchedules(ctx *gofr.Context) ([]models.Schedule, error)
+	UpsertSchedule(ctx *gofr.Context, sch *models.Schedule) error
+	UpdateScheduleState(ctx *gofr.Context, groupID int64, state, lastError string) error
+	DeleteSchedule(ctx *gofr.Context, groupID int64) error
+
+	CreateCalendar(ctx *gofr.Context, cal *models.Calendar) error
+	GetCalendars(ctx *gofr.Context, cloudAccountID int64) ([]models.Calendar, error)
+	GetCalendarByID(ctx *gofr.Context, cloudAccountID, id int64) (*models.Calendar, error)
+	UpdateCalendar(ctx *gofr.Context, cal *models.Calendar) error
+	DeleteCalendar(ctx *gofr.Context, id int64) error
+	GetGroupCalendars(ctx *gofr.Context, groupID int64, at time.Time) ([]models.GroupCalendar, error)
+	GetOffCalendars(ctx *gofr.Context, at time.Time) ([]models.GroupCalendar, error)
+	AttachCalendar(ctx *gofr.Context, gc *models.GroupCalendar) error
+	DetachCalendar(ctx *gofr.Context, groupID, calendarID int64) (bool, error)
+	UpdateCalendarState(ctx *gofr.Context, groupID int64, state string) error
 }
 
 type ResourceService interface {
 	GetByID(ctx *gofr.Context, id int64) (*models.Resource, error)
+	ChangeState(ctx *gofr.Context, resDetails resource.ResourceDetails) error
+	CreateLock(ctx *gofr.Context, lock *models.Lock) (*models.Lock, error)
+	GetLocks(ctx *gofr.Context, cloudAccID, resourceID, groupID int64) ([]models.Lock, error)
 }
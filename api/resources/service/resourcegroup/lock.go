@@ -0,0 +1,19 @@
+package resourcegroup
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// LockGroup keeps all the members of a resource group from being suspended until the lock expires, including the
+// resources added to the group while the lock is held.
+func (s *Service) LockGroup(ctx *gofr.Context, cloudAccID, groupID int64, lock *models.Lock) (*models.Lock, error) {
+	if err := s.checkGroup(ctx, cloudAccID, groupID); err != nil {
+		return nil, err
+	}
+
+	lock.CloudAccountID, lock.ResourceID, lock.GroupID = cloudAccID, 0, groupID
+
+	return s.resSvc.CreateLock(ctx, lock)
+}
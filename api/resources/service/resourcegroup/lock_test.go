@@ -0,0 +1,49 @@
+package resourcegroup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func TestService_LockGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	mockResSvc := NewMockResourceService(ctrl)
+	svc := New(mockStore, mockResSvc)
+	ctx := &gofr.Context{}
+	until := time.Now().Add(time.Hour).UTC()
+	expected := &models.Lock{ID: 5, CloudAccountID: 1, GroupID: 2, Until: until, Author: "jane"}
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil)
+	mockResSvc.EXPECT().CreateLock(ctx, &models.Lock{CloudAccountID: 1, GroupID: 2, Until: until, Author: "jane"}).
+		Return(expected, nil)
+
+	lock, err := svc.LockGroup(ctx, 1, 2, &models.Lock{ResourceID: 7, Until: until, Author: "jane"})
+
+	require.NoError(t, err)
+	assert.Equal(t, expected, lock)
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(3)).Return(nil, nil)
+
+	lock, err = svc.LockGroup(ctx, 1, 3, &models.Lock{Until: until, Author: "jane"})
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "resource group", Value: "3"}, err)
+	assert.Nil(t, lock)
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(nil, assert.AnError)
+
+	lock, err = svc.LockGroup(ctx, 1, 2, &models.Lock{Until: until, Author: "jane"})
+
+	assert.Equal(t, &errInternalServer{}, err)
+	assert.Nil(t, lock)
+}
@@ -11,8 +11,10 @@ package resourcegroup
 
 import (
 	reflect "reflect"
+	time "time"
 
 	models "github.com/zopdev/zopdev/api/resources/models"
+	resource "github.com/zopdev/zopdev/api/resources/service/resource"
 	gomock "go.uber.org/mock/gomock"
 	gofr "gofr.dev/pkg/gofr"
 )
@@ -55,6 +57,34 @@ func (mr *MockRGStoreMockRecorder) AddResourcesToGroup(ctx, groupID, resourceID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddResourcesToGroup", reflect.TypeOf((*MockRGStore)(nil).AddResourcesToGroup), ctx, groupID, resourceID)
 }
 
+// AttachCalendar mocks base method.
+func (m *MockRGStore) AttachCalendar(ctx *gofr.Context, gc *models.GroupCalendar) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachCalendar", ctx, gc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachCalendar indicates an expected call of AttachCalendar.
+func (mr *MockRGStoreMockRecorder) AttachCalendar(ctx, gc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachCalendar", reflect.TypeOf((*MockRGStore)(nil).AttachCalendar), ctx, gc)
+}
+
+// CreateCalendar mocks base method.
+func (m *MockRGStore) CreateCalendar(ctx *gofr.Context, cal *models.Calendar) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCalendar", ctx, cal)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCalendar indicates an expected call of CreateCalendar.
+func (mr *MockRGStoreMockRecorder) CreateCalendar(ctx, cal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCalendar", reflect.TypeOf((*MockRGStore)(nil).CreateCalendar), ctx, cal)
+}
+
 // CreateResourceGroup mocks base method.
 func (m *MockRGStore) CreateResourceGroup(ctx *gofr.Context, resourceGroup *models.RGCreate) (int64, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +100,20 @@ func (mr *MockRGStoreMockRecorder) CreateResourceGroup(ctx, resourceGroup any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceGroup", reflect.TypeOf((*MockRGStore)(nil).CreateResourceGroup), ctx, resourceGroup)
 }
 
+// DeleteCalendar mocks base method.
+func (m *MockRGStore) DeleteCalendar(ctx *gofr.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCalendar", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCalendar indicates an expected call of DeleteCalendar.
+func (mr *MockRGStoreMockRecorder) DeleteCalendar(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCalendar", reflect.TypeOf((*MockRGStore)(nil).DeleteCalendar), ctx, id)
+}
+
 // DeleteResourceGroup mocks base method.
 func (m *MockRGStore) DeleteResourceGroup(ctx *gofr.Context, id int64) error {
 	m.ctrl.T.Helper()
@@ -84,6 +128,35 @@ func (mr *MockRGStoreMockRecorder) DeleteResourceGroup(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceGroup", reflect.TypeOf((*MockRGStore)(nil).DeleteResourceGroup), ctx, id)
 }
 
+// DeleteSchedule mocks base method.
+func (m *MockRGStore) DeleteSchedule(ctx *gofr.Context, groupID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", ctx, groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockRGStoreMockRecorder) DeleteSchedule(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockRGStore)(nil).DeleteSchedule), ctx, groupID)
+}
+
+// DetachCalendar mocks base method.
+func (m *MockRGStore) DetachCalendar(ctx *gofr.Context, groupID, calendarID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachCalendar", ctx, groupID, calendarID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachCalendar indicates an expected call of DetachCalendar.
+func (mr *MockRGStoreMockRecorder) DetachCalendar(ctx, groupID, calendarID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachCalendar", reflect.TypeOf((*MockRGStore)(nil).DetachCalendar), ctx, groupID, calendarID)
+}
+
 // GetAllResourceGroups mocks base method.
 func (m *MockRGStore) GetAllResourceGroups(ctx *gofr.Context, cloudAccID int64) ([]models.ResourceGroup, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +172,111 @@ func (mr *MockRGStoreMockRecorder) GetAllResourceGroups(ctx, cloudAccID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllResourceGroups", reflect.TypeOf((*MockRGStore)(nil).GetAllResourceGroups), ctx, cloudAccID)
 }
 
+// GetAllSchedules mocks base method.
+func (m *MockRGStore) GetAllSchedules(ctx *gofr.Context) ([]models.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSchedules", ctx)
+	ret0, _ := ret[0].([]models.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSchedules indicates an expected call of GetAllSchedules.
+func (mr *MockRGStoreMockRecorder) GetAllSchedules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSchedules", reflect.TypeOf((*MockRGStore)(nil).GetAllSchedules), ctx)
+}
+
+// GetCalendarByID mocks base method.
+func (m *MockRGStore) GetCalendarByID(ctx *gofr.Context, cloudAccountID, id int64) (*models.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendarByID", ctx, cloudAccountID, id)
+	ret0, _ := ret[0].(*models.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendarByID indicates an expected call of GetCalendarByID.
+func (mr *MockRGStoreMockRecorder) GetCalendarByID(ctx, cloudAccountID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendarByID", reflect.TypeOf((*MockRGStore)(nil).GetCalendarByID), ctx, cloudAccountID, id)
+}
+
+// GetCalendars mocks base method.
+func (m *MockRGStore) GetCalendars(ctx *gofr.Context, cloudAccountID int64) ([]models.Calendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendars", ctx, cloudAccountID)
+	ret0, _ := ret[0].([]models.Calendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendars indicates an expected call of GetCalendars.
+func (mr *MockRGStoreMockRecorder) GetCalendars(ctx, cloudAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendars", reflect.TypeOf((*MockRGStore)(nil).GetCalendars), ctx, cloudAccountID)
+}
+
+// GetGroupCalendars mocks base method.
+func (m *MockRGStore) GetGroupCalendars(ctx *gofr.Context, groupID int64, at time.Time) ([]models.GroupCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupCalendars", ctx, groupID, at)
+	ret0, _ := ret[0].([]models.GroupCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupCalendars indicates an expected call of GetGroupCalendars.
+func (mr *MockRGStoreMockRecorder) GetGroupCalendars(ctx, groupID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupCalendars", reflect.TypeOf((*MockRGStore)(nil).GetGroupCalendars), ctx, groupID, at)
+}
+
+// GetGroupChange mocks base method.
+func (m *MockRGStore) GetGroupChange(ctx *gofr.Context, groupID int64) (*models.RGChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupChange", ctx, groupID)
+	ret0, _ := ret[0].(*models.RGChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupChange indicates an expected call of GetGroupChange.
+func (mr *MockRGStoreMockRecorder) GetGroupChange(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupChange", reflect.TypeOf((*MockRGStore)(nil).GetGroupChange), ctx, groupID)
+}
+
+// GetGroupChangesByStatus mocks base method.
+func (m *MockRGStore) GetGroupChangesByStatus(ctx *gofr.Context, status string) ([]models.RGChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupChangesByStatus", ctx, status)
+	ret0, _ := ret[0].([]models.RGChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupChangesByStatus indicates an expected call of GetGroupChangesByStatus.
+func (mr *MockRGStoreMockRecorder) GetGroupChangesByStatus(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupChangesByStatus", reflect.TypeOf((*MockRGStore)(nil).GetGroupChangesByStatus), ctx, status)
+}
+
+// GetOffCalendars mocks base method.
+func (m *MockRGStore) GetOffCalendars(ctx *gofr.Context, at time.Time) ([]models.GroupCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOffCalendars", ctx, at)
+	ret0, _ := ret[0].([]models.GroupCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOffCalendars indicates an expected call of GetOffCalendars.
+func (mr *MockRGStoreMockRecorder) GetOffCalendars(ctx, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOffCalendars", reflect.TypeOf((*MockRGStore)(nil).GetOffCalendars), ctx, at)
+}
+
 // GetResourceGroupByID mocks base method.
 func (m *MockRGStore) GetResourceGroupByID(ctx *gofr.Context, cloudAccID, id int64) (*models.ResourceGroup, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +307,50 @@ func (mr *MockRGStoreMockRecorder) GetResourceIDs(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceIDs", reflect.TypeOf((*MockRGStore)(nil).GetResourceIDs), ctx, id)
 }
 
+// GetResourceTiers mocks base method.
+func (m *MockRGStore) GetResourceTiers(ctx *gofr.Context, groupID int64) (map[int64]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceTiers", ctx, groupID)
+	ret0, _ := ret[0].(map[int64]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceTiers indicates an expected call of GetResourceTiers.
+func (mr *MockRGStoreMockRecorder) GetResourceTiers(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceTiers", reflect.TypeOf((*MockRGStore)(nil).GetResourceTiers), ctx, groupID)
+}
+
+// GetSchedule mocks base method.
+func (m *MockRGStore) GetSchedule(ctx *gofr.Context, groupID int64) (*models.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedule", ctx, groupID)
+	ret0, _ := ret[0].(*models.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedule indicates an expected call of GetSchedule.
+func (mr *MockRGStoreMockRecorder) GetSchedule(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedule", reflect.TypeOf((*MockRGStore)(nil).GetSchedule), ctx, groupID)
+}
+
+// InsertGroupChange mocks base method.
+func (m *MockRGStore) InsertGroupChange(ctx *gofr.Context, ch *models.RGChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertGroupChange", ctx, ch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertGroupChange indicates an expected call of InsertGroupChange.
+func (mr *MockRGStoreMockRecorder) InsertGroupChange(ctx, ch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertGroupChange", reflect.TypeOf((*MockRGStore)(nil).InsertGroupChange), ctx, ch)
+}
+
 // RemoveResourceFromGroup mocks base method.
 func (m *MockRGStore) RemoveResourceFromGroup(ctx *gofr.Context, groupID, resourceID int64) error {
 	m.ctrl.T.Helper()
@@ -143,6 +365,62 @@ func (mr *MockRGStoreMockRecorder) RemoveResourceFromGroup(ctx, groupID, resourc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveResourceFromGroup", reflect.TypeOf((*MockRGStore)(nil).RemoveResourceFromGroup), ctx, groupID, resourceID)
 }
 
+// SetResourceTiers mocks base method.
+func (m *MockRGStore) SetResourceTiers(ctx *gofr.Context, groupID int64, tiers map[int64]int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetResourceTiers", ctx, groupID, tiers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetResourceTiers indicates an expected call of SetResourceTiers.
+func (mr *MockRGStoreMockRecorder) SetResourceTiers(ctx, groupID, tiers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetResourceTiers", reflect.TypeOf((*MockRGStore)(nil).SetResourceTiers), ctx, groupID, tiers)
+}
+
+// UpdateCalendar mocks base method.
+func (m *MockRGStore) UpdateCalendar(ctx *gofr.Context, cal *models.Calendar) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCalendar", ctx, cal)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCalendar indicates an expected call of UpdateCalendar.
+func (mr *MockRGStoreMockRecorder) UpdateCalendar(ctx, cal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCalendar", reflect.TypeOf((*MockRGStore)(nil).UpdateCalendar), ctx, cal)
+}
+
+// UpdateCalendarState mocks base method.
+func (m *MockRGStore) UpdateCalendarState(ctx *gofr.Context, groupID int64, state string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCalendarState", ctx, groupID, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCalendarState indicates an expected call of UpdateCalendarState.
+func (mr *MockRGStoreMockRecorder) UpdateCalendarState(ctx, groupID, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCalendarState", reflect.TypeOf((*MockRGStore)(nil).UpdateCalendarState), ctx, groupID, state)
+}
+
+// UpdateGroupChange mocks base method.
+func (m *MockRGStore) UpdateGroupChange(ctx *gofr.Context, ch *models.RGChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroupChange", ctx, ch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGroupChange indicates an expected call of UpdateGroupChange.
+func (mr *MockRGStoreMockRecorder) UpdateGroupChange(ctx, ch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupChange", reflect.TypeOf((*MockRGStore)(nil).UpdateGroupChange), ctx, ch)
+}
+
 // UpdateResourceGroup mocks base method.
 func (m *MockRGStore) UpdateResourceGroup(ctx *gofr.Context, resourceGroup *models.RGUpdate) error {
 	m.ctrl.T.Helper()
@@ -157,6 +435,34 @@ func (mr *MockRGStoreMockRecorder) UpdateResourceGroup(ctx, resourceGroup any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceGroup", reflect.TypeOf((*MockRGStore)(nil).UpdateResourceGroup), ctx, resourceGroup)
 }
 
+// UpdateScheduleState mocks base method.
+func (m *MockRGStore) UpdateScheduleState(ctx *gofr.Context, groupID int64, state, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduleState", ctx, groupID, state, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateScheduleState indicates an expected call of UpdateScheduleState.
+func (mr *MockRGStoreMockRecorder) UpdateScheduleState(ctx, groupID, state, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduleState", reflect.TypeOf((*MockRGStore)(nil).UpdateScheduleState), ctx, groupID, state, lastError)
+}
+
+// UpsertSchedule mocks base method.
+func (m *MockRGStore) UpsertSchedule(ctx *gofr.Context, sch *models.Schedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSchedule", ctx, sch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertSchedule indicates an expected call of UpsertSchedule.
+func (mr *MockRGStoreMockRecorder) UpsertSchedule(ctx, sch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSchedule", reflect.TypeOf((*MockRGStore)(nil).UpsertSchedule), ctx, sch)
+}
+
 // MockResourceService is a mock of ResourceService interface.
 type MockResourceService struct {
 	ctrl     *gomock.Controller
@@ -181,6 +487,35 @@ func (m *MockResourceService) EXPECT() *MockResourceServiceMockRecorder {
 	return m.recorder
 }
 
+// ChangeState mocks base method.
+func (m *MockResourceService) ChangeState(ctx *gofr.Context, resDetails resource.ResourceDetails) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeState", ctx, resDetails)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeState indicates an expected call of ChangeState.
+func (mr *MockResourceServiceMockRecorder) ChangeState(ctx, resDetails any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeState", reflect.TypeOf((*MockResourceService)(nil).ChangeState), ctx, resDetails)
+}
+
+// CreateLock mocks base method.
+func (m *MockResourceService) CreateLock(ctx *gofr.Context, lock *models.Lock) (*models.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLock", ctx, lock)
+	ret0, _ := ret[0].(*models.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLock indicates an expected call of CreateLock.
+func (mr *MockResourceServiceMockRecorder) CreateLock(ctx, lock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLock", reflect.TypeOf((*MockResourceService)(nil).CreateLock), ctx, lock)
+}
+
 // GetByID mocks base method.
 func (m *MockResourceService) GetByID(ctx *gofr.Context, id int64) (*models.Resource, error) {
 	m.ctrl.T.Helper()
@@ -195,3 +530,18 @@ func (mr *MockResourceServiceMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockResourceService)(nil).GetByID), ctx, id)
 }
+
+// GetLocks mocks base method.
+func (m *MockResourceService) GetLocks(ctx *gofr.Context, cloudAccID, resourceID, groupID int64) ([]models.Lock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocks", ctx, cloudAccID, resourceID, groupID)
+	ret0, _ := ret[0].([]models.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocks indicates an expected call of GetLocks.
+func (mr *MockResourceServiceMockRecorder) GetLocks(ctx, cloudAccID, resourceID, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocks", reflect.TypeOf((*MockResourceService)(nil).GetLocks), ctx, cloudAccID, resourceID, groupID)
+}
@@ -0,0 +1,258 @@
+package resourcegroup
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/service/resource"
+)
+
+// clockFormat is the layout of the start and stop times of a schedule window.
+const clockFormat = "15:04"
+
+// weekdays maps the days of a schedule window to the days of the week.
+var weekdays = map[string]time.Weekday{
+	"SUN": time.Sunday, "MON": time.Monday, "TUE": time.Tuesday, "WED": time.Wednesday,
+	"THU": time.Thursday, "FRI": time.Friday, "SAT": time.Saturday,
+}
+
+// GetSchedule returns the schedule of a resource group.
+func (s *Service) GetSchedule(ctx *gofr.Context, cloudAccID, groupID int64) (*models.Schedule, error) {
+	if err := s.checkGroup(ctx, cloudAccID, groupID); err != nil {
+		return nil, err
+	}
+
+	sch, err := s.grpStore.GetSchedule(ctx, groupID)
+	if err != nil {
+		return nil, &errInternalServer{}
+	}
+
+	if sch == nil {
+		return nil, gofrHttp.ErrorEntityNotFound{Name: "schedule", Value: strconv.FormatInt(groupID, 10)}
+	}
+
+	sch.CloudAccountID = cloudAccID
+
+	return sch, nil
+}
+
+// SetSchedule creates or replaces the schedule of a resource group. The new windows are applied to the members of
+// the group by the next scheduler run.
+func (s *Service) SetSchedule(ctx *gofr.Context, cloudAccID, groupID int64,
+	sch *models.Schedule) (*models.Schedule, error) {
+	if err := validateSchedule(sch); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkGroup(ctx, cloudAccID, groupID); err != nil {
+		return nil, err
+	}
+
+	sch.GroupID = groupID
+
+	if err := s.grpStore.UpsertSchedule(ctx, sch); err != nil {
+		return nil, &errInternalServer{}
+	}
+
+	return s.GetSchedule(ctx, cloudAccID, groupID)
+}
+
+// DeleteSchedule deletes the schedule of a resource group, the members keep their current state.
+func (s *Service) DeleteSchedule(ctx *gofr.Context, cloudAccID, groupID int64) error {
+	if _, err := s.GetSchedule(ctx, cloudAccID, groupID); err != nil {
+		return err
+	}
+
+	if err := s.grpStore.DeleteSchedule(ctx, groupID); err != nil {
+		return &errInternalServer{}
+	}
+
+	return nil
+}
+
+// ScheduleCron is a cron job that starts the members of the scheduled resource groups when an on window begins and
+// suspends them when it ends. A group is only acted on when its state changes, members started or suspended by a
+// user in between are left alone until the next change. A group is not started during an off period of its calendars.
+func (s *Service) ScheduleCron(ctx *gofr.Context) {
+	schedules, err := s.grpStore.GetAllSchedules(ctx)
+	if err != nil {
+		ctx.Errorf("failed to get resource group schedules: %v", err)
+		return
+	}
+
+	now := time.Now()
+
+	off, err := s.offGroups(ctx, now)
+	if err != nil {
+		ctx.Errorf("failed to get the off calendars of resource groups: %v", err)
+		return
+	}
+
+	for i := range schedules {
+		loc, err := time.LoadLocation(schedules[i].Timezone)
+		if err != nil {
+			ctx.Errorf("invalid timezone %q of the schedule of resource group %d: %v",
+				schedules[i].Timezone, schedules[i].GroupID, err)
+
+			continue
+		}
+
+		state := scheduledState(schedules[i].Windows, now.In(loc))
+		if string(state) == schedules[i].LastState {
+			continue
+		}
+
+		// A keep-awake lock on the group defers the suspend until it expires.
+		if state == resource.SUSPEND && s.groupLocked(ctx, schedules[i].CloudAccountID, schedules[i].GroupID) {
+			continue
+		}
+
+		// The group stays suspended until the off period of its calendar ends.
+		if state == resource.START && off[schedules[i].GroupID] {
+			continue
+		}
+
+		s.applyGroupState(ctx, schedules[i].CloudAccountID, schedules[i].GroupID, state, resource.ActorSchedule)
+	}
+}
+
+// applyGroupState starts a state change of the members of a resource group on behalf of a cron job. The state is
+// recorded once the change completes with all the members changed, a group whose members fail to change is retried
+// with a growing delay until it gives up and one whose members are deferred is retried by the next run. A group with
+// a change still in progress is skipped.
+func (s *Service) applyGroupState(ctx *gofr.Context, cloudAccID, groupID int64, state resource.ResourceState,
+	actor string) {
+	_, err := s.startChange(ctx, cloudAccID, groupID, state, actor, defaultTierTimeout)
+
+	var retryLater *errRetryLater
+
+	switch {
+	case errChangeInProgress(err):
+		ctx.Infof("resource group %d is still being changed by an earlier change", groupID)
+	case errors.As(err, &retryLater):
+		ctx.Debugf("retrying the change of resource group %d at %s", groupID, retryLater.RetryAt.Format(time.RFC3339))
+	case err != nil:
+		ctx.Errorf("failed to change the state of resource group %d: %v", groupID, err)
+	}
+}
+
+// groupLocked reports whether a keep-awake lock is held on a resource group.
+func (s *Service) groupLocked(ctx *gofr.Context, cloudAccID, groupID int64) bool {
+	locks, err := s.resSvc.GetLocks(ctx, cloudAccID, 0, groupID)
+	if err != nil {
+		ctx.Errorf("failed to get the locks of resource group %d: %v", groupID, err)
+		return true
+	}
+
+	return len(locks) > 0
+}
+
+// checkGroup returns an error when the resource group does not exist in the cloud account.
+func (s *Service) checkGroup(ctx *gofr.Context, cloudAccID, groupID int64) error {
+	grp, err := s.grpStore.GetResourceGroupByID(ctx, cloudAccID, groupID)
+	if err != nil {
+		return &errInternalServer{}
+	}
+
+	if grp == nil {
+		return gofrHttp.ErrorEntityNotFound{Name: "resource group", Value: strconv.FormatInt(groupID, 10)}
+	}
+
+	return nil
+}
+
+func validateSchedule(sch *models.Schedule) error {
+	if sch.Timezone == "" {
+		sch.Timezone = "UTC"
+	}
+
+	if _, err := time.LoadLocation(sch.Timezone); err != nil {
+		return gofrHttp.ErrorInvalidParam{Params: []string{"timezone"}}
+	}
+
+	if len(sch.Windows) == 0 {
+		return gofrHttp.ErrorMissingParam{Params: []string{"windows"}}
+	}
+
+	for i := range sch.Windows {
+		w := &sch.Windows[i]
+
+		if len(w.Days) == 0 {
+			return gofrHttp.ErrorMissingParam{Params: []string{"windows.days"}}
+		}
+
+		for j := range w.Days {
+			w.Days[j] = strings.ToUpper(w.Days[j])
+
+			if _, ok := weekdays[w.Days[j]]; !ok {
+				return gofrHttp.ErrorInvalidParam{Params: []string{"windows.days"}}
+			}
+		}
+
+		start, err := clockMinutes(w.Start)
+		if err != nil {
+			return gofrHttp.ErrorInvalidParam{Params: []string{"windows.start"}}
+		}
+
+		stop, err := clockMinutes(w.Stop)
+		if err != nil || stop == start {
+			return gofrHttp.ErrorInvalidParam{Params: []string{"windows.stop"}}
+		}
+	}
+
+	return nil
+}
+
+// scheduledState returns the state of the members of a resource group at a time of the time zone of its schedule:
+// START within an on window and SUSPEND outside all of them.
+func scheduledState(windows models.ScheduleWindows, now time.Time) resource.ResourceState {
+	minute := now.Hour()*60 + now.Minute()
+	today := now.Weekday()
+	yesterday := (today + 6) % 7
+
+	for i := range windows {
+		start, err := clockMinutes(windows[i].Start)
+		if err != nil {
+			continue
+		}
+
+		stop, err := clockMinutes(windows[i].Stop)
+		if err != nil {
+			continue
+		}
+
+		for _, d := range windows[i].Days {
+			day, ok := weekdays[d]
+			if !ok {
+				continue
+			}
+
+			if start < stop && day == today && minute >= start && minute < stop {
+				return resource.START
+			}
+
+			// An overnight window runs from its start time on its day to its stop time on the next day.
+			if start > stop && ((day == today && minute >= start) || (day == yesterday && minute < stop)) {
+				return resource.START
+			}
+		}
+	}
+
+	return resource.SUSPEND
+}
+
+// clockMinutes returns the minutes since midnight of a time of day in the HH:MM format.
+func clockMinutes(clock string) (int, error) {
+	t, err := time.Parse(clockFormat, clock)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
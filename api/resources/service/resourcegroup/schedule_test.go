@@ -0,0 +1,462 @@
+package resourcegroup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/service/resource"
+)
+
+func TestScheduledState(t *testing.T) {
+	windows := models.ScheduleWindows{
+		{Days: []string{"MON", "TUE", "WED", "THU", "FRI"}, Start: "08:00", Stop: "20:00"},
+		{Days: []string{"SAT"}, Start: "22:00", Stop: "02:00"},
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		exp  resource.ResourceState
+	}{
+		{"weekday within window", time.Date(2025, 7, 7, 8, 0, 0, 0, time.UTC), resource.START},
+		{"weekday at stop time", time.Date(2025, 7, 7, 20, 0, 0, 0, time.UTC), resource.SUSPEND},
+		{"weekday before start time", time.Date(2025, 7, 11, 7, 59, 0, 0, time.UTC), resource.SUSPEND},
+		{"overnight window on its day", time.Date(2025, 7, 12, 23, 30, 0, 0, time.UTC), resource.START},
+		{"overnight window on next day", time.Date(2025, 7, 13, 1, 59, 0, 0, time.UTC), resource.START},
+		{"after overnight window", time.Date(2025, 7, 13, 2, 0, 0, 0, time.UTC), resource.SUSPEND},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.exp, scheduledState(windows, tc.now))
+		})
+	}
+}
+
+func TestValidateSchedule(t *testing.T) {
+	sch := &models.Schedule{Windows: models.ScheduleWindows{{Days: []string{"mon"}, Start: "08:00", Stop: "20:00"}}}
+
+	require.NoError(t, validateSchedule(sch))
+	assert.Equal(t, "UTC", sch.Timezone)
+	assert.Equal(t, []string{"MON"}, sch.Windows[0].Days)
+
+	tests := []struct {
+		name   string
+		sch    *models.Schedule
+		expErr error
+	}{
+		{"invalid timezone", &models.Schedule{Timezone: "Mars/Olympus"},
+			gofrHttp.ErrorInvalidParam{Params: []string{"timezone"}}},
+		{"missing windows", &models.Schedule{Timezone: "Europe/Paris"},
+			gofrHttp.ErrorMissingParam{Params: []string{"windows"}}},
+		{"missing days", &models.Schedule{Windows: models.ScheduleWindows{{Start: "08:00", Stop: "20:00"}}},
+			gofrHttp.ErrorMissingParam{Params: []string{"windows.days"}}},
+		{"invalid day", &models.Schedule{Windows: models.ScheduleWindows{{Days: []string{"MONDAY"}}}},
+			gofrHttp.ErrorInvalidParam{Params: []string{"windows.days"}}},
+		{"invalid start", &models.Schedule{Windows: models.ScheduleWindows{{Days: []string{"MON"}, Start: "8am"}}},
+			gofrHttp.ErrorInvalidParam{Params: []string{"windows.start"}}},
+		{"empty window", &models.Schedule{Windows: models.ScheduleWindows{
+			{Days: []string{"MON"}, Start: "08:00", Stop: "08:00"}}},
+			gofrHttp.ErrorInvalidParam{Params: []string{"windows.stop"}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expErr, validateSchedule(tc.sch))
+		})
+	}
+}
+
+func TestService_SetSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{}
+	sch := &models.Schedule{Timezone: "Europe/Berlin",
+		Windows: models.ScheduleWindows{{Days: []string{"MON"}, Start: "08:00", Stop: "20:00"}}}
+	stored := &models.Schedule{GroupID: 2, Timezone: "Europe/Berlin", Windows: sch.Windows}
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil).Times(2)
+	mockStore.EXPECT().UpsertSchedule(ctx, &models.Schedule{GroupID: 2, Timezone: "Europe/Berlin",
+		Windows: sch.Windows}).Return(nil)
+	mockStore.EXPECT().GetSchedule(ctx, int64(2)).Return(stored, nil)
+
+	res, err := svc.SetSchedule(ctx, 1, 2, sch)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.Schedule{GroupID: 2, CloudAccountID: 1, Timezone: "Europe/Berlin",
+		Windows: sch.Windows}, res)
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(3)).Return(nil, nil)
+
+	_, err = svc.SetSchedule(ctx, 1, 3, sch)
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "resource group", Value: "3"}, err)
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil)
+	mockStore.EXPECT().UpsertSchedule(ctx, gomock.Any()).Return(assert.AnError)
+
+	_, err = svc.SetSchedule(ctx, 1, 2, sch)
+
+	assert.Equal(t, &errInternalServer{}, err)
+
+	_, err = svc.SetSchedule(ctx, 1, 2, &models.Schedule{Timezone: "UTC"})
+
+	assert.Equal(t, gofrHttp.ErrorMissingParam{Params: []string{"windows"}}, err)
+}
+
+func TestService_GetSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{}
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil).Times(3)
+	mockStore.EXPECT().GetSchedule(ctx, int64(2)).Return(nil, nil)
+
+	_, err := svc.GetSchedule(ctx, 1, 2)
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "schedule", Value: "2"}, err)
+
+	mockStore.EXPECT().GetSchedule(ctx, int64(2)).Return(nil, assert.AnError)
+
+	_, err = svc.GetSchedule(ctx, 1, 2)
+
+	assert.Equal(t, &errInternalServer{}, err)
+
+	mockStore.EXPECT().GetSchedule(ctx, int64(2)).Return(&models.Schedule{GroupID: 2, Timezone: "UTC"}, nil)
+
+	res, err := svc.GetSchedule(ctx, 1, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.Schedule{GroupID: 2, CloudAccountID: 1, Timezone: "UTC"}, res)
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(nil, assert.AnError)
+
+	_, err = svc.GetSchedule(ctx, 1, 2)
+
+	assert.Equal(t, &errInternalServer{}, err)
+}
+
+func TestService_DeleteSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{}
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil).Times(3)
+	mockStore.EXPECT().GetSchedule(ctx, int64(2)).Return(&models.Schedule{GroupID: 2}, nil).Times(2)
+	mockStore.EXPECT().DeleteSchedule(ctx, int64(2)).Return(nil)
+
+	require.NoError(t, svc.DeleteSchedule(ctx, 1, 2))
+
+	mockStore.EXPECT().DeleteSchedule(ctx, int64(2)).Return(assert.AnError)
+
+	assert.Equal(t, &errInternalServer{}, svc.DeleteSchedule(ctx, 1, 2))
+
+	mockStore.EXPECT().GetSchedule(ctx, int64(2)).Return(nil, nil)
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "schedule", Value: "2"}, svc.DeleteSchedule(ctx, 1, 2))
+}
+
+func TestService_ScheduleCron(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mockStore := NewMockRGStore(ctrl)
+	mockResSvc := NewMockResourceService(ctrl)
+	svc := New(mockStore, mockResSvc)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	always := models.ScheduleWindows{{Days: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"},
+		Start: "00:00", Stop: "23:59"}}
+	state := scheduledState(always, time.Now().UTC())
+
+	mockStore.EXPECT().GetAllSchedules(ctx).Return([]models.Schedule{
+		// The state of the group is already applied.
+		{GroupID: 1, CloudAccountID: 3, Timezone: "UTC", Windows: always, LastState: string(state)},
+		// The members that are not started or suspended are skipped.
+		{GroupID: 2, CloudAccountID: 3, Timezone: "UTC", Windows: always},
+		// The members of the groups are retried on the next run.
+		{GroupID: 3, CloudAccountID: 3, Timezone: "UTC", Windows: always},
+		{GroupID: 4, CloudAccountID: 3, Timezone: "UTC", Windows: always},
+		{GroupID: 5, CloudAccountID: 3, Timezone: "Nowhere/Invalid", Windows: always},
+	}, nil)
+	mockStore.EXPECT().GetOffCalendars(ctx, gomock.Any()).Return(nil, nil)
+	mockStore.EXPECT().GetGroupChange(ctx, gomock.Any()).Return(nil, nil).Times(3)
+	mockStore.EXPECT().InsertGroupChange(ctx, gomock.Any()).Return(nil).Times(2)
+	mockStore.EXPECT().GetResourceIDs(ctx, int64(2)).Return([]int64{10, 13}, nil)
+	mockStore.EXPECT().GetResourceTiers(ctx, int64(2)).Return(map[int64]int{}, nil)
+	mockResSvc.EXPECT().GetByID(ctx, int64(10)).Return(&models.Resource{ID: 10, Name: "db", Type: "SQL"}, nil).Times(2)
+	mockResSvc.EXPECT().GetByID(ctx, int64(13)).Return(&models.Resource{ID: 13, Name: "disk", Type: "GCP_DISK"}, nil)
+	mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 10, CloudAccID: 3, Name: "db", Type: "SQL",
+		State: state, RequestedBy: resource.ActorSchedule}).Return(nil)
+	mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 13, CloudAccID: 3, Name: "disk",
+		Type: "GCP_DISK", State: state, RequestedBy: resource.ActorSchedule}).
+		Return(&resource.ErrNotChangeable{ResourceID: 13, Type: "GCP_DISK"})
+	mockStore.EXPECT().UpdateScheduleState(ctx, int64(2), string(state), "").Return(nil)
+	mockStore.EXPECT().GetResourceIDs(ctx, int64(3)).Return([]int64{11, 12}, nil)
+	mockStore.EXPECT().GetResourceTiers(ctx, int64(3)).Return(map[int64]int{}, nil)
+	mockResSvc.EXPECT().GetByID(ctx, int64(11)).Return(nil, assert.AnError)
+	mockResSvc.EXPECT().GetByID(ctx, int64(12)).Return(&models.Resource{ID: 12, Name: "vm", Type: "GCE"}, nil)
+	mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 12, CloudAccID: 3, Name: "vm", Type: "GCE",
+		State: state, RequestedBy: resource.ActorSchedule}).Return(assert.AnError)
+	mockStore.EXPECT().GetResourceIDs(ctx, int64(4)).Return(nil, assert.AnError)
+	// The groups are checked for locks when the window has just closed.
+	mockResSvc.EXPECT().GetLocks(ctx, int64(3), int64(0), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	svc.ScheduleCron(ctx)
+
+	mockStore.EXPECT().GetAllSchedules(ctx).Return(nil, assert.AnError)
+
+	svc.ScheduleCron(ctx)
+}
+
+func TestService_ScheduleCron_Locked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mockStore := NewMockRGStore(ctrl)
+	mockResSvc := NewMockResourceService(ctrl)
+	svc := New(mockStore, mockResSvc)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+
+	// A schedule without windows keeps the group suspended.
+	mockStore.EXPECT().GetAllSchedules(ctx).Return([]models.Schedule{
+		{GroupID: 2, CloudAccountID: 3, Timezone: "UTC", LastState: string(resource.START)},
+		{GroupID: 4, CloudAccountID: 3, Timezone: "UTC", LastState: string(resource.START)},
+		// A member of the group is kept awake on its own.
+		{GroupID: 7, CloudAccountID: 3, Timezone: "UTC", LastState: string(resource.START)},
+	}, nil)
+	mockStore.EXPECT().GetOffCalendars(ctx, gomock.Any()).Return(nil, nil)
+	mockResSvc.EXPECT().GetLocks(ctx, int64(3), int64(0), int64(2)).
+		Return([]models.Lock{{ID: 1, CloudAccountID: 3, GroupID: 2, Author: "jane"}}, nil)
+	mockResSvc.EXPECT().GetLocks(ctx, int64(3), int64(0), int64(4)).Return(nil, assert.AnError)
+	mockResSvc.EXPECT().GetLocks(ctx, int64(3), int64(0), int64(7)).Return(nil, nil)
+	mockStore.EXPECT().GetResourceIDs(ctx, int64(7)).Return([]int64{20, 21}, nil)
+	mockStore.EXPECT().GetResourceTiers(ctx, int64(7)).Return(map[int64]int{}, nil)
+	mockStore.EXPECT().GetGroupChange(ctx, int64(7)).Return(nil, nil)
+	mockStore.EXPECT().InsertGroupChange(ctx, gomock.Any()).Return(nil)
+	mockResSvc.EXPECT().GetByID(ctx, int64(20)).Return(&models.Resource{ID: 20, Name: "vm", Type: "GCE"}, nil)
+	mockResSvc.EXPECT().GetByID(ctx, int64(21)).Return(&models.Resource{ID: 21, Name: "db", Type: "SQL"}, nil).
+		Times(2)
+	mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 20, CloudAccID: 3, Name: "vm", Type: "GCE",
+		State: resource.SUSPEND, RequestedBy: resource.ActorSchedule}).
+		Return(&resource.ErrResourceLocked{ResourceID: 20, Author: "jane", Until: time.Now().Add(time.Hour)})
+	mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 21, CloudAccID: 3, Name: "db", Type: "SQL",
+		State: resource.SUSPEND, RequestedBy: resource.ActorSchedule}).Return(nil)
+
+	// The suspend of the locked groups and members is not recorded, it is retried on the next run.
+	svc.ScheduleCron(ctx)
+}
+
+func TestService_ScheduleCron_OffCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	days := []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+	// The windows cover the whole day.
+	alwaysOn := models.ScheduleWindows{{Days: days, Start: "00:00", Stop: "12:00"},
+		{Days: days, Start: "12:00", Stop: "00:00"}}
+
+	mockStore.EXPECT().GetAllSchedules(ctx).Return([]models.Schedule{
+		{GroupID: 6, CloudAccountID: 3, Timezone: "UTC", Windows: alwaysOn, LastState: string(resource.SUSPEND)},
+	}, nil)
+	mockStore.EXPECT().GetOffCalendars(ctx, gomock.Any()).Return([]models.GroupCalendar{
+		{GroupID: 6, CloudAccountID: 3, CalendarID: 1, Kind: models.CalendarOff, Active: true},
+	}, nil)
+
+	// The group in an off period is not started, the start is retried once the period ends.
+	svc.ScheduleCron(ctx)
+
+	mockStore.EXPECT().GetAllSchedules(ctx).Return([]models.Schedule{
+		{GroupID: 6, CloudAccountID: 3, Timezone: "UTC", Windows: alwaysOn, LastState: string(resource.SUSPEND)},
+	}, nil)
+	mockStore.EXPECT().GetOffCalendars(ctx, gomock.Any()).Return(nil, assert.AnError)
+
+	svc.ScheduleCron(ctx)
+}
+
+func TestService_ScheduleCron_ChangeInProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	days := []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+	alwaysOn := models.ScheduleWindows{{Days: days, Start: "00:00", Stop: "12:00"},
+		{Days: days, Start: "12:00", Stop: "00:00"}}
+
+	// The tiers of an earlier change of the group are still being changed.
+	mockStore.EXPECT().GetAllSchedules(ctx).Return([]models.Schedule{
+		{GroupID: 6, CloudAccountID: 3, Timezone: "UTC", Windows: alwaysOn, LastState: string(resource.SUSPEND)},
+	}, nil)
+	mockStore.EXPECT().GetOffCalendars(ctx, gomock.Any()).Return(nil, nil)
+	mockStore.EXPECT().GetGroupChange(ctx, int64(6)).
+		Return(&models.RGChange{ID: 3, GroupID: 6, Status: resource.OperationInProgress}, nil)
+
+	// The group is neither changed nor recorded, the earlier change records it once completed.
+	svc.ScheduleCron(ctx)
+
+	// The group is being changed by an overlapping run.
+	svc.inFlight.Store(int64(6), struct{}{})
+
+	mockStore.EXPECT().GetAllSchedules(ctx).Return([]models.Schedule{
+		{GroupID: 6, CloudAccountID: 3, Timezone: "UTC", Windows: alwaysOn, LastState: string(resource.SUSPEND)},
+	}, nil)
+	mockStore.EXPECT().GetOffCalendars(ctx, gomock.Any()).Return(nil, nil)
+
+	svc.ScheduleCron(ctx)
+
+	svc.inFlight.Delete(int64(6))
+
+	mockStore.EXPECT().GetAllSchedules(ctx).Return([]models.Schedule{
+		{GroupID: 6, CloudAccountID: 3, Timezone: "UTC", Windows: alwaysOn, LastState: string(resource.SUSPEND)},
+	}, nil)
+	mockStore.EXPECT().GetOffCalendars(ctx, gomock.Any()).Return(nil, nil)
+	mockStore.EXPECT().GetGroupChange(ctx, int64(6)).Return(nil, nil)
+	mockStore.EXPECT().GetResourceIDs(ctx, int64(6)).Return(nil, assert.AnError)
+
+	svc.ScheduleCron(ctx)
+
+	_, busy := svc.inFlight.Load(int64(6))
+
+	assert.False(t, busy)
+}
+
+func TestService_ScheduleCron_Retry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mockStore := NewMockRGStore(ctrl)
+	mockResSvc := NewMockResourceService(ctrl)
+	svc := New(mockStore, mockResSvc)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	days := []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+	alwaysOn := models.ScheduleWindows{{Days: days, Start: "00:00", Stop: "12:00"},
+		{Days: days, Start: "12:00", Stop: "00:00"}}
+	schedules := []models.Schedule{
+		{GroupID: 6, CloudAccountID: 3, Timezone: "UTC", Windows: alwaysOn, LastState: string(resource.SUSPEND)},
+	}
+	justNow, anHourAgo := time.Now().UTC(), time.Now().UTC().Add(-time.Hour)
+	failedChange := func(attempt int, completedAt *time.Time, outcome models.MemberOutcome) *models.RGChange {
+		return &models.RGChange{ID: 3, GroupID: 6, CloudAccountID: 3, State: string(resource.START),
+			RequestedBy: resource.ActorSchedule, Tiers: models.GroupTiers{{20}}, Tier: 0,
+			Members: models.MemberOutcomes{outcome}, Status: resource.OperationFailed, Attempt: attempt,
+			CompletedAt: completedAt}
+	}
+	failure := models.MemberOutcome{ResourceID: 20, Error: "permission denied"}
+	expectStart := func(attempt int, err error) {
+		mockStore.EXPECT().GetResourceIDs(ctx, int64(6)).Return([]int64{20}, nil)
+		mockStore.EXPECT().GetResourceTiers(ctx, int64(6)).Return(map[int64]int{}, nil)
+		mockResSvc.EXPECT().GetByID(ctx, int64(20)).Return(&models.Resource{ID: 20, Name: "vm", Type: "GCE"}, nil)
+		mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 20, CloudAccID: 3, Name: "vm", Type: "GCE",
+			State: resource.START, RequestedBy: resource.ActorSchedule}).Return(err)
+		mockStore.EXPECT().InsertGroupChange(ctx, gomock.Any()).DoAndReturn(
+			func(_ *gofr.Context, ch *models.RGChange) error {
+				assert.Equal(t, attempt, ch.Attempt)
+				assert.Equal(t, resource.OperationFailed, ch.Status)
+
+				return nil
+			})
+	}
+
+	// The members of the group failed to change a minute ago, the change is retried after two minutes.
+	mockStore.EXPECT().GetAllSchedules(ctx).Return(schedules, nil)
+	mockStore.EXPECT().GetOffCalendars(ctx, gomock.Any()).Return(nil, nil)
+	mockStore.EXPECT().GetGroupChange(ctx, int64(6)).Return(failedChange(2, &justNow, failure), nil)
+
+	svc.ScheduleCron(ctx)
+
+	// The members that were only deferred are retried right away and do not count as an attempt.
+	mockStore.EXPECT().GetAllSchedules(ctx).Return(schedules, nil)
+	mockStore.EXPECT().GetOffCalendars(ctx, gomock.Any()).Return(nil, nil)
+	mockStore.EXPECT().GetGroupChange(ctx, int64(6)).Return(failedChange(2, &justNow,
+		models.MemberOutcome{ResourceID: 20, Deferred: true, Error: "blackout"}), nil)
+	expectStart(2, &resource.ErrBlackout{ResourceID: 20, Until: time.Now().Add(time.Hour)})
+
+	svc.ScheduleCron(ctx)
+
+	// The members fail to change once more and the schedule gives up until the next window.
+	mockStore.EXPECT().GetAllSchedules(ctx).Return(schedules, nil)
+	mockStore.EXPECT().GetOffCalendars(ctx, gomock.Any()).Return(nil, nil)
+	mockStore.EXPECT().GetGroupChange(ctx, int64(6)).Return(failedChange(4, &anHourAgo, failure), nil)
+	expectStart(5, assert.AnError)
+	mockStore.EXPECT().UpdateScheduleState(ctx, int64(6), string(resource.START),
+		"failed to start after 5 attempts: resource 20: "+assert.AnError.Error()).Return(nil)
+
+	svc.ScheduleCron(ctx)
+}
+
+func TestChangeAttempt(t *testing.T) {
+	justNow := time.Now().UTC()
+	failure := models.MemberOutcomes{{ResourceID: 20, Error: "permission denied"},
+		{ResourceID: 21, Error: "skipped, a previous tier did not reach RUNNING"}}
+	last := &models.RGChange{GroupID: 6, State: string(resource.SUSPEND), RequestedBy: resource.ActorCalendar,
+		Tiers: models.GroupTiers{{20}, {21}}, Tier: 0, Members: failure, Status: resource.OperationFailed,
+		Attempt: 3}
+
+	tests := []struct {
+		desc        string
+		last        *models.RGChange
+		requestedBy string
+		state       resource.ResourceState
+		attempt     int
+		retryAt     bool
+	}{
+		{desc: "requested by a user", last: last, requestedBy: "jane", state: resource.SUSPEND, attempt: 1},
+		{desc: "first change", requestedBy: resource.ActorCalendar, state: resource.SUSPEND, attempt: 1},
+		{desc: "different state", last: last, requestedBy: resource.ActorCalendar, state: resource.START, attempt: 1},
+		{desc: "different cron job", last: last, requestedBy: resource.ActorSchedule, state: resource.SUSPEND,
+			attempt: 1},
+		{desc: "failed", last: last, requestedBy: resource.ActorCalendar, state: resource.SUSPEND, attempt: 4},
+		{desc: "failed within the retry delay", last: &models.RGChange{GroupID: 6, State: last.State,
+			RequestedBy: last.RequestedBy, Tiers: last.Tiers, Members: failure, Status: last.Status, Attempt: 3,
+			CompletedAt: &justNow}, requestedBy: resource.ActorCalendar, state: resource.SUSPEND, retryAt: true},
+		{desc: "deferred members only", last: &models.RGChange{GroupID: 6, State: last.State,
+			RequestedBy: last.RequestedBy, Tiers: last.Tiers, Members: models.MemberOutcomes{
+				{ResourceID: 20, Deferred: true}, failure[1]}, Status: last.Status, Attempt: 3,
+			CompletedAt: &justNow}, requestedBy: resource.ActorCalendar, state: resource.SUSPEND, attempt: 3},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			attempt, err := changeAttempt(tc.last, tc.requestedBy, tc.state)
+
+			assert.Equal(t, tc.attempt, attempt)
+
+			if !tc.retryAt {
+				require.NoError(t, err)
+				return
+			}
+
+			var retryLater *errRetryLater
+
+			require.ErrorAs(t, err, &retryLater)
+			assert.Equal(t, justNow.Add(4*time.Minute), retryLater.RetryAt)
+		})
+	}
+}
@@ -14,11 +14,20 @@ import (
 const (
 	STOPPED = "STOPPED"
 	RUNNING = "RUNNING"
+
+	// PARTIAL is the status of a resource group with both running and stopped members.
+	PARTIAL = "PARTIAL"
+	// TRANSITIONING is the status of a resource group with members being started or stopped.
+	TRANSITIONING = "TRANSITIONING"
 )
 
 type Service struct {
 	grpStore RGStore
 	resSvc   ResourceService
+
+	// inFlight holds the IDs of the resource groups whose change is being started or advanced, a request or run
+	// overlapping it skips the group.
+	inFlight sync.Map
 }
 
 func New(store RGStore, rsSvc ResourceService) *Service {
@@ -45,10 +54,7 @@ func (s *Service) GetAllResourceGroups(ctx *gofr.Context, cloudAccID int64) ([]m
 				return er
 			}
 
-			var (
-				resources []models.Resource
-				status    = RUNNING
-			)
+			var resources []models.Resource
 
 			for i := range resIDs {
 				resource, er := s.resSvc.GetByID(ctx, resIDs[i])
@@ -56,15 +62,11 @@ func (s *Service) GetAllResourceGroups(ctx *gofr.Context, cloudAccID int64) ([]m
 					return er
 				}
 
-				if resource.Status == STOPPED {
-					status = STOPPED
-				}
-
 				resources = append(resources, *resource)
 			}
 
 			mu.Lock()
-			rg.Status = status
+			rg.Status = groupStatus(memberStatuses(resources))
 			resourceGroupData = append(resourceGroupData, models.ResourceGroupData{
 				ResourceGroup: rg,
 				Resources:     resources,
@@ -94,7 +96,10 @@ func (s *Service) GetResourceGroupByID(ctx *gofr.Context, cloudAccID, id int64)
 		return nil, &errInternalServer{}
 	}
 
-	rg.Status = RUNNING
+	index, err := s.grpStore.GetResourceTiers(ctx, id)
+	if err != nil {
+		return nil, &errInternalServer{}
+	}
 
 	resources := make([]models.Resource, 0, len(resIDs))
 
@@ -104,20 +109,30 @@ func (s *Service) GetResourceGroupByID(ctx *gofr.Context, cloudAccID, id int64)
 			return nil, &errInternalServer{}
 		}
 
-		if resource.Status == STOPPED {
-			rg.Status = STOPPED
-		}
-
 		resources = append(resources, *resource)
 	}
 
-	return &models.ResourceGroupData{
+	rg.Status = groupStatus(memberStatuses(resources))
+
+	data := &models.ResourceGroupData{
 		ResourceGroup: *rg,
 		Resources:     resources,
-	}, nil
+	}
+
+	// The tiers are only shown when the members are ordered.
+	if tiers := orderTiers(resIDs, index); len(tiers) > 1 {
+		data.Tiers = tiers
+	}
+
+	return data, nil
 }
 
 func (s *Service) CreateResourceGroup(ctx *gofr.Context, rg *models.RGCreate) (*models.ResourceGroupData, error) {
+	index, err := tierIndex(rg.Tiers, rg.ResourceIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	id, err := s.grpStore.CreateResourceGroup(ctx, rg)
 	if err != nil {
 		return nil, &errInternalServer{}
@@ -131,10 +146,22 @@ func (s *Service) CreateResourceGroup(ctx *gofr.Context, rg *models.RGCreate) (*
 		}
 	}
 
+	if len(rg.Tiers) > 0 {
+		err = s.grpStore.SetResourceTiers(ctx, id, index)
+		if err != nil {
+			return nil, &errInternalServer{}
+		}
+	}
+
 	return s.GetResourceGroupByID(ctx, rg.CloudAccountID, id)
 }
 
 func (s *Service) UpdateResourceGroup(ctx *gofr.Context, rg *models.RGUpdate) (*models.ResourceGroupData, error) {
+	index, err := tierIndex(rg.Tiers, rg.ResourceIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the resource group exists
 	existingRG, err := s.grpStore.GetResourceGroupByID(ctx, rg.CloudAccountID, rg.ID)
 	if err != nil {
@@ -161,6 +188,12 @@ func (s *Service) UpdateResourceGroup(ctx *gofr.Context, rg *models.RGUpdate) (*
 		return nil, &errInternalServer{}
 	}
 
+	// The tiers are replaced along with the resources, members without a tier move to the first tier.
+	err = s.grpStore.SetResourceTiers(ctx, rg.ID, index)
+	if err != nil {
+		return nil, &errInternalServer{}
+	}
+
 	return s.GetResourceGroupByID(ctx, rg.CloudAccountID, rg.ID)
 }
 
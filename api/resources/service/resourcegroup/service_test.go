@@ -21,7 +21,7 @@ func TestService_GetAllResourceGroups(t *testing.T) {
 	svc := New(mockStore, mockResSvc)
 	ctx := &gofr.Context{}
 
-	rg1 := models.ResourceGroup{ID: 1, Status: STOPPED}
+	rg1 := models.ResourceGroup{ID: 1, Status: PARTIAL}
 	rg2 := models.ResourceGroup{ID: 2, Status: RUNNING}
 	r1 := &models.Resource{ID: 10, Status: RUNNING}
 	r2 := &models.Resource{ID: 11, Status: STOPPED}
@@ -108,7 +108,7 @@ func TestService_GetResourceGroupByID(t *testing.T) {
 	svc := New(mockStore, mockResSvc)
 	ctx := &gofr.Context{}
 
-	rg := &models.ResourceGroup{ID: 1, Status: STOPPED}
+	rg := &models.ResourceGroup{ID: 1, Status: PARTIAL}
 	r1 := &models.Resource{ID: 10, Status: RUNNING}
 	r2 := &models.Resource{ID: 11, Status: STOPPED}
 	resourceIDs := []int64{10, 11}
@@ -124,12 +124,14 @@ func TestService_GetResourceGroupByID(t *testing.T) {
 			setup: func() {
 				mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(1)).Return(rg, nil)
 				mockStore.EXPECT().GetResourceIDs(ctx, int64(1)).Return(resourceIDs, nil)
+				mockStore.EXPECT().GetResourceTiers(ctx, int64(1)).Return(map[int64]int{10: 0, 11: 1}, nil)
 				mockResSvc.EXPECT().GetByID(ctx, int64(10)).Return(r1, nil)
 				mockResSvc.EXPECT().GetByID(ctx, int64(11)).Return(r2, nil)
 			},
 			expected: &models.ResourceGroupData{
 				ResourceGroup: *rg,
 				Resources:     []models.Resource{*r1, *r2},
+				Tiers:         [][]int64{{10}, {11}},
 			},
 			expectedErr: nil,
 		},
@@ -154,6 +156,7 @@ func TestService_GetResourceGroupByID(t *testing.T) {
 			setup: func() {
 				mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(1)).Return(rg, nil)
 				mockStore.EXPECT().GetResourceIDs(ctx, int64(1)).Return(resourceIDs, nil)
+				mockStore.EXPECT().GetResourceTiers(ctx, int64(1)).Return(map[int64]int{}, nil)
 				mockResSvc.EXPECT().GetByID(ctx, int64(10)).Return(nil, assert.AnError)
 			},
 			expectedErr: &errInternalServer{},
@@ -198,6 +201,7 @@ func TestService_CreateResourceGroup(t *testing.T) {
 				mockStore.EXPECT().AddResourcesToGroup(ctx, int64(1), rgCreate.ResourceIDs).Return(nil)
 				mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(1)).Return(rg, nil)
 				mockStore.EXPECT().GetResourceIDs(ctx, int64(1)).Return(resourceIDs, nil)
+				mockStore.EXPECT().GetResourceTiers(ctx, int64(1)).Return(map[int64]int{}, nil)
 				mockResSvc.EXPECT().GetByID(ctx, int64(10)).Return(resource, nil)
 			},
 			expectedErr: nil,
@@ -259,8 +263,10 @@ func TestService_UpdateResourceGroup(t *testing.T) {
 				mockStore.EXPECT().GetResourceIDs(ctx, rgUpdate.ID).Return(resourceIDs, nil) // existing resource IDs - 10
 				mockStore.EXPECT().UpdateResourceGroup(ctx, rgUpdate).Return(nil)
 				mockStore.EXPECT().AddResourcesToGroup(ctx, rgUpdate.ID, []int64{11}).Return(nil) // adding new resource ID - 11
+				mockStore.EXPECT().SetResourceTiers(ctx, rgUpdate.ID, map[int64]int{}).Return(nil)
 				mockStore.EXPECT().GetResourceGroupByID(ctx, rg.CloudAccountID, rgUpdate.ID).Return(rg, nil)
 				mockStore.EXPECT().GetResourceIDs(ctx, rg.ID).Return([]int64{11, 10}, nil)
+				mockStore.EXPECT().GetResourceTiers(ctx, rg.ID).Return(map[int64]int{}, nil)
 				mockResSvc.EXPECT().GetByID(ctx, int64(11)).Return(r1, nil)
 				mockResSvc.EXPECT().GetByID(ctx, int64(10)).Return(r2, nil)
 			},
@@ -0,0 +1,157 @@
+package resourcegroup
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/service/resource"
+)
+
+// maxParallelChanges is the number of members of a resource group whose state is changed at the same time.
+const maxParallelChanges = 5
+
+// ChangeGroupState starts or suspends all the members of a resource group, tier by tier. The first tier is changed
+// right away and the next tiers by ChangeCron, the members of the next tiers are reported as pending. A member that
+// fails to change state does not stop the other members of its tier, the outcome of every member is reported along
+// with the status of the group after the change.
+func (s *Service) ChangeGroupState(ctx *gofr.Context, cloudAccID, groupID int64,
+	req *models.RGStateChange) (*models.RGStateResult, error) {
+	state := resource.ResourceState(strings.ToUpper(req.State))
+	if state != resource.START && state != resource.SUSPEND {
+		return nil, gofrHttp.ErrorInvalidParam{Params: []string{"state"}}
+	}
+
+	timeout := defaultTierTimeout
+
+	if req.TierTimeout != "" {
+		d, err := time.ParseDuration(req.TierTimeout)
+		if err != nil || d <= 0 {
+			return nil, gofrHttp.ErrorInvalidParam{Params: []string{"tier_timeout"}}
+		}
+
+		timeout = d
+	}
+
+	if err := s.checkGroup(ctx, cloudAccID, groupID); err != nil {
+		return nil, err
+	}
+
+	ch, err := s.startChange(ctx, cloudAccID, groupID, state, req.RequestedBy, timeout)
+	if err != nil {
+		if errChangeInProgress(err) {
+			return nil, err
+		}
+
+		return nil, &errInternalServer{}
+	}
+
+	return changeResult(ch), nil
+}
+
+// changeMembers changes the state of the members of a resource group concurrently and returns their outcomes in the
+// order of the members.
+func (s *Service) changeMembers(ctx *gofr.Context, cloudAccID int64, resIDs []int64, state resource.ResourceState,
+	requestedBy string) []models.MemberOutcome {
+	outcomes := make([]models.MemberOutcome, len(resIDs))
+
+	errGrp := new(errgroup.Group)
+	errGrp.SetLimit(maxParallelChanges)
+
+	for i, id := range resIDs {
+		errGrp.Go(func() error {
+			outcomes[i] = s.changeMember(ctx, cloudAccID, id, state, requestedBy)
+			return nil
+		})
+	}
+
+	_ = errGrp.Wait()
+
+	return outcomes
+}
+
+func (s *Service) changeMember(ctx *gofr.Context, cloudAccID, id int64, state resource.ResourceState,
+	requestedBy string) models.MemberOutcome {
+	out := models.MemberOutcome{ResourceID: id}
+
+	res, err := s.resSvc.GetByID(ctx, id)
+	if err != nil {
+		out.Error = err.Error()
+		return out
+	}
+
+	out.Name, out.Status = res.Name, res.Status
+
+	err = s.resSvc.ChangeState(ctx, resource.ResourceDetails{ID: res.ID, CloudAccID: cloudAccID, Name: res.Name,
+		Type: resource.ResourceType(res.Type), State: state, RequestedBy: requestedBy})
+	if err != nil {
+		var notChangeable *resource.ErrNotChangeable
+
+		out.Skipped = errors.As(err, &notChangeable)
+		out.Deferred = deferred(err)
+		out.Error = err.Error()
+
+		return out
+	}
+
+	out.Succeeded = true
+
+	// The member is read again for the transitional status set by the state change.
+	if res, err = s.resSvc.GetByID(ctx, id); err == nil {
+		out.Status = res.Status
+	}
+
+	return out
+}
+
+// deferred reports whether a state change was refused for now and is to be retried later, e.g. while a keep-awake
+// lock is held on the member or during a blackout period.
+func deferred(err error) bool {
+	var (
+		locked   *resource.ErrResourceLocked
+		blackout *resource.ErrBlackout
+	)
+
+	return errors.As(err, &locked) || errors.As(err, &blackout)
+}
+
+// groupStatus returns the status of a resource group from the statuses of its members. Members that are neither
+// running nor stopped, such as the waste candidates and the caches, do not count.
+func groupStatus(statuses []string) string {
+	var running, stopped int
+
+	for _, status := range statuses {
+		switch status {
+		case resource.STARTING, resource.STOPPING:
+			return TRANSITIONING
+		case RUNNING:
+			running++
+		case STOPPED:
+			stopped++
+		}
+	}
+
+	switch {
+	case stopped == 0:
+		return RUNNING
+	case running == 0:
+		return STOPPED
+	default:
+		return PARTIAL
+	}
+}
+
+func memberStatuses(resources []models.Resource) []string {
+	statuses := make([]string, 0, len(resources))
+
+	for i := range resources {
+		statuses = append(statuses, resources[i].Status)
+	}
+
+	return statuses
+}
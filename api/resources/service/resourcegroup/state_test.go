@@ -0,0 +1,128 @@
+package resourcegroup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/service/resource"
+)
+
+func TestService_ChangeGroupState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	mockResSvc := NewMockResourceService(ctrl)
+	svc := New(mockStore, mockResSvc)
+	ctx := &gofr.Context{}
+	db := &models.Resource{ID: 10, Name: "db", Type: "SQL", Status: RUNNING}
+	vm := &models.Resource{ID: 11, Name: "vm", Type: "GCE", Status: RUNNING}
+	app := &models.Resource{ID: 13, Name: "app", Type: "GCE", Status: RUNNING}
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil)
+	mockStore.EXPECT().GetGroupChange(ctx, int64(2)).
+		Return(&models.RGChange{ID: 4, GroupID: 2, Status: resource.OperationSucceeded}, nil)
+	mockStore.EXPECT().GetResourceIDs(ctx, int64(2)).Return([]int64{10, 11, 12, 13}, nil)
+	mockStore.EXPECT().GetResourceTiers(ctx, int64(2)).Return(map[int64]int{}, nil)
+	mockResSvc.EXPECT().GetByID(ctx, int64(10)).Return(db, nil)
+	mockResSvc.EXPECT().GetByID(ctx, int64(10)).Return(&models.Resource{ID: 10, Status: resource.STOPPING}, nil)
+	mockResSvc.EXPECT().GetByID(ctx, int64(11)).Return(vm, nil)
+	mockResSvc.EXPECT().GetByID(ctx, int64(12)).Return(nil, assert.AnError)
+	mockResSvc.EXPECT().GetByID(ctx, int64(13)).Return(app, nil)
+	errInProgress := &resource.ErrOperationInProgress{ResourceID: 11}
+	errLocked := &resource.ErrResourceLocked{ResourceID: 13, Author: "bob"}
+
+	mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 10, CloudAccID: 1, Name: "db", Type: "SQL",
+		State: resource.SUSPEND, RequestedBy: "alice"}).Return(nil)
+	mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 11, CloudAccID: 1, Name: "vm", Type: "GCE",
+		State: resource.SUSPEND, RequestedBy: "alice"}).Return(errInProgress)
+	mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 13, CloudAccID: 1, Name: "app", Type: "GCE",
+		State: resource.SUSPEND, RequestedBy: "alice"}).Return(errLocked)
+	mockStore.EXPECT().InsertGroupChange(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, ch *models.RGChange) error {
+		assert.Equal(t, 0, ch.Tier)
+		assert.Equal(t, defaultTierTimeout, ch.TierTimeout)
+		assert.NotNil(t, ch.CompletedAt)
+
+		ch.ID = 5
+
+		return nil
+	})
+
+	res, err := svc.ChangeGroupState(ctx, 1, 2, &models.RGStateChange{State: "suspend", RequestedBy: "alice"})
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.RGStateResult{GroupID: 2, State: "SUSPEND", Status: TRANSITIONING, ChangeID: 5,
+		ChangeStatus: resource.OperationFailed, Members: []models.MemberOutcome{
+			{ResourceID: 10, Name: "db", Status: resource.STOPPING, Succeeded: true},
+			{ResourceID: 11, Name: "vm", Status: RUNNING, Error: errInProgress.Error()},
+			{ResourceID: 12, Error: assert.AnError.Error()},
+			{ResourceID: 13, Name: "app", Status: RUNNING, Deferred: true, Error: errLocked.Error()},
+		}}, res)
+}
+
+func TestService_ChangeGroupState_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{}
+
+	_, err := svc.ChangeGroupState(ctx, 1, 2, &models.RGStateChange{State: "RESTART"})
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"state"}}, err)
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(nil, nil)
+
+	_, err = svc.ChangeGroupState(ctx, 1, 2, &models.RGStateChange{State: "START"})
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "resource group", Value: "2"}, err)
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil)
+	mockStore.EXPECT().GetGroupChange(ctx, int64(2)).Return(nil, nil)
+	mockStore.EXPECT().GetResourceIDs(ctx, int64(2)).Return(nil, assert.AnError)
+
+	_, err = svc.ChangeGroupState(ctx, 1, 2, &models.RGStateChange{State: "START"})
+
+	assert.Equal(t, &errInternalServer{}, err)
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil)
+	mockStore.EXPECT().GetGroupChange(ctx, int64(2)).
+		Return(&models.RGChange{ID: 4, GroupID: 2, Status: resource.OperationInProgress}, nil)
+
+	_, err = svc.ChangeGroupState(ctx, 1, 2, &models.RGStateChange{State: "START"})
+
+	assert.Equal(t, &ErrChangeInProgress{GroupID: 2}, err)
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil)
+	mockStore.EXPECT().GetGroupChange(ctx, int64(2)).Return(nil, assert.AnError)
+
+	_, err = svc.ChangeGroupState(ctx, 1, 2, &models.RGStateChange{State: "START"})
+
+	assert.Equal(t, &errInternalServer{}, err)
+}
+
+func TestGroupStatus(t *testing.T) {
+	tests := []struct {
+		statuses []string
+		exp      string
+	}{
+		{nil, RUNNING},
+		{[]string{RUNNING, RUNNING}, RUNNING},
+		{[]string{STOPPED, resource.UNATTACHED}, STOPPED},
+		// The caches of a suspended group keep serving requests.
+		{[]string{STOPPED, resource.AVAILABLE}, STOPPED},
+		{[]string{RUNNING, STOPPED}, PARTIAL},
+		{[]string{RUNNING, STOPPED, resource.STARTING}, TRANSITIONING},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.exp, groupStatus(tc.statuses), tc.statuses)
+	}
+}
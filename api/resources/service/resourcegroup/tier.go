@@ -0,0 +1,257 @@
+package resourcegroup
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/service/resource"
+)
+
+// defaultTierTimeout is the time a tier of a resource group is waited for to reach its state when the state change
+// sets no timeout.
+const defaultTierTimeout = 10 * time.Minute
+
+// tierIndex returns the tier of each member of a resource group from the tiers of a request. It returns an error
+// when a tier holds a resource that is not a member of the group or a resource is in more than one tier.
+func tierIndex(tiers [][]int64, resourceIDs []int64) (map[int64]int, error) {
+	members := make(map[int64]bool, len(resourceIDs))
+
+	for _, id := range resourceIDs {
+		members[id] = true
+	}
+
+	index := make(map[int64]int, len(resourceIDs))
+
+	for tier, ids := range tiers {
+		for _, id := range ids {
+			if _, ok := index[id]; ok || !members[id] {
+				return nil, gofrHttp.ErrorInvalidParam{Params: []string{"tiers"}}
+			}
+
+			index[id] = tier
+		}
+	}
+
+	return index, nil
+}
+
+// orderTiers groups the members of a resource group by ascending tier, the members of a tier keep their order.
+func orderTiers(resIDs []int64, index map[int64]int) [][]int64 {
+	byTier := make(map[int][]int64)
+
+	for _, id := range resIDs {
+		byTier[index[id]] = append(byTier[index[id]], id)
+	}
+
+	order := make([]int, 0, len(byTier))
+
+	for tier := range byTier {
+		order = append(order, tier)
+	}
+
+	sort.Ints(order)
+
+	tiers := make([][]int64, 0, len(order))
+
+	for _, tier := range order {
+		tiers = append(tiers, byTier[tier])
+	}
+
+	return tiers
+}
+
+// groupTiers returns the members of a resource group by tier in the order the tiers are changed: by ascending tier
+// when started and by descending tier when suspended.
+func (s *Service) groupTiers(ctx *gofr.Context, groupID int64, state resource.ResourceState) ([][]int64, error) {
+	resIDs, err := s.grpStore.GetResourceIDs(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := s.grpStore.GetResourceTiers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers := orderTiers(resIDs, index)
+	if state == resource.SUSPEND {
+		for i, j := 0, len(tiers)-1; i < j; i, j = i+1, j-1 {
+			tiers[i], tiers[j] = tiers[j], tiers[i]
+		}
+	}
+
+	return tiers, nil
+}
+
+// advanceChange moves a state change of a resource group to its next tier once the members of the tier changed last
+// reached the state. A tier whose members are all in the state once changed, e.g. skipped, is not waited for. When a
+// tier fails or times out, the members of the next tiers are skipped and the change fails. The change completes once
+// its last tier is changed, the last tier is not waited for.
+func (s *Service) advanceChange(ctx *gofr.Context, ch *models.RGChange) {
+	state := resource.ResourceState(ch.State)
+	target := targetStatus(state)
+
+	if ch.Tier >= 0 {
+		done, reached := s.checkTier(ctx, tierOutcomes(ch, ch.Tier), target, *ch.TierDeadline)
+		if !done {
+			return
+		}
+
+		if !reached {
+			skipTiers(ch, target)
+			completeChange(ch)
+
+			return
+		}
+	}
+
+	for ch.Tier < len(ch.Tiers)-1 {
+		ch.Tier++
+
+		deadline := time.Now().Add(ch.TierTimeout).UTC()
+		ch.TierDeadline = &deadline
+
+		outcomes := tierOutcomes(ch, ch.Tier)
+		copy(outcomes, s.changeMembers(ctx, ch.CloudAccountID, ch.Tiers[ch.Tier], state, ch.RequestedBy))
+
+		if !changed(outcomes) {
+			skipTiers(ch, target)
+			completeChange(ch)
+
+			return
+		}
+
+		if ch.Tier < len(ch.Tiers)-1 && !settled(outcomes, target) {
+			return
+		}
+	}
+
+	completeChange(ch)
+}
+
+// checkTier checks the members of a tier against the status of a state. It reports whether the tier is done and
+// whether all of its members but the skipped ones reached the status, the outcomes of the members that did not are
+// updated with the reason. A tier whose members are still changing is done once its deadline passed.
+func (s *Service) checkTier(ctx *gofr.Context, outcomes []models.MemberOutcome, target string,
+	deadline time.Time) (done, reached bool) {
+	pending := 0
+
+	for i := range outcomes {
+		if outcomes[i].Skipped || outcomes[i].Status == target {
+			continue
+		}
+
+		res, err := s.resSvc.GetByID(ctx, outcomes[i].ResourceID)
+		if err == nil {
+			outcomes[i].Status = res.Status
+		}
+
+		switch {
+		case outcomes[i].Status == target:
+		case outcomes[i].Status == resource.FAILED:
+			outcomes[i].Succeeded = false
+			outcomes[i].Error = fmt.Sprintf("failed to reach %s", target)
+
+			return true, false
+		default:
+			pending++
+		}
+	}
+
+	if pending == 0 {
+		return true, true
+	}
+
+	if time.Now().Before(deadline) {
+		return false, false
+	}
+
+	failPending(outcomes, target, "timed out waiting for "+target)
+
+	return true, false
+}
+
+// tierOutcomes returns the outcomes of the members of a tier of a change, they share the members of the change.
+func tierOutcomes(ch *models.RGChange, tier int) []models.MemberOutcome {
+	offset := tierOffset(ch, tier)
+
+	return ch.Members[offset : offset+len(ch.Tiers[tier])]
+}
+
+// tierOffset returns the index of the first member of a tier in the members of a change.
+func tierOffset(ch *models.RGChange, tier int) int {
+	offset := 0
+
+	for _, ids := range ch.Tiers[:tier] {
+		offset += len(ids)
+	}
+
+	return offset
+}
+
+// changed reports whether the state change was accepted for all the members of a tier but the skipped ones.
+func changed(outcomes []models.MemberOutcome) bool {
+	for i := range outcomes {
+		if !outcomes[i].Succeeded && !outcomes[i].Skipped {
+			return false
+		}
+	}
+
+	return true
+}
+
+// settled reports whether all the members of a tier but the skipped ones are in the status of the state change.
+func settled(outcomes []models.MemberOutcome, target string) bool {
+	for i := range outcomes {
+		if !outcomes[i].Skipped && outcomes[i].Status != target {
+			return false
+		}
+	}
+
+	return true
+}
+
+// skipTiers skips the members of the tiers of a change after the tier changed last.
+func skipTiers(ch *models.RGChange, target string) {
+	for i := tierOffset(ch, ch.Tier+1); i < len(ch.Members); i++ {
+		ch.Members[i] = models.MemberOutcome{ResourceID: ch.Members[i].ResourceID,
+			Error: fmt.Sprintf("skipped, a previous tier did not reach %s", target)}
+	}
+}
+
+// completeChange completes a state change of a resource group. It succeeds when all the members but the skipped ones
+// accepted the state change.
+func completeChange(ch *models.RGChange) {
+	now := time.Now().UTC()
+
+	ch.Status = resource.OperationSucceeded
+	ch.CompletedAt = &now
+
+	if !changed(ch.Members) {
+		ch.Status = resource.OperationFailed
+	}
+}
+
+// failPending marks the members of a tier that have not reached the status of the state change as failed.
+func failPending(outcomes []models.MemberOutcome, target, reason string) {
+	for i := range outcomes {
+		if !outcomes[i].Skipped && outcomes[i].Status != target {
+			outcomes[i].Succeeded = false
+			outcomes[i].Error = reason
+		}
+	}
+}
+
+// targetStatus returns the status of a resource once it has reached a state.
+func targetStatus(state resource.ResourceState) string {
+	if state == resource.START {
+		return RUNNING
+	}
+
+	return STOPPED
+}
@@ -0,0 +1,261 @@
+package resourcegroup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+	gofrHttp "gofr.dev/pkg/gofr/http"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+	"github.com/zopdev/zopdev/api/resources/service/resource"
+)
+
+func TestTierIndex(t *testing.T) {
+	index, err := tierIndex([][]int64{{10}, {11, 12}}, []int64{10, 11, 12, 13})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]int{10: 0, 11: 1, 12: 1}, index)
+	assert.Equal(t, [][]int64{{10, 13}, {11, 12}}, orderTiers([]int64{10, 11, 12, 13}, index))
+
+	_, err = tierIndex([][]int64{{10}, {14}}, []int64{10, 11})
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"tiers"}}, err)
+
+	_, err = tierIndex([][]int64{{10}, {10}}, []int64{10, 11})
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"tiers"}}, err)
+}
+
+func TestService_CreateResourceGroup_Tiers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{}
+	rgCreate := &models.RGCreate{CloudAccountID: 1, ResourceIDs: []int64{10, 11}, Tiers: [][]int64{{10}, {11}}}
+
+	mockStore.EXPECT().CreateResourceGroup(ctx, rgCreate).Return(int64(1), nil)
+	mockStore.EXPECT().AddResourcesToGroup(ctx, int64(1), rgCreate.ResourceIDs).Return(nil)
+	mockStore.EXPECT().SetResourceTiers(ctx, int64(1), map[int64]int{10: 0, 11: 1}).Return(assert.AnError)
+
+	_, err := svc.CreateResourceGroup(ctx, rgCreate)
+
+	assert.Equal(t, &errInternalServer{}, err)
+
+	_, err = svc.CreateResourceGroup(ctx, &models.RGCreate{ResourceIDs: []int64{10}, Tiers: [][]int64{{11}}})
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"tiers"}}, err)
+}
+
+func TestService_ChangeGroupState_Tiers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	mockResSvc := NewMockResourceService(ctrl)
+	svc := New(mockStore, mockResSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+	tiers := map[int64]int{10: 0, 11: 1, 12: 2}
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil)
+	mockStore.EXPECT().GetGroupChange(ctx, int64(2)).Return(nil, nil)
+	mockStore.EXPECT().GetResourceIDs(ctx, int64(2)).Return([]int64{10, 11, 12}, nil)
+	mockStore.EXPECT().GetResourceTiers(ctx, int64(2)).Return(tiers, nil)
+
+	// The application is stopped first, the next tiers are changed once it is stopped.
+	gomock.InOrder(
+		mockResSvc.EXPECT().GetByID(ctx, int64(12)).Return(&models.Resource{ID: 12, Name: "app", Status: RUNNING}, nil),
+		mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 12, CloudAccID: 1, Name: "app",
+			State: resource.SUSPEND}).Return(nil),
+		mockResSvc.EXPECT().GetByID(ctx, int64(12)).Return(&models.Resource{ID: 12, Status: resource.STOPPING}, nil),
+	)
+	mockStore.EXPECT().InsertGroupChange(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, ch *models.RGChange) error {
+		assert.Equal(t, models.GroupTiers{{12}, {11}, {10}}, ch.Tiers)
+		assert.Equal(t, 0, ch.Tier)
+		assert.Equal(t, 5*time.Minute, ch.TierTimeout)
+		assert.WithinDuration(t, time.Now().Add(5*time.Minute), *ch.TierDeadline, time.Minute)
+		assert.Nil(t, ch.CompletedAt)
+
+		ch.ID = 7
+
+		return nil
+	})
+
+	res, err := svc.ChangeGroupState(ctx, 1, 2, &models.RGStateChange{State: "SUSPEND", TierTimeout: "5m"})
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.RGStateResult{GroupID: 2, State: "SUSPEND", Status: TRANSITIONING, ChangeID: 7,
+		ChangeStatus: resource.OperationInProgress, Members: []models.MemberOutcome{
+			{ResourceID: 12, Name: "app", Status: resource.STOPPING, Succeeded: true},
+			{ResourceID: 11, Pending: true},
+			{ResourceID: 10, Pending: true},
+		}}, res)
+
+	_, err = svc.ChangeGroupState(ctx, 1, 2, &models.RGStateChange{State: "START", TierTimeout: "soon"})
+
+	assert.Equal(t, gofrHttp.ErrorInvalidParam{Params: []string{"tier_timeout"}}, err)
+}
+
+func TestService_ChangeCron(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, _ := container.NewMockContainer(t)
+	mockStore := NewMockRGStore(ctrl)
+	mockResSvc := NewMockResourceService(ctrl)
+	svc := New(mockStore, mockResSvc)
+	ctx := &gofr.Context{Context: context.Background(), Container: mockContainer}
+	later, earlier := time.Now().Add(time.Minute), time.Now().Add(-time.Minute)
+
+	mockStore.EXPECT().GetGroupChangesByStatus(ctx, resource.OperationInProgress).Return([]models.RGChange{
+		// The application is stopped, the next tier is stopped.
+		{ID: 1, GroupID: 2, CloudAccountID: 1, State: "SUSPEND", Tiers: models.GroupTiers{{12}, {11}, {10}},
+			TierTimeout: time.Minute, TierDeadline: &later, Status: resource.OperationInProgress,
+			Members: models.MemberOutcomes{{ResourceID: 12, Name: "app", Status: resource.STOPPING, Succeeded: true},
+				{ResourceID: 11, Pending: true}, {ResourceID: 10, Pending: true}}},
+		// The database does not start within the timeout, the next tiers are not started.
+		{ID: 2, GroupID: 3, CloudAccountID: 1, State: "START", RequestedBy: "alice", Tiers: models.GroupTiers{{20}, {21}},
+			TierTimeout: time.Minute, TierDeadline: &earlier, Status: resource.OperationInProgress,
+			Members: models.MemberOutcomes{{ResourceID: 20, Name: "db", Status: resource.STARTING, Succeeded: true},
+				{ResourceID: 21, Pending: true}}},
+		// The last tier of a scheduled change is started, the scheduled state is recorded.
+		{ID: 3, GroupID: 4, CloudAccountID: 1, State: "START", RequestedBy: resource.ActorSchedule,
+			Tiers: models.GroupTiers{{30}, {31}}, TierTimeout: time.Minute, TierDeadline: &later,
+			Status: resource.OperationInProgress, Members: models.MemberOutcomes{
+				{ResourceID: 30, Name: "db", Status: resource.STARTING, Succeeded: true}, {ResourceID: 31, Pending: true}}},
+		// The database is still starting.
+		{ID: 4, GroupID: 5, CloudAccountID: 1, State: "START", Tiers: models.GroupTiers{{40}, {41}},
+			TierTimeout: time.Minute, TierDeadline: &later, Status: resource.OperationInProgress,
+			Members: models.MemberOutcomes{{ResourceID: 40, Name: "db", Status: resource.STARTING, Succeeded: true},
+				{ResourceID: 41, Pending: true}}},
+	}, nil)
+
+	mockResSvc.EXPECT().GetByID(ctx, int64(12)).Return(&models.Resource{ID: 12, Status: STOPPED}, nil)
+	gomock.InOrder(
+		mockResSvc.EXPECT().GetByID(ctx, int64(11)).Return(&models.Resource{ID: 11, Name: "api", Status: RUNNING}, nil),
+		mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 11, CloudAccID: 1, Name: "api",
+			State: resource.SUSPEND}).Return(nil),
+		mockResSvc.EXPECT().GetByID(ctx, int64(11)).Return(&models.Resource{ID: 11, Status: resource.STOPPING}, nil),
+	)
+	mockStore.EXPECT().UpdateGroupChange(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, ch *models.RGChange) error {
+		assert.Equal(t, 1, ch.Tier)
+		assert.Equal(t, resource.OperationInProgress, ch.Status)
+		assert.Equal(t, models.MemberOutcomes{
+			{ResourceID: 12, Name: "app", Status: STOPPED, Succeeded: true},
+			{ResourceID: 11, Name: "api", Status: resource.STOPPING, Succeeded: true},
+			{ResourceID: 10, Pending: true},
+		}, ch.Members)
+
+		return nil
+	})
+
+	mockResSvc.EXPECT().GetByID(ctx, int64(20)).Return(&models.Resource{ID: 20, Status: resource.STARTING}, nil)
+	mockStore.EXPECT().UpdateGroupChange(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, ch *models.RGChange) error {
+		assert.Equal(t, resource.OperationFailed, ch.Status)
+		assert.NotNil(t, ch.CompletedAt)
+		assert.Equal(t, models.MemberOutcomes{
+			{ResourceID: 20, Name: "db", Status: resource.STARTING, Error: "timed out waiting for RUNNING"},
+			{ResourceID: 21, Error: "skipped, a previous tier did not reach RUNNING"},
+		}, ch.Members)
+
+		return nil
+	})
+
+	mockResSvc.EXPECT().GetByID(ctx, int64(30)).Return(&models.Resource{ID: 30, Status: RUNNING}, nil)
+	gomock.InOrder(
+		mockResSvc.EXPECT().GetByID(ctx, int64(31)).Return(&models.Resource{ID: 31, Name: "app", Status: STOPPED}, nil),
+		mockResSvc.EXPECT().ChangeState(ctx, resource.ResourceDetails{ID: 31, CloudAccID: 1, Name: "app",
+			State: resource.START, RequestedBy: resource.ActorSchedule}).Return(nil),
+		mockResSvc.EXPECT().GetByID(ctx, int64(31)).Return(&models.Resource{ID: 31, Status: resource.STARTING}, nil),
+	)
+	mockStore.EXPECT().UpdateGroupChange(ctx, gomock.Any()).DoAndReturn(func(_ *gofr.Context, ch *models.RGChange) error {
+		assert.Equal(t, resource.OperationSucceeded, ch.Status)
+
+		return nil
+	})
+	mockStore.EXPECT().UpdateScheduleState(ctx, int64(4), string(resource.START), "").Return(nil)
+
+	mockResSvc.EXPECT().GetByID(ctx, int64(40)).Return(&models.Resource{ID: 40, Status: resource.STARTING}, nil)
+	mockStore.EXPECT().UpdateGroupChange(ctx, gomock.Any()).Return(assert.AnError)
+
+	svc.ChangeCron(ctx)
+
+	mockStore.EXPECT().GetGroupChangesByStatus(ctx, resource.OperationInProgress).Return(nil, assert.AnError)
+
+	svc.ChangeCron(ctx)
+}
+
+func TestService_GetGroupState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	svc := New(mockStore, nil)
+	ctx := &gofr.Context{}
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil).Times(3)
+	mockStore.EXPECT().GetGroupChange(ctx, int64(2)).Return(&models.RGChange{ID: 7, GroupID: 2, State: "START",
+		Status: resource.OperationInProgress, Members: models.MemberOutcomes{
+			{ResourceID: 10, Name: "db", Status: RUNNING, Succeeded: true}, {ResourceID: 11, Pending: true}}}, nil)
+
+	res, err := svc.GetGroupState(ctx, 1, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.RGStateResult{GroupID: 2, State: "START", Status: RUNNING, ChangeID: 7,
+		ChangeStatus: resource.OperationInProgress, Members: []models.MemberOutcome{
+			{ResourceID: 10, Name: "db", Status: RUNNING, Succeeded: true}, {ResourceID: 11, Pending: true}}}, res)
+
+	mockStore.EXPECT().GetGroupChange(ctx, int64(2)).Return(nil, nil)
+
+	_, err = svc.GetGroupState(ctx, 1, 2)
+
+	assert.Equal(t, gofrHttp.ErrorEntityNotFound{Name: "resource group change", Value: "2"}, err)
+
+	mockStore.EXPECT().GetGroupChange(ctx, int64(2)).Return(nil, assert.AnError)
+
+	_, err = svc.GetGroupState(ctx, 1, 2)
+
+	assert.Equal(t, &errInternalServer{}, err)
+}
+
+func TestService_ChangeGroupState_SkippedMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := NewMockRGStore(ctrl)
+	mockResSvc := NewMockResourceService(ctrl)
+	svc := New(mockStore, mockResSvc)
+	ctx := &gofr.Context{Context: context.Background()}
+	notChangeable := &resource.ErrNotChangeable{ResourceID: 10, Type: "GCP_DISK"}
+
+	mockStore.EXPECT().GetResourceGroupByID(ctx, int64(1), int64(2)).Return(&models.ResourceGroup{ID: 2}, nil)
+	mockStore.EXPECT().GetGroupChange(ctx, int64(2)).Return(nil, nil)
+	mockStore.EXPECT().GetResourceIDs(ctx, int64(2)).Return([]int64{10, 11}, nil)
+	mockStore.EXPECT().GetResourceTiers(ctx, int64(2)).Return(map[int64]int{10: 0, 11: 1}, nil)
+
+	// The disk of the first tier is not started, the next tier is started without waiting for it.
+	gomock.InOrder(
+		mockResSvc.EXPECT().GetByID(ctx, int64(10)).Return(&models.Resource{ID: 10, Name: "disk", Status: STOPPED}, nil),
+		mockResSvc.EXPECT().ChangeState(ctx, gomock.Any()).Return(notChangeable),
+		mockResSvc.EXPECT().GetByID(ctx, int64(11)).Return(&models.Resource{ID: 11, Name: "app", Status: STOPPED}, nil),
+		mockResSvc.EXPECT().ChangeState(ctx, gomock.Any()).Return(nil),
+		mockResSvc.EXPECT().GetByID(ctx, int64(11)).Return(&models.Resource{ID: 11, Status: resource.STARTING}, nil),
+	)
+	mockStore.EXPECT().InsertGroupChange(ctx, gomock.Any()).Return(nil)
+
+	res, err := svc.ChangeGroupState(ctx, 1, 2, &models.RGStateChange{State: "START"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.MemberOutcome{
+		{ResourceID: 10, Name: "disk", Status: STOPPED, Skipped: true, Error: notChangeable.Error()},
+		{ResourceID: 11, Name: "app", Status: resource.STARTING, Succeeded: true},
+	}, res.Members)
+	assert.Equal(t, resource.OperationSucceeded, res.ChangeStatus)
+}
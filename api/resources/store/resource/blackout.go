@@ -0,0 +1,41 @@
+package resource
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// GetActiveBlackout fetches the blackout calendar of a resource group of a resource with a period in progress at a
+// time. The calendar is returned with the period that ends last, nil is returned when no blackout is in progress.
+func (*Store) GetActiveBlackout(ctx *gofr.Context, resourceID int64, at time.Time) (*models.Calendar, error) {
+	row := ctx.SQL.QueryRowContext(ctx, `SELECT c.id, c.name, c.timezone, p.name, p.start_at, p.end_at
+       FROM calendar_periods p JOIN calendars c ON c.id = p.calendar_id
+       JOIN resource_group_calendars gc ON gc.calendar_id = p.calendar_id
+       JOIN resource_groups g ON g.id = gc.group_id
+       JOIN resource_group_memberships m ON m.group_id = gc.group_id
+       WHERE m.resource_id = ? AND gc.kind = ? AND g.deleted_at IS NULL AND p.start_at <= ? AND p.end_at > ?
+       ORDER BY p.end_at DESC LIMIT 1`, resourceID, models.CalendarBlackout, at, at)
+
+	var (
+		cal    models.Calendar
+		period models.CalendarPeriod
+	)
+
+	err := row.Scan(&cal.ID, &cal.Name, &cal.Timezone, &period.Name, &period.Start, &period.End)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	cal.Periods = []models.CalendarPeriod{period}
+
+	return &cal, nil
+}
@@ -0,0 +1,60 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func TestStore_GetActiveBlackout(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	now := time.Now()
+	start := now.Add(-time.Hour)
+	end := now.Add(time.Hour)
+	columns := []string{"id", "name", "timezone", "period", "start_at", "end_at"}
+	query := `SELECT c.id, c.name, c.timezone, p.name, p.start_at, p.end_at
+       FROM calendar_periods p JOIN calendars c ON c.id = p.calendar_id
+       JOIN resource_group_calendars gc ON gc.calendar_id = p.calendar_id
+       JOIN resource_groups g ON g.id = gc.group_id
+       JOIN resource_group_memberships m ON m.group_id = gc.group_id
+       WHERE m.resource_id = ? AND gc.kind = ? AND g.deleted_at IS NULL AND p.start_at <= ? AND p.end_at > ?
+       ORDER BY p.end_at DESC LIMIT 1`
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).
+		WithArgs(int64(2), "BLACKOUT", now, now).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(4, "Quarter-end", "UTC", "Q3 close", start, end))
+
+	cal, err := store.GetActiveBlackout(ctx, 2, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.Calendar{ID: 4, Name: "Quarter-end", Timezone: "UTC",
+		Periods: []models.CalendarPeriod{{Name: "Q3 close", Start: start, End: end}}}, cal)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).
+		WithArgs(int64(2), "BLACKOUT", now, now).
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	cal, err = store.GetActiveBlackout(ctx, 2, now)
+
+	require.NoError(t, err)
+	assert.Nil(t, cal)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).
+		WithArgs(int64(2), "BLACKOUT", now, now).
+		WillReturnError(assert.AnError)
+
+	cal, err = store.GetActiveBlackout(ctx, 2, now)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, cal)
+}
@@ -0,0 +1,64 @@
+package resource
+
+import (
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// InsertEvent appends an event to the change history of a resource.
+func (*Store) InsertEvent(ctx *gofr.Context, event *models.Event) error {
+	_, err := ctx.SQL.ExecContext(ctx,
+		`INSERT INTO resource_events (resource_id, cloud_account_id, event_type, from_status, to_status, actor, 
+message) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		event.ResourceID, event.CloudAccountID, event.Type, event.FromStatus, event.ToStatus, event.Actor, event.Message)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetEvents fetches the change history of a resource, the most recent event first.
+// The zero value of from and to leaves the corresponding end of the time range open. The bounds are compared in UTC,
+// the time zone in which created_at is stored.
+func (*Store) GetEvents(ctx *gofr.Context, cloudAccountID, resourceID int64, from, to time.Time) ([]models.Event, error) {
+	events := make([]models.Event, 0)
+	args := []any{cloudAccountID, resourceID}
+	timeClause := ``
+
+	if !from.IsZero() {
+		timeClause += ` AND created_at >= ?`
+
+		args = append(args, from.UTC())
+	}
+
+	if !to.IsZero() {
+		timeClause += ` AND created_at <= ?`
+
+		args = append(args, to.UTC())
+	}
+
+	rows, err := ctx.SQL.QueryContext(ctx, `SELECT id, resource_id, cloud_account_id, event_type, from_status, 
+       to_status, actor, message, created_at FROM resource_events WHERE cloud_account_id = ? AND resource_id = ?`+
+		timeClause+` ORDER BY created_at DESC, id DESC`, args...)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var event models.Event
+		if er := rows.Scan(&event.ID, &event.ResourceID, &event.CloudAccountID, &event.Type, &event.FromStatus,
+			&event.ToStatus, &event.Actor, &event.Message, &event.CreatedAt); er != nil {
+			return nil, er
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
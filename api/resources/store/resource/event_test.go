@@ -0,0 +1,118 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func TestStore_InsertEvent(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	query := `INSERT INTO resource_events (resource_id, cloud_account_id, event_type, from_status, to_status, actor, 
+message) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	event := &models.Event{ResourceID: 1, CloudAccountID: 2, Type: "STATE_CHANGE_REQUESTED", FromStatus: "RUNNING",
+		ToStatus: "STOPPED", Actor: "jane"}
+
+	mocks.SQL.Sqlmock.ExpectExec(query).
+		WithArgs(int64(1), int64(2), "STATE_CHANGE_REQUESTED", "RUNNING", "STOPPED", "jane", "").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := store.InsertEvent(ctx, event)
+	require.NoError(t, err)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).
+		WithArgs(int64(1), int64(2), "STATE_CHANGE_REQUESTED", "RUNNING", "STOPPED", "jane", "").
+		WillReturnError(assert.AnError)
+
+	err = store.InsertEvent(ctx, event)
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestStore_GetEvents(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	mockTime := time.Now()
+	from := mockTime.Add(-time.Hour)
+	// The bounds of the time range of a caller in UTC+05:30.
+	offsetFrom := time.Date(2025, 7, 1, 15, 30, 0, 0, time.FixedZone("IST", 5*60*60+30*60))
+	offsetTo := offsetFrom.Add(time.Hour)
+	columns := []string{"id", "resource_id", "cloud_account_id", "event_type", "from_status", "to_status", "actor",
+		"message", "created_at"}
+	query := `SELECT id, resource_id, cloud_account_id, event_type, from_status, 
+       to_status, actor, message, created_at FROM resource_events WHERE cloud_account_id = ? AND resource_id = ?`
+
+	testCases := []struct {
+		name      string
+		from, to  time.Time
+		expResp   []models.Event
+		expErr    error
+		mockCalls func()
+	}{
+		{
+			name: "Time range",
+			from: from,
+			to:   mockTime,
+			expResp: []models.Event{{ID: 1, ResourceID: 1, CloudAccountID: 2, Type: "REMOVED", FromStatus: "RUNNING",
+				Actor: "resource-sync", CreatedAt: mockTime}},
+			mockCalls: func() {
+				mocks.SQL.Sqlmock.ExpectQuery(query+` AND created_at >= ? AND created_at <= ? ORDER BY created_at DESC, id DESC`).
+					WithArgs(int64(2), int64(1), from.UTC(), mockTime.UTC()).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(1, 1, 2, "REMOVED", "RUNNING", "", "resource-sync", "", mockTime))
+			},
+		},
+		{
+			name:    "Time range with an offset",
+			from:    offsetFrom,
+			to:      offsetTo,
+			expResp: []models.Event{},
+			mockCalls: func() {
+				mocks.SQL.Sqlmock.ExpectQuery(query+` AND created_at >= ? AND created_at <= ? ORDER BY created_at DESC, id DESC`).
+					WithArgs(int64(2), int64(1), time.Date(2025, 7, 1, 10, 0, 0, 0, time.UTC),
+						time.Date(2025, 7, 1, 11, 0, 0, 0, time.UTC)).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name:    "Open range",
+			expResp: []models.Event{},
+			mockCalls: func() {
+				mocks.SQL.Sqlmock.ExpectQuery(query+` ORDER BY created_at DESC, id DESC`).
+					WithArgs(int64(2), int64(1)).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name:   "Query error",
+			from:   from,
+			expErr: assert.AnError,
+			mockCalls: func() {
+				mocks.SQL.Sqlmock.ExpectQuery(query+` AND created_at >= ? ORDER BY created_at DESC, id DESC`).
+					WithArgs(int64(2), int64(1), from.UTC()).
+					WillReturnError(assert.AnError)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCalls()
+
+			events, err := store.GetEvents(ctx, 2, 1, tc.from, tc.to)
+
+			assert.Equal(t, tc.expErr, err)
+			assert.Equal(t, tc.expResp, events)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package resource
+
+import (
+	"sort"
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+	gofrSQL "gofr.dev/pkg/gofr/datasource/sql"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// UpdateLabels replaces the labels of a resource with the provided labels. The labels are replaced in a transaction
+// so that a failed insert keeps the previous labels.
+func (*Store) UpdateLabels(ctx *gofr.Context, resourceID int64, labels models.Labels) error {
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return err
+	}
+
+	err = replaceLabels(ctx, tx, resourceID, labels)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func replaceLabels(ctx *gofr.Context, tx *gofrSQL.Tx, resourceID int64, labels models.Labels) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM resource_labels WHERE resource_id = ?`, resourceID)
+	if err != nil {
+		return err
+	}
+
+	if len(labels) == 0 {
+		return nil
+	}
+
+	// The keys are sorted so that the labels are always inserted in the same order.
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	values := make([]string, 0, len(keys))
+	args := make([]any, 0, 3*len(keys))
+
+	for _, k := range keys {
+		values = append(values, `(?, ?, ?)`)
+		args = append(args, resourceID, k, labels[k])
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO resource_labels (resource_id, label_key, label_value) VALUES `+
+		strings.Join(values, `, `), args...)
+
+	return err
+}
+
+// labelClause forms the conditions on the resources table for the label selectors and appends their arguments.
+func labelClause(selectors []models.LabelSelector, args []any) (string, []any) {
+	clause := ``
+
+	for _, sel := range selectors {
+		switch sel.Operator {
+		case models.LabelEquals:
+			clause += ` AND id IN (SELECT resource_id FROM resource_labels WHERE label_key = ? AND label_value = ?)`
+
+			args = append(args, sel.Key, sel.Value)
+		case models.LabelNotEquals:
+			clause += ` AND id NOT IN (SELECT resource_id FROM resource_labels WHERE label_key = ? AND label_value = ?)`
+
+			args = append(args, sel.Key, sel.Value)
+		case models.LabelExists:
+			clause += ` AND id IN (SELECT resource_id FROM resource_labels WHERE label_key = ?)`
+
+			args = append(args, sel.Key)
+		}
+	}
+
+	return clause, args
+}
+
+// getLabels fetches the labels of all the resources of a cloud account, keyed by the resource ID.
+func getLabels(ctx *gofr.Context, cloudAccountID int64) (map[int64]models.Labels, error) {
+	labels := make(map[int64]models.Labels)
+
+	rows, err := ctx.SQL.QueryContext(ctx, `SELECT l.resource_id, l.label_key, l.label_value 
+		FROM resource_labels l JOIN resources r ON r.id = l.resource_id WHERE r.cloud_account_id = ?`, cloudAccountID)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id         int64
+			key, value string
+		)
+
+		if er := rows.Scan(&id, &key, &value); er != nil {
+			return nil, er
+		}
+
+		if labels[id] == nil {
+			labels[id] = make(models.Labels)
+		}
+
+		labels[id][key] = value
+	}
+
+	return labels, nil
+}
+
+// getLabelsByIDs fetches the labels of the resources with the given IDs, keyed by the resource ID.
+func getLabelsByIDs(ctx *gofr.Context, ids []int64) (map[int64]models.Labels, error) {
+	labels := make(map[int64]models.Labels)
+	args := make([]any, 0, len(ids))
+
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	rows, err := ctx.SQL.QueryContext(ctx, `SELECT resource_id, label_key, label_value FROM resource_labels
+		WHERE resource_id IN (`+strings.TrimSuffix(strings.Repeat(`?, `, len(ids)), `, `)+`)`, args...)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id         int64
+			key, value string
+		)
+
+		if er := rows.Scan(&id, &key, &value); er != nil {
+			return nil, er
+		}
+
+		if labels[id] == nil {
+			labels[id] = make(models.Labels)
+		}
+
+		labels[id][key] = value
+	}
+
+	return labels, nil
+}
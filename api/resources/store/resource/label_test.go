@@ -0,0 +1,133 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func TestStore_UpdateLabels(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+
+	testCases := []struct {
+		name      string
+		labels    models.Labels
+		expErr    error
+		mockCalls func()
+	}{
+		{
+			name:   "Replace labels",
+			labels: models.Labels{"team": "payments", "env": "dev"},
+			mockCalls: func() {
+				mocks.SQL.Sqlmock.ExpectBegin()
+				mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM resource_labels WHERE resource_id = ?`).
+					WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+				mocks.SQL.Sqlmock.ExpectExec(`INSERT INTO resource_labels (resource_id, label_key, label_value) `+
+					`VALUES (?, ?, ?), (?, ?, ?)`).
+					WithArgs(int64(1), "env", "dev", int64(1), "team", "payments").
+					WillReturnResult(sqlmock.NewResult(2, 2))
+				mocks.SQL.Sqlmock.ExpectCommit()
+			},
+		},
+		{
+			name: "Remove all labels",
+			mockCalls: func() {
+				mocks.SQL.Sqlmock.ExpectBegin()
+				mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM resource_labels WHERE resource_id = ?`).
+					WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+				mocks.SQL.Sqlmock.ExpectCommit()
+			},
+		},
+		{
+			name:   "Begin error",
+			labels: models.Labels{"team": "payments"},
+			expErr: assert.AnError,
+			mockCalls: func() {
+				mocks.SQL.Sqlmock.ExpectBegin().WillReturnError(assert.AnError)
+			},
+		},
+		{
+			name:   "Delete error",
+			labels: models.Labels{"team": "payments"},
+			expErr: assert.AnError,
+			mockCalls: func() {
+				mocks.SQL.Sqlmock.ExpectBegin()
+				mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM resource_labels WHERE resource_id = ?`).
+					WithArgs(int64(1)).WillReturnError(assert.AnError)
+				mocks.SQL.Sqlmock.ExpectRollback()
+			},
+		},
+		{
+			name:   "Insert error keeps the previous labels",
+			labels: models.Labels{"team": "payments"},
+			expErr: assert.AnError,
+			mockCalls: func() {
+				mocks.SQL.Sqlmock.ExpectBegin()
+				mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM resource_labels WHERE resource_id = ?`).
+					WithArgs(int64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+				mocks.SQL.Sqlmock.ExpectExec(`INSERT INTO resource_labels (resource_id, label_key, label_value) `+
+					`VALUES (?, ?, ?)`).
+					WithArgs(int64(1), "team", "payments").WillReturnError(assert.AnError)
+				mocks.SQL.Sqlmock.ExpectRollback()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockCalls()
+
+			err := store.UpdateLabels(ctx, 1, tc.labels)
+
+			assert.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestStore_GetResources_LabelSelectors(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	mockTime := time.Now()
+	settings := models.Settings{"zone": "us-central1-a"}
+
+	mocks.SQL.Sqlmock.ExpectQuery(`SELECT id, resource_uid, name, state, cloud_account_id, 
+       cloud_provider, resource_type, created_at, updated_at, settings, region, 
+       vcpu, memory_gb, storage_gb, machine_class, high_availability
+		FROM resources WHERE cloud_account_id = ?`+
+		` AND id IN (SELECT resource_id FROM resource_labels WHERE label_key = ? AND label_value = ?)`+
+		` AND id NOT IN (SELECT resource_id FROM resource_labels WHERE label_key = ? AND label_value = ?)`+
+		` AND id IN (SELECT resource_id FROM resource_labels WHERE label_key = ?) ORDER BY resource_uid`).
+		WithArgs(123, "team", "payments", "env", "prod", "owner").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "resource_uid", "name", "state", "cloud_account_id",
+			"cloud_provider", "resource_type", "created_at", "updated_at", "settings", "region",
+			"vcpu", "memory_gb", "storage_gb", "machine_class", "high_availability"}).
+			AddRow(1, "zopdev/vm-1", "vm-1", "RUNNING", 123, "GCP", "GCE", mockTime, mockTime, &settings, "us-central1",
+				2, 8, 20, "e2-standard-2", false))
+	mocks.SQL.Sqlmock.ExpectQuery(`SELECT l.resource_id, l.label_key, l.label_value 
+		FROM resource_labels l JOIN resources r ON r.id = l.resource_id WHERE r.cloud_account_id = ?`).
+		WithArgs(123).
+		WillReturnRows(sqlmock.NewRows([]string{"resource_id", "label_key", "label_value"}).
+			AddRow(1, "team", "payments").AddRow(1, "owner", "jane"))
+
+	resources, err := store.GetResources(ctx, 123, nil,
+		models.LabelSelector{Key: "team", Value: "payments", Operator: models.LabelEquals},
+		models.LabelSelector{Key: "env", Value: "prod", Operator: models.LabelNotEquals},
+		models.LabelSelector{Key: "owner", Operator: models.LabelExists})
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{{ID: 1, UID: "zopdev/vm-1", Name: "vm-1", Status: "RUNNING", Type: "GCE",
+		CloudAccount: models.CloudAccount{ID: 123, Type: "GCP"}, CreatedAt: mockTime, UpdatedAt: mockTime,
+		Settings: settings, Region: "us-central1", Labels: models.Labels{"team": "payments", "owner": "jane"},
+		Spec: models.Spec{VCPU: 2, MemoryGB: 8, StorageGB: 20, MachineClass: "e2-standard-2"}}}, resources)
+}
@@ -0,0 +1,108 @@
+package resource
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// InsertLock inserts a keep-awake lock and sets its ID.
+func (*Store) InsertLock(ctx *gofr.Context, lock *models.Lock) error {
+	res, err := ctx.SQL.ExecContext(ctx, `INSERT INTO keep_awake_locks (cloud_account_id, resource_id, group_id, until,
+       author, reason) VALUES (?, ?, ?, ?, ?, ?)`,
+		lock.CloudAccountID, lock.ResourceID, lock.GroupID, lock.Until, lock.Author, lock.Reason)
+	if err != nil {
+		return err
+	}
+
+	lock.ID, err = res.LastInsertId()
+
+	return err
+}
+
+// GetLocks fetches the locks of a cloud account that are active at a time, the lock that expires first comes first.
+// A non-zero resource or group ID restricts the locks to those held on the resource or on the group.
+func (*Store) GetLocks(ctx *gofr.Context, cloudAccountID, resourceID, groupID int64,
+	at time.Time) ([]models.Lock, error) {
+	clause := ``
+	args := []any{cloudAccountID, at}
+
+	if resourceID != 0 {
+		clause += ` AND resource_id = ?`
+
+		args = append(args, resourceID)
+	}
+
+	if groupID != 0 {
+		clause += ` AND group_id = ?`
+
+		args = append(args, groupID)
+	}
+
+	rows, err := ctx.SQL.QueryContext(ctx, `SELECT id, cloud_account_id, resource_id, group_id, until, author, reason,
+       created_at FROM keep_awake_locks WHERE cloud_account_id = ? AND until > ?`+clause+` ORDER BY until, id`,
+		args...)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	locks := make([]models.Lock, 0)
+
+	for rows.Next() {
+		var lock models.Lock
+		if er := rows.Scan(&lock.ID, &lock.CloudAccountID, &lock.ResourceID, &lock.GroupID, &lock.Until, &lock.Author,
+			&lock.Reason, &lock.CreatedAt); er != nil {
+			return nil, er
+		}
+
+		locks = append(locks, lock)
+	}
+
+	return locks, nil
+}
+
+// GetActiveLock fetches the lock that keeps a resource awake at a time, held either on the resource or on one of its
+// resource groups. The lock that expires last is returned, nil is returned when the resource is not locked.
+func (*Store) GetActiveLock(ctx *gofr.Context, resourceID int64, at time.Time) (*models.Lock, error) {
+	row := ctx.SQL.QueryRowContext(ctx, `SELECT id, cloud_account_id, resource_id, group_id, until, author, reason,
+       created_at FROM keep_awake_locks WHERE until > ? AND (resource_id = ? OR group_id IN
+       (SELECT group_id FROM resource_group_memberships WHERE resource_id = ?)) ORDER BY until DESC, id DESC LIMIT 1`,
+		at, resourceID, resourceID)
+
+	var lock models.Lock
+
+	err := row.Scan(&lock.ID, &lock.CloudAccountID, &lock.ResourceID, &lock.GroupID, &lock.Until, &lock.Author,
+		&lock.Reason, &lock.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &lock, nil
+}
+
+// ReleaseLock expires an active lock of a cloud account at a time. It reports whether the lock was active.
+func (*Store) ReleaseLock(ctx *gofr.Context, cloudAccountID, id int64, at time.Time) (bool, error) {
+	res, err := ctx.SQL.ExecContext(ctx,
+		`UPDATE keep_awake_locks SET until = ? WHERE id = ? AND cloud_account_id = ? AND until > ?`,
+		at, id, cloudAccountID, at)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
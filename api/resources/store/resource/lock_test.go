@@ -0,0 +1,145 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const lockQuery = `SELECT id, cloud_account_id, resource_id, group_id, until, author, reason,
+       created_at FROM keep_awake_locks`
+
+var lockColumns = []string{"id", "cloud_account_id", "resource_id", "group_id", "until", "author", "reason",
+	"created_at"}
+
+func TestStore_InsertLock(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	query := `INSERT INTO keep_awake_locks (cloud_account_id, resource_id, group_id, until,
+       author, reason) VALUES (?, ?, ?, ?, ?, ?)`
+	until := time.Now().Add(time.Hour)
+	lock := &models.Lock{CloudAccountID: 1, GroupID: 2, Until: until, Author: "jane", Reason: "demo"}
+
+	mocks.SQL.Sqlmock.ExpectExec(query).
+		WithArgs(int64(1), int64(0), int64(2), until, "jane", "demo").
+		WillReturnResult(sqlmock.NewResult(4, 1))
+
+	require.NoError(t, store.InsertLock(ctx, lock))
+	assert.Equal(t, int64(4), lock.ID)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).
+		WithArgs(int64(1), int64(0), int64(2), until, "jane", "demo").
+		WillReturnError(assert.AnError)
+
+	assert.Equal(t, assert.AnError, store.InsertLock(ctx, lock))
+}
+
+func TestStore_GetLocks(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	now := time.Now()
+
+	mocks.SQL.Sqlmock.ExpectQuery(lockQuery+
+		` WHERE cloud_account_id = ? AND until > ? AND resource_id = ? AND group_id = ? ORDER BY until, id`).
+		WithArgs(int64(1), now, int64(2), int64(3)).
+		WillReturnRows(sqlmock.NewRows(lockColumns).
+			AddRow(4, 1, 2, 0, now.Add(time.Hour), "jane", "demo", now))
+
+	locks, err := store.GetLocks(ctx, 1, 2, 3, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Lock{{ID: 4, CloudAccountID: 1, ResourceID: 2, Until: now.Add(time.Hour),
+		Author: "jane", Reason: "demo", CreatedAt: now}}, locks)
+
+	mocks.SQL.Sqlmock.ExpectQuery(lockQuery+` WHERE cloud_account_id = ? AND until > ? ORDER BY until, id`).
+		WithArgs(int64(1), now).
+		WillReturnError(assert.AnError)
+
+	locks, err = store.GetLocks(ctx, 1, 0, 0, now)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, locks)
+}
+
+func TestStore_GetActiveLock(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	now := time.Now()
+	query := lockQuery + ` WHERE until > ? AND (resource_id = ? OR group_id IN
+       (SELECT group_id FROM resource_group_memberships WHERE resource_id = ?)) ORDER BY until DESC, id DESC LIMIT 1`
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).
+		WithArgs(now, int64(2), int64(2)).
+		WillReturnRows(sqlmock.NewRows(lockColumns).
+			AddRow(4, 1, 0, 3, now.Add(time.Hour), "jane", "", now))
+
+	lock, err := store.GetActiveLock(ctx, 2, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.Lock{ID: 4, CloudAccountID: 1, GroupID: 3, Until: now.Add(time.Hour), Author: "jane",
+		CreatedAt: now}, lock)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).
+		WithArgs(now, int64(2), int64(2)).
+		WillReturnRows(sqlmock.NewRows(lockColumns))
+
+	lock, err = store.GetActiveLock(ctx, 2, now)
+
+	require.NoError(t, err)
+	assert.Nil(t, lock)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).
+		WithArgs(now, int64(2), int64(2)).
+		WillReturnError(assert.AnError)
+
+	lock, err = store.GetActiveLock(ctx, 2, now)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, lock)
+}
+
+func TestStore_ReleaseLock(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	now := time.Now()
+	query := `UPDATE keep_awake_locks SET until = ? WHERE id = ? AND cloud_account_id = ? AND until > ?`
+
+	mocks.SQL.Sqlmock.ExpectExec(query).
+		WithArgs(now, int64(4), int64(1), now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	released, err := store.ReleaseLock(ctx, 1, 4, now)
+
+	require.NoError(t, err)
+	assert.True(t, released)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).
+		WithArgs(now, int64(4), int64(1), now).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	released, err = store.ReleaseLock(ctx, 1, 4, now)
+
+	require.NoError(t, err)
+	assert.False(t, released)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).
+		WithArgs(now, int64(4), int64(1), now).
+		WillReturnError(assert.AnError)
+
+	released, err = store.ReleaseLock(ctx, 1, 4, now)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.False(t, released)
+}
@@ -0,0 +1,83 @@
+package resource
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// InsertOperation records a state change requested for a resource and sets the ID of the inserted operation.
+func (*Store) InsertOperation(ctx *gofr.Context, op *models.Operation) error {
+	result, err := ctx.SQL.ExecContext(ctx,
+		`INSERT INTO resource_operations (resource_id, cloud_account_id, action, target_state, status, error, 
+completed_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		op.ResourceID, op.CloudAccountID, op.Action, op.TargetState, op.Status, op.Error, op.CompletedAt)
+	if err != nil {
+		return err
+	}
+
+	op.ID, err = result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetOperations fetches the operations of a resource, the most recent operation first.
+func (*Store) GetOperations(ctx *gofr.Context, resourceID int64) ([]models.Operation, error) {
+	return getOperations(ctx, `resource_id = ?`, resourceID)
+}
+
+// GetOperationsByStatus fetches the operations of all resources that are in the given status.
+func (*Store) GetOperationsByStatus(ctx *gofr.Context, status string) ([]models.Operation, error) {
+	return getOperations(ctx, `status = ?`, status)
+}
+
+// HasOperation reports whether a resource has an operation in the given status.
+func (*Store) HasOperation(ctx *gofr.Context, resourceID int64, status string) (bool, error) {
+	var exists bool
+
+	err := ctx.SQL.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM resource_operations WHERE resource_id = ? 
+AND status = ?)`, resourceID, status).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// CompleteOperation marks an operation as completed with the given status and error message.
+func (*Store) CompleteOperation(ctx *gofr.Context, id int64, status, errMsg string) error {
+	_, err := ctx.SQL.ExecContext(ctx, `UPDATE resource_operations SET status = ?, error = ?, 
+updated_at = CURRENT_TIMESTAMP, completed_at = CURRENT_TIMESTAMP WHERE id = ?`, status, errMsg, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func getOperations(ctx *gofr.Context, where string, arg any) ([]models.Operation, error) {
+	operations := make([]models.Operation, 0)
+
+	rows, err := ctx.SQL.QueryContext(ctx, `SELECT id, resource_id, cloud_account_id, action, target_state, status, 
+       error, created_at, updated_at, completed_at FROM resource_operations WHERE `+where+` ORDER BY id DESC`, arg)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var op models.Operation
+		if er := rows.Scan(&op.ID, &op.ResourceID, &op.CloudAccountID, &op.Action, &op.TargetState, &op.Status,
+			&op.Error, &op.CreatedAt, &op.UpdatedAt, &op.CompletedAt); er != nil {
+			return nil, er
+		}
+
+		operations = append(operations, op)
+	}
+
+	return operations, nil
+}
@@ -0,0 +1,138 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func TestStore_InsertOperation(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	query := `INSERT INTO resource_operations (resource_id, cloud_account_id, action, target_state, status, error, 
+completed_at) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	op := &models.Operation{ResourceID: 1, CloudAccountID: 2, Action: "START", TargetState: "RUNNING",
+		Status: "IN_PROGRESS"}
+
+	mocks.SQL.Sqlmock.ExpectExec(query).
+		WithArgs(int64(1), int64(2), "START", "RUNNING", "IN_PROGRESS", "", op.CompletedAt).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+
+	err := store.InsertOperation(ctx, op)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), op.ID)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).
+		WithArgs(int64(1), int64(2), "START", "RUNNING", "IN_PROGRESS", "", op.CompletedAt).
+		WillReturnError(assert.AnError)
+
+	err = store.InsertOperation(ctx, op)
+
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestStore_GetOperations(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	mockTime := time.Now()
+	columns := []string{"id", "resource_id", "cloud_account_id", "action", "target_state", "status", "error",
+		"created_at", "updated_at", "completed_at"}
+	query := `SELECT id, resource_id, cloud_account_id, action, target_state, status, 
+       error, created_at, updated_at, completed_at FROM resource_operations WHERE resource_id = ? ORDER BY id DESC`
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(2, 1, 3, "SUSPEND", "STOPPED", "IN_PROGRESS", "", mockTime, mockTime, nil).
+			AddRow(1, 1, 3, "START", "RUNNING", "FAILED", "quota exceeded", mockTime, mockTime, mockTime))
+
+	ops, err := store.GetOperations(ctx, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Operation{
+		{ID: 2, ResourceID: 1, CloudAccountID: 3, Action: "SUSPEND", TargetState: "STOPPED", Status: "IN_PROGRESS",
+			CreatedAt: mockTime, UpdatedAt: mockTime},
+		{ID: 1, ResourceID: 1, CloudAccountID: 3, Action: "START", TargetState: "RUNNING", Status: "FAILED",
+			Error: "quota exceeded", CreatedAt: mockTime, UpdatedAt: mockTime, CompletedAt: &mockTime},
+	}, ops)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(int64(1)).WillReturnError(assert.AnError)
+
+	ops, err = store.GetOperations(ctx, 1)
+
+	assert.Equal(t, assert.AnError, err)
+	assert.Nil(t, ops)
+}
+
+func TestStore_GetOperationsByStatus(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	mockTime := time.Now()
+
+	mocks.SQL.Sqlmock.ExpectQuery(`SELECT id, resource_id, cloud_account_id, action, target_state, status, 
+       error, created_at, updated_at, completed_at FROM resource_operations WHERE status = ? ORDER BY id DESC`).
+		WithArgs("IN_PROGRESS").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "resource_id", "cloud_account_id", "action", "target_state",
+			"status", "error", "created_at", "updated_at", "completed_at"}).
+			AddRow(2, 1, 3, "SUSPEND", "STOPPED", "IN_PROGRESS", "", mockTime, mockTime, nil))
+
+	ops, err := store.GetOperationsByStatus(ctx, "IN_PROGRESS")
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Operation{{ID: 2, ResourceID: 1, CloudAccountID: 3, Action: "SUSPEND",
+		TargetState: "STOPPED", Status: "IN_PROGRESS", CreatedAt: mockTime, UpdatedAt: mockTime}}, ops)
+}
+
+func TestStore_HasOperation(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	query := `SELECT EXISTS (SELECT 1 FROM resource_operations WHERE resource_id = ? 
+AND status = ?)`
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(int64(1), "IN_PROGRESS").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	exists, err := store.HasOperation(ctx, 1, "IN_PROGRESS")
+
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(int64(1), "IN_PROGRESS").WillReturnError(assert.AnError)
+
+	exists, err = store.HasOperation(ctx, 1, "IN_PROGRESS")
+
+	assert.Equal(t, assert.AnError, err)
+	assert.False(t, exists)
+}
+
+func TestStore_CompleteOperation(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	query := `UPDATE resource_operations SET status = ?, error = ?, 
+updated_at = CURRENT_TIMESTAMP, completed_at = CURRENT_TIMESTAMP WHERE id = ?`
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs("SUCCEEDED", "", int64(1)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := store.CompleteOperation(ctx, 1, "SUCCEEDED", "")
+	require.NoError(t, err)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs("FAILED", "timed out", int64(1)).
+		WillReturnError(assert.AnError)
+
+	err = store.CompleteOperation(ctx, 1, "FAILED", "timed out")
+	assert.Equal(t, assert.AnError, err)
+}
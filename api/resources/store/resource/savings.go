@@ -0,0 +1,97 @@
+package resource
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// InsertSavings opens a savings ledger entry for a suspended resource and sets the ID of the inserted entry.
+func (*Store) InsertSavings(ctx *gofr.Context, savings *models.Savings) error {
+	result, err := ctx.SQL.ExecContext(ctx, `INSERT INTO resource_savings (resource_id, cloud_account_id, hourly_rate,
+currency, stopped_at) VALUES (?, ?, ?, ?, ?)`,
+		savings.ResourceID, savings.CloudAccountID, savings.HourlyRate, savings.Currency, savings.StoppedAt)
+	if err != nil {
+		return err
+	}
+
+	savings.ID, err = result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetOpenSavings fetches the open savings ledger entry of a resource, nil is returned if the resource has none.
+func (*Store) GetOpenSavings(ctx *gofr.Context, resourceID int64) (*models.Savings, error) {
+	var s models.Savings
+
+	row := ctx.SQL.QueryRowContext(ctx, `SELECT id, resource_id, cloud_account_id, hourly_rate, currency, stopped_at,
+       started_at, amount FROM resource_savings WHERE resource_id = ? AND started_at IS NULL ORDER BY id DESC LIMIT 1`,
+		resourceID)
+
+	err := row.Scan(&s.ID, &s.ResourceID, &s.CloudAccountID, &s.HourlyRate, &s.Currency, &s.StoppedAt,
+		&s.StartedAt, &s.Amount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// CloseSavings closes a savings ledger entry with the time the resource was started again and the saved amount.
+func (*Store) CloseSavings(ctx *gofr.Context, id int64, startedAt time.Time, amount float64) error {
+	_, err := ctx.SQL.ExecContext(ctx, `UPDATE resource_savings SET started_at = ?, amount = ? WHERE id = ?`,
+		startedAt, amount, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// VoidSavings deletes a savings ledger entry of a resource that was not suspended after all.
+func (*Store) VoidSavings(ctx *gofr.Context, id int64) error {
+	_, err := ctx.SQL.ExecContext(ctx, `DELETE FROM resource_savings WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSavings fetches the savings ledger entries of a cloud account whose downtime overlaps the time range,
+// including the entries that are still open.
+func (*Store) GetSavings(ctx *gofr.Context, cloudAccountID int64, from, to time.Time) ([]models.Savings, error) {
+	savings := make([]models.Savings, 0)
+
+	rows, err := ctx.SQL.QueryContext(ctx, `SELECT id, resource_id, cloud_account_id, hourly_rate, currency, stopped_at,
+       started_at, amount FROM resource_savings WHERE cloud_account_id = ? AND stopped_at < ?
+       AND (started_at IS NULL OR started_at > ?) ORDER BY id`, cloudAccountID, to, from)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var s models.Savings
+		if er := rows.Scan(&s.ID, &s.ResourceID, &s.CloudAccountID, &s.HourlyRate, &s.Currency, &s.StoppedAt,
+			&s.StartedAt, &s.Amount); er != nil {
+			return nil, er
+		}
+
+		savings = append(savings, s)
+	}
+
+	return savings, nil
+}
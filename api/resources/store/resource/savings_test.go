@@ -0,0 +1,136 @@
+package resource
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+var savingsColumns = []string{"id", "resource_id", "cloud_account_id", "hourly_rate", "currency", "stopped_at",
+	"started_at", "amount"}
+
+func TestStore_InsertSavings(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	stoppedAt := time.Now()
+	query := `INSERT INTO resource_savings (resource_id, cloud_account_id, hourly_rate,
+currency, stopped_at) VALUES (?, ?, ?, ?, ?)`
+	savings := &models.Savings{ResourceID: 1, CloudAccountID: 2, HourlyRate: 0.2, Currency: "USD", StoppedAt: stoppedAt}
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(int64(1), int64(2), 0.2, "USD", stoppedAt).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+
+	err := store.InsertSavings(ctx, savings)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), savings.ID)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(int64(1), int64(2), 0.2, "USD", stoppedAt).
+		WillReturnError(assert.AnError)
+
+	err = store.InsertSavings(ctx, savings)
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestStore_GetOpenSavings(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	stoppedAt := time.Now()
+	query := `SELECT id, resource_id, cloud_account_id, hourly_rate, currency, stopped_at,
+       started_at, amount FROM resource_savings WHERE resource_id = ? AND started_at IS NULL ORDER BY id DESC LIMIT 1`
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows(savingsColumns).AddRow(5, 1, 2, 0.2, "USD", stoppedAt, nil, 0))
+
+	savings, err := store.GetOpenSavings(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &models.Savings{ID: 5, ResourceID: 1, CloudAccountID: 2, HourlyRate: 0.2, Currency: "USD",
+		StoppedAt: stoppedAt}, savings)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(int64(1)).WillReturnError(sql.ErrNoRows)
+
+	savings, err = store.GetOpenSavings(ctx, 1)
+	require.NoError(t, err)
+	assert.Nil(t, savings)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(int64(1)).WillReturnError(assert.AnError)
+
+	_, err = store.GetOpenSavings(ctx, 1)
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestStore_CloseSavings(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	startedAt := time.Now()
+	query := `UPDATE resource_savings SET started_at = ?, amount = ? WHERE id = ?`
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(startedAt, 1.5, int64(5)).WillReturnResult(sqlmock.NewResult(5, 1))
+
+	err := store.CloseSavings(ctx, 5, startedAt, 1.5)
+	require.NoError(t, err)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(startedAt, 1.5, int64(5)).WillReturnError(assert.AnError)
+
+	err = store.CloseSavings(ctx, 5, startedAt, 1.5)
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestStore_VoidSavings(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	query := `DELETE FROM resource_savings WHERE id = ?`
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(int64(5)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := store.VoidSavings(ctx, 5)
+	require.NoError(t, err)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(int64(5)).WillReturnError(assert.AnError)
+
+	err = store.VoidSavings(ctx, 5)
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestStore_GetSavings(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	stoppedAt := from.Add(time.Hour)
+	startedAt := from.Add(3 * time.Hour)
+	query := `SELECT id, resource_id, cloud_account_id, hourly_rate, currency, stopped_at,
+       started_at, amount FROM resource_savings WHERE cloud_account_id = ? AND stopped_at < ?
+       AND (started_at IS NULL OR started_at > ?) ORDER BY id`
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(int64(2), to, from).
+		WillReturnRows(sqlmock.NewRows(savingsColumns).
+			AddRow(5, 1, 2, 0.2, "USD", stoppedAt, startedAt, 0.4).
+			AddRow(6, 3, 2, 0.1, "USD", stoppedAt, nil, 0))
+
+	savings, err := store.GetSavings(ctx, 2, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, []models.Savings{
+		{ID: 5, ResourceID: 1, CloudAccountID: 2, HourlyRate: 0.2, Currency: "USD", StoppedAt: stoppedAt,
+			StartedAt: &startedAt, Amount: 0.4},
+		{ID: 6, ResourceID: 3, CloudAccountID: 2, HourlyRate: 0.1, Currency: "USD", StoppedAt: stoppedAt},
+	}, savings)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(int64(2), to, from).WillReturnError(assert.AnError)
+
+	_, err = store.GetSavings(ctx, 2, from, to)
+	assert.Equal(t, assert.AnError, err)
+}
@@ -0,0 +1,149 @@
+package resource
+
+import (
+	"strings"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// sortColumns are the columns of the resources table by which the resources are sorted. Resources are inserted in
+// the order they are created, their ID orders them by creation time.
+var sortColumns = map[string]string{
+	models.SortByName:      "name",
+	models.SortByStatus:    "state",
+	models.SortByRegion:    "region",
+	models.SortByType:      "resource_type",
+	models.SortByProvider:  "cloud_provider",
+	models.SortByCreatedAt: "id",
+}
+
+// SearchResources fetches a page of the resources of all the cloud accounts matching the query. The page is sorted by
+// the sort field then by ID, and starts after the cursor of the query when set.
+func (*Store) SearchResources(ctx *gofr.Context, q *models.ResourceQuery) ([]models.Resource, error) {
+	column, ok := sortColumns[q.SortBy]
+	if !ok {
+		column = sortColumns[models.SortByName]
+	}
+
+	clause, args := searchClause(q)
+
+	order, cmp := ` ASC`, ` > `
+	if q.Descending {
+		order, cmp = ` DESC`, ` < `
+	}
+
+	if q.After != nil {
+		if column == "id" {
+			clause += ` AND id` + cmp + `?`
+
+			args = append(args, q.After.ID)
+		} else {
+			clause += ` AND (` + column + cmp + `? OR (` + column + ` = ? AND id` + cmp + `?))`
+
+			args = append(args, q.After.Value, q.After.Value, q.After.ID)
+		}
+	}
+
+	orderBy := ` ORDER BY id` + order
+	if column != "id" {
+		orderBy = ` ORDER BY ` + column + order + `, id` + order
+	}
+
+	args = append(args, q.Limit)
+
+	rows, err := ctx.SQL.QueryContext(ctx, `SELECT id, resource_uid, name, state, cloud_account_id,
+       cloud_provider, resource_type, created_at, updated_at, settings, region,
+       vcpu, memory_gb, storage_gb, machine_class, high_availability
+		FROM resources WHERE 1 = 1`+clause+orderBy+` LIMIT ?`, args...)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	resources := make([]models.Resource, 0)
+
+	for rows.Next() {
+		var res models.Resource
+		if er := rows.Scan(&res.ID, &res.UID, &res.Name, &res.Status,
+			&res.CloudAccount.ID, &res.CloudAccount.Type, &res.Type,
+			&res.CreatedAt, &res.UpdatedAt, &res.Settings, &res.Region, &res.Spec.VCPU, &res.Spec.MemoryGB,
+			&res.Spec.StorageGB, &res.Spec.MachineClass, &res.Spec.HighAvailability); er != nil {
+			return nil, er
+		}
+
+		resources = append(resources, res)
+	}
+
+	if len(resources) == 0 {
+		return resources, nil
+	}
+
+	ids := make([]int64, 0, len(resources))
+	for i := range resources {
+		ids = append(ids, resources[i].ID)
+	}
+
+	labels, err := getLabelsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resources {
+		resources[i].Labels = labels[resources[i].ID]
+	}
+
+	return resources, nil
+}
+
+// searchClause forms the conditions on the resources table for the filters of the query and their arguments.
+func searchClause(q *models.ResourceQuery) (string, []any) {
+	var (
+		clause string
+		args   = make([]any, 0, maxResTypes)
+	)
+
+	if q.Name != "" {
+		// The wildcards of the name are matched literally.
+		clause += ` AND name LIKE ? ESCAPE '\'`
+
+		args = append(args, `%`+likeEscaper.Replace(q.Name)+`%`)
+	}
+
+	in := func(column string, values []string) {
+		if len(values) == 0 {
+			return
+		}
+
+		clause += ` AND ` + column + ` IN (` + strings.TrimSuffix(strings.Repeat(`?, `, len(values)), `, `) + `)`
+
+		for _, v := range values {
+			args = append(args, v)
+		}
+	}
+
+	in(`state`, q.Statuses)
+	in(`region`, q.Regions)
+	in(`UPPER(cloud_provider)`, upper(q.Providers))
+	in(`resource_type`, q.Types)
+
+	labelFilter, args := labelClause(q.Selectors, args)
+
+	return clause + labelFilter, args
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// upper returns the values in upper case, the cloud provider of a resource is stored as entered for its account.
+func upper(values []string) []string {
+	out := make([]string, 0, len(values))
+
+	for _, v := range values {
+		out = append(out, strings.ToUpper(v))
+	}
+
+	return out
+}
@@ -0,0 +1,102 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const searchQuery = `SELECT id, resource_uid, name, state, cloud_account_id,
+       cloud_provider, resource_type, created_at, updated_at, settings, region,
+       vcpu, memory_gb, storage_gb, machine_class, high_availability
+		FROM resources WHERE 1 = 1`
+
+var searchColumns = []string{"id", "resource_uid", "name", "state", "cloud_account_id", "cloud_provider",
+	"resource_type", "created_at", "updated_at", "settings", "region", "vcpu", "memory_gb", "storage_gb",
+	"machine_class", "high_availability"}
+
+func TestStore_SearchResources(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	mockTime := time.Now()
+	settings := models.Settings{"zone": "us-central1-a"}
+
+	mocks.SQL.Sqlmock.ExpectQuery(searchQuery+
+		` AND name LIKE ? ESCAPE '\'`+
+		` AND state IN (?)`+
+		` AND region IN (?, ?)`+
+		` AND UPPER(cloud_provider) IN (?)`+
+		` AND resource_type IN (?)`+
+		` AND id IN (SELECT resource_id FROM resource_labels WHERE label_key = ? AND label_value = ?)`+
+		` AND (name > ? OR (name = ? AND id > ?)) ORDER BY name ASC, id ASC LIMIT ?`).
+		WithArgs(`%vm\_%`, "RUNNING", "us-central1", "us-east1", "GCP", "GCE", "team", "payments",
+			"vm-1", "vm-1", 1, 3).
+		WillReturnRows(sqlmock.NewRows(searchColumns).
+			AddRow(2, "zopdev/vm-2", "vm_2", "RUNNING", 123, "gcp", "GCE", mockTime, mockTime, &settings,
+				"us-central1", 2, 8, 20, "e2-standard-2", false).
+			AddRow(3, "other/vm-3", "vm_3", "RUNNING", 456, "GCP", "GCE", mockTime, mockTime, &settings,
+				"us-east1", 0, 0, 0, "", false))
+	mocks.SQL.Sqlmock.ExpectQuery(`SELECT resource_id, label_key, label_value FROM resource_labels
+		WHERE resource_id IN (?, ?)`).
+		WithArgs(2, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"resource_id", "label_key", "label_value"}).
+			AddRow(2, "team", "payments").AddRow(3, "team", "payments"))
+
+	resources, err := store.SearchResources(ctx, &models.ResourceQuery{
+		Name:      "vm_",
+		Statuses:  []string{"RUNNING"},
+		Regions:   []string{"us-central1", "us-east1"},
+		Providers: []string{"gcp"},
+		Types:     []string{"GCE"},
+		Selectors: []models.LabelSelector{{Key: "team", Value: "payments", Operator: models.LabelEquals}},
+		SortBy:    models.SortByName,
+		After:     &models.PageCursor{Value: "vm-1", ID: 1},
+		Limit:     3,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Resource{
+		{ID: 2, UID: "zopdev/vm-2", Name: "vm_2", Status: "RUNNING", Type: "GCE",
+			CloudAccount: models.CloudAccount{ID: 123, Type: "gcp"}, CreatedAt: mockTime, UpdatedAt: mockTime,
+			Settings: settings, Region: "us-central1", Labels: models.Labels{"team": "payments"},
+			Spec: models.Spec{VCPU: 2, MemoryGB: 8, StorageGB: 20, MachineClass: "e2-standard-2"}},
+		{ID: 3, UID: "other/vm-3", Name: "vm_3", Status: "RUNNING", Type: "GCE",
+			CloudAccount: models.CloudAccount{ID: 456, Type: "GCP"}, CreatedAt: mockTime, UpdatedAt: mockTime,
+			Settings: settings, Region: "us-east1", Labels: models.Labels{"team": "payments"}},
+	}, resources)
+}
+
+func TestStore_SearchResources_SortByCreatedAt(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+
+	// Resources are sorted by creation time through their ID.
+	mocks.SQL.Sqlmock.ExpectQuery(searchQuery+` AND id < ? ORDER BY id DESC LIMIT ?`).
+		WithArgs(10, 51).
+		WillReturnRows(sqlmock.NewRows(searchColumns))
+
+	resources, err := store.SearchResources(ctx, &models.ResourceQuery{SortBy: models.SortByCreatedAt,
+		Descending: true, After: &models.PageCursor{ID: 10}, Limit: 51})
+
+	require.NoError(t, err)
+	assert.Empty(t, resources)
+
+	mocks.SQL.Sqlmock.ExpectQuery(searchQuery + ` ORDER BY state ASC, id ASC LIMIT ?`).
+		WithArgs(51).
+		WillReturnError(assert.AnError)
+
+	resources, err = store.SearchResources(ctx, &models.ResourceQuery{SortBy: models.SortByStatus, Limit: 51})
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, resources)
+}
@@ -13,12 +13,19 @@ type Store struct{}
 
 func New() *Store { return &Store{} }
 
-// InsertResource inserts a new resource into the database.
+// InsertResource inserts a new resource into the database and sets the ID of the inserted resource.
 func (*Store) InsertResource(ctx *gofr.Context, res *models.Resource) error {
-	_, err := ctx.SQL.ExecContext(ctx,
+	result, err := ctx.SQL.ExecContext(ctx,
 		`INSERT INTO resources (resource_uid, name, state, cloud_account_id, cloud_provider, resource_type, 
-settings, region) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
-		res.UID, res.Name, res.Status, res.CloudAccount.ID, res.CloudAccount.Type, res.Type, res.Settings, res.Region)
+settings, region, vcpu, memory_gb, storage_gb, machine_class, high_availability) 
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		res.UID, res.Name, res.Status, res.CloudAccount.ID, res.CloudAccount.Type, res.Type, res.Settings, res.Region,
+		res.Spec.VCPU, res.Spec.MemoryGB, res.Spec.StorageGB, res.Spec.MachineClass, res.Spec.HighAvailability)
+	if err != nil {
+		return err
+	}
+
+	res.ID, err = result.LastInsertId()
 	if err != nil {
 		return err
 	}
@@ -31,7 +38,8 @@ func (*Store) GetResourceByID(ctx *gofr.Context, id int64) (*models.Resource, er
 	var res models.Resource
 
 	row := ctx.SQL.QueryRowContext(ctx, `SELECT id, resource_uid, name, state, cloud_account_id, 
-	   cloud_provider, resource_type, created_at, updated_at, settings, region
+	   cloud_provider, resource_type, created_at, updated_at, settings, region, 
+	   vcpu, memory_gb, storage_gb, machine_class, high_availability
 		FROM resources WHERE id = ?`, id)
 
 	if row.Err() != nil {
@@ -40,17 +48,19 @@ func (*Store) GetResourceByID(ctx *gofr.Context, id int64) (*models.Resource, er
 
 	if err := row.Scan(&res.ID, &res.UID, &res.Name, &res.Status,
 		&res.CloudAccount.ID, &res.CloudAccount.Type, &res.Type,
-		&res.CreatedAt, &res.UpdatedAt, &res.Settings, &res.Region); err != nil {
+		&res.CreatedAt, &res.UpdatedAt, &res.Settings, &res.Region, &res.Spec.VCPU, &res.Spec.MemoryGB,
+		&res.Spec.StorageGB, &res.Spec.MachineClass, &res.Spec.HighAvailability); err != nil {
 		return nil, err
 	}
 
 	return &res, nil
 }
 
-// GetResources fetches resources for a given cloud account ID.
+// GetResources fetches resources for a given cloud account ID, optionally filtered by resource types and label selectors.
 // IMP: The returned result is sorted by resource UID. This is to ensure that the resources are returned in a consistent order.
 // The service layer can use this to compare the resources fetched from the cloud provider with the resources stored in the database.
-func (*Store) GetResources(ctx *gofr.Context, cloudAccountID int64, resourceType []string) ([]models.Resource, error) {
+func (*Store) GetResources(ctx *gofr.Context, cloudAccountID int64, resourceType []string,
+	selectors ...models.LabelSelector) ([]models.Resource, error) {
 	var (
 		resources []models.Resource
 		args      = make([]any, 0, maxResTypes)
@@ -75,9 +85,12 @@ func (*Store) GetResources(ctx *gofr.Context, cloudAccountID int64, resourceType
 		inClause += `)`
 	}
 
+	labelFilter, args := labelClause(selectors, args)
+
 	rows, err := ctx.SQL.QueryContext(ctx, `SELECT id, resource_uid, name, state, cloud_account_id, 
-       cloud_provider, resource_type, created_at, updated_at, settings, region
-		FROM resources WHERE cloud_account_id = ?`+inClause+` ORDER BY resource_uid`, args...)
+       cloud_provider, resource_type, created_at, updated_at, settings, region, 
+       vcpu, memory_gb, storage_gb, machine_class, high_availability
+		FROM resources WHERE cloud_account_id = ?`+inClause+labelFilter+` ORDER BY resource_uid`, args...)
 	if err != nil || rows.Err() != nil {
 		return nil, err
 	}
@@ -88,16 +101,59 @@ func (*Store) GetResources(ctx *gofr.Context, cloudAccountID int64, resourceType
 		var res models.Resource
 		if er := rows.Scan(&res.ID, &res.UID, &res.Name, &res.Status,
 			&res.CloudAccount.ID, &res.CloudAccount.Type, &res.Type,
-			&res.CreatedAt, &res.UpdatedAt, &res.Settings, &res.Region); er != nil {
+			&res.CreatedAt, &res.UpdatedAt, &res.Settings, &res.Region, &res.Spec.VCPU, &res.Spec.MemoryGB,
+			&res.Spec.StorageGB, &res.Spec.MachineClass, &res.Spec.HighAvailability); er != nil {
 			return nil, er
 		}
 
 		resources = append(resources, res)
 	}
 
+	if len(resources) == 0 {
+		return resources, nil
+	}
+
+	labels, err := getLabels(ctx, cloudAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range resources {
+		resources[i].Labels = labels[resources[i].ID]
+	}
+
 	return resources, nil
 }
 
+// GetResourceGroupNames returns the names of the resource groups that the resources of a cloud account belong to,
+// keyed by the resource ID. Deleted resource groups are not included.
+func (*Store) GetResourceGroupNames(ctx *gofr.Context, cloudAccountID int64) (map[int64][]string, error) {
+	names := make(map[int64][]string)
+
+	rows, err := ctx.SQL.QueryContext(ctx, `SELECT m.resource_id, g.name FROM resource_group_memberships m 
+		JOIN resource_groups g ON g.id = m.group_id WHERE g.cloud_account_id = ? AND g.deleted_at IS NULL`, cloudAccountID)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id   int64
+			name string
+		)
+
+		if er := rows.Scan(&id, &name); er != nil {
+			return nil, er
+		}
+
+		names[id] = append(names[id], name)
+	}
+
+	return names, nil
+}
+
 // UpdateStatus updates the state of a resource in the database by its ID with the provided status.
 // It returns an error if the update operation fails.
 func (*Store) UpdateStatus(ctx *gofr.Context, status string, id int64) error {
@@ -110,12 +166,65 @@ func (*Store) UpdateStatus(ctx *gofr.Context, status string, id int64) error {
 	return nil
 }
 
-// RemoveResource deletes a resource by its ID from the database and returns an error if the operation fails.
-func (*Store) RemoveResource(ctx *gofr.Context, id int64) error {
-	_, err := ctx.SQL.ExecContext(ctx, `DELETE FROM resources WHERE id = ?`, id)
+// UpdateRegion updates the region of a resource in the database by its ID.
+func (*Store) UpdateRegion(ctx *gofr.Context, region string, id int64) error {
+	_, err := ctx.SQL.ExecContext(ctx, `UPDATE resources SET region = ? WHERE id = ?`,
+		region, id)
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// UpdateSpec updates the normalized spec of a resource in the database by its ID.
+func (*Store) UpdateSpec(ctx *gofr.Context, spec models.Spec, id int64) error {
+	_, err := ctx.SQL.ExecContext(ctx, `UPDATE resources SET vcpu = ?, memory_gb = ?, storage_gb = ?, machine_class = ?, 
+high_availability = ? WHERE id = ?`, spec.VCPU, spec.MemoryGB, spec.StorageGB, spec.MachineClass, spec.HighAvailability, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateSettings updates the provider specific settings of a resource in the database by its ID.
+func (*Store) UpdateSettings(ctx *gofr.Context, settings models.Settings, id int64) error {
+	_, err := ctx.SQL.ExecContext(ctx, `UPDATE resources SET settings = ? WHERE id = ?`, settings, id)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// resourceTables are the tables holding the rows of a resource, which are deleted along with the resource.
+var resourceTables = []string{`resource_labels`, `resource_group_memberships`, `resource_operations`,
+	`resource_savings`, `keep_awake_locks`}
+
+// RemoveResource deletes a resource and the rows that refer to it by its ID from the database in a transaction. The
+// events of the resource are kept as its history.
+func (*Store) RemoveResource(ctx *gofr.Context, id int64) error {
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return err
+	}
+
+	for _, table := range resourceTables {
+		_, err = tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE resource_id = ?`, id)
+		if err != nil {
+			_ = tx.Rollback()
+
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM resources WHERE id = ?`, id)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return err
+	}
+
+	return tx.Commit()
+}
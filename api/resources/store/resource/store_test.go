@@ -8,6 +8,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"gofr.dev/pkg/gofr"
 	"gofr.dev/pkg/gofr/container"
@@ -32,6 +33,8 @@ func TestStore_InsertResource(t *testing.T) {
 		},
 		Type:   "SQL",
 		Region: "us-central1",
+		Spec: models.Spec{VCPU: 2, MemoryGB: 7.5, StorageGB: 10, MachineClass: "db-custom-2-7680",
+			HighAvailability: true},
 	}
 	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
 	store := New()
@@ -48,9 +51,11 @@ func TestStore_InsertResource(t *testing.T) {
 			mockCalls: func() {
 				mocks.SQL.Sqlmock.ExpectExec(
 					`INSERT INTO resources (resource_uid, name, state, cloud_account_id, cloud_provider, resource_type, 
-settings, region) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`).
+settings, region, vcpu, memory_gb, storage_gb, machine_class, high_availability) 
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`).
 					WithArgs(mockInput.UID, mockInput.Name, mockInput.Status, mockInput.CloudAccount.ID,
-						mockInput.CloudAccount.Type, mockInput.Type, mockInput.Settings, mockInput.Region).
+						mockInput.CloudAccount.Type, mockInput.Type, mockInput.Settings, mockInput.Region,
+						2.0, 7.5, int64(10), "db-custom-2-7680", true).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 		},
@@ -61,9 +66,11 @@ settings, region) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`).
 			mockCalls: func() {
 				mocks.SQL.Sqlmock.ExpectExec(
 					`INSERT INTO resources (resource_uid, name, state, cloud_account_id, cloud_provider, resource_type,  
-settings, region) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`).
+settings, region, vcpu, memory_gb, storage_gb, machine_class, high_availability) 
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`).
 					WithArgs(mockInput.UID, mockInput.Name, mockInput.Status, mockInput.CloudAccount.ID,
-						mockInput.CloudAccount.Type, mockInput.Type, mockInput.Settings, mockInput.Region).
+						mockInput.CloudAccount.Type, mockInput.Type, mockInput.Settings, mockInput.Region,
+						2.0, 7.5, int64(10), "db-custom-2-7680", true).
 					WillReturnError(assert.AnError)
 			},
 		},
@@ -88,8 +95,11 @@ func TestStore_GetResources(t *testing.T) {
 	mockTime := time.Now()
 	settings := models.Settings{"region": "us-central1", "zone": "us-central1-a"}
 	query := `SELECT id, resource_uid, name, state, cloud_account_id, 
-       cloud_provider, resource_type, created_at, updated_at, settings, region
+       cloud_provider, resource_type, created_at, updated_at, settings, region, 
+       vcpu, memory_gb, storage_gb, machine_class, high_availability
 		FROM resources WHERE cloud_account_id = ? AND resource_type IN (?, ?) ORDER BY resource_uid`
+	labelQuery := `SELECT l.resource_id, l.label_key, l.label_value 
+		FROM resource_labels l JOIN resources r ON r.id = l.resource_id WHERE r.cloud_account_id = ?`
 	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
 	store := New()
 
@@ -108,16 +118,20 @@ func TestStore_GetResources(t *testing.T) {
 			mockCalls: func() {
 				mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(123, "SQL", "VM").
 					WillReturnRows(sqlmock.NewRows([]string{"id", "resource_uid", "name", "state",
-						"cloud_account_id", "cloud_provider", "resource_type", "created_at", "updated_at", "settings", "region"}).
+						"cloud_account_id", "cloud_provider", "resource_type", "created_at", "updated_at", "settings", "region",
+						"vcpu", "memory_gb", "storage_gb", "machine_class", "high_availability"}).
 						AddRow(1, "zopdev/sql-instance-1", "sql-instance-1", "RUNNING", 123, "GCP",
-							"SQL", mockTime, mockTime, &settings, "us-central1").
+							"SQL", mockTime, mockTime, &settings, "us-central1", 0, 0, 0, "", false).
 						AddRow(2, "zopdev/vm-instance-1", "vm-instance-1", "STOPPED", 123, "GCP",
-							"VM", mockTime, mockTime, &settings, "us-central1"))
+							"VM", mockTime, mockTime, &settings, "us-central1", 0, 0, 0, "", false))
+				mocks.SQL.Sqlmock.ExpectQuery(labelQuery).WithArgs(123).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_id", "label_key", "label_value"}).
+						AddRow(1, "team", "payments").AddRow(1, "env", "dev"))
 			},
 			expResp: []models.Resource{
 				{ID: 1, UID: "zopdev/sql-instance-1", CloudAccount: models.CloudAccount{ID: 123, Type: "GCP"},
 					Type: "SQL", Name: "sql-instance-1", Status: "RUNNING", CreatedAt: mockTime, UpdatedAt: mockTime,
-					Settings: settings, Region: "us-central1"},
+					Settings: settings, Region: "us-central1", Labels: models.Labels{"team": "payments", "env": "dev"}},
 				{ID: 2, UID: "zopdev/vm-instance-1", CloudAccount: models.CloudAccount{ID: 123, Type: "GCP"},
 					Type: "VM", Name: "vm-instance-1", Status: "STOPPED", CreatedAt: mockTime, UpdatedAt: mockTime,
 					Settings: settings, Region: "us-central1"},
@@ -134,12 +148,16 @@ func TestStore_GetResources(t *testing.T) {
 			},
 			mockCalls: func() {
 				mocks.SQL.Sqlmock.ExpectQuery(`SELECT id, resource_uid, name, state, cloud_account_id, 
-       cloud_provider, resource_type, created_at, updated_at, settings, region
+       cloud_provider, resource_type, created_at, updated_at, settings, region, 
+       vcpu, memory_gb, storage_gb, machine_class, high_availability
 		FROM resources WHERE cloud_account_id = ? AND resource_type IN (?) ORDER BY resource_uid`).WithArgs(123, "SQL").
 					WillReturnRows(sqlmock.NewRows([]string{"id", "resource_uid", "name", "state", "cloud_account_id",
-						"cloud_provider", "resource_type", "created_at", "updated_at", "settings", "region"}).
+						"cloud_provider", "resource_type", "created_at", "updated_at", "settings", "region",
+						"vcpu", "memory_gb", "storage_gb", "machine_class", "high_availability"}).
 						AddRow(1, "zopdev/sql-instance-1", "sql-instance-1", "RUNNING", 123, "GCP",
-							"SQL", mockTime, mockTime, &settings, "us-central1"))
+							"SQL", mockTime, mockTime, &settings, "us-central1", 0, 0, 0, "", false))
+				mocks.SQL.Sqlmock.ExpectQuery(labelQuery).WithArgs(123).
+					WillReturnRows(sqlmock.NewRows([]string{"resource_id", "label_key", "label_value"}))
 			},
 		},
 		{
@@ -166,6 +184,27 @@ func TestStore_GetResources(t *testing.T) {
 	}
 }
 
+func TestStore_GetResourceGroupNames(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	query := `SELECT m.resource_id, g.name FROM resource_group_memberships m 
+		JOIN resource_groups g ON g.id = m.group_id WHERE g.cloud_account_id = ? AND g.deleted_at IS NULL`
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(123).
+		WillReturnRows(sqlmock.NewRows([]string{"resource_id", "name"}).
+			AddRow(1, "dev").AddRow(1, "payments").AddRow(2, "dev"))
+
+	names, err := store.GetResourceGroupNames(ctx, 123)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64][]string{1: {"dev", "payments"}, 2: {"dev"}}, names)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(123).WillReturnError(assert.AnError)
+
+	_, err = store.GetResourceGroupNames(ctx, 123)
+	assert.Equal(t, assert.AnError, err)
+}
+
 func TestStore_UpdateResource(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -213,6 +252,66 @@ func TestStore_UpdateResource(t *testing.T) {
 	}
 }
 
+func TestStore_UpdateRegion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+
+	mocks.SQL.Sqlmock.ExpectExec(`UPDATE resources SET region = ? WHERE id = ?`).
+		WithArgs("eu-west-1", 1).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := store.UpdateRegion(ctx, "eu-west-1", 1)
+	require.NoError(t, err)
+
+	mocks.SQL.Sqlmock.ExpectExec(`UPDATE resources SET region = ? WHERE id = ?`).
+		WithArgs("eu-west-1", 2).WillReturnError(assert.AnError)
+
+	err = store.UpdateRegion(ctx, "eu-west-1", 2)
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestStore_UpdateSpec(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	spec := models.Spec{VCPU: 4, MemoryGB: 16, StorageGB: 100, MachineClass: "db.m5.xlarge", HighAvailability: true}
+	query := `UPDATE resources SET vcpu = ?, memory_gb = ?, storage_gb = ?, machine_class = ?, 
+high_availability = ? WHERE id = ?`
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(4.0, 16.0, int64(100), "db.m5.xlarge", true, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := store.UpdateSpec(ctx, spec, 1)
+	require.NoError(t, err)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(4.0, 16.0, int64(100), "db.m5.xlarge", true, 2).
+		WillReturnError(assert.AnError)
+
+	err = store.UpdateSpec(ctx, spec, 2)
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestStore_UpdateSettings(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	settings := models.Settings{"zone": "us-central1-a", "suspended_node_count": 3}
+	query := `UPDATE resources SET settings = ? WHERE id = ?`
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(settings, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := store.UpdateSettings(ctx, settings, 1)
+	require.NoError(t, err)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(settings, 2).WillReturnError(assert.AnError)
+
+	err = store.UpdateSettings(ctx, settings, 2)
+	assert.Equal(t, assert.AnError, err)
+}
+
 func TestStore_RemoveResource(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -231,8 +330,16 @@ func TestStore_RemoveResource(t *testing.T) {
 			name:       "Successful Removal",
 			resourceID: 1,
 			mockCalls: func() {
+				mocks.SQL.Sqlmock.ExpectBegin()
+
+				for _, table := range resourceTables {
+					mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM ` + table + ` WHERE resource_id = ?`).
+						WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+				}
+
 				mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM resources WHERE id = ?`).
 					WithArgs(1).WillReturnResult(sqlmock.NewResult(1, 1))
+				mocks.SQL.Sqlmock.ExpectCommit()
 			},
 		},
 		{
@@ -240,8 +347,27 @@ func TestStore_RemoveResource(t *testing.T) {
 			resourceID: 2,
 			expErr:     assert.AnError,
 			mockCalls: func() {
+				mocks.SQL.Sqlmock.ExpectBegin()
+
+				for _, table := range resourceTables {
+					mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM ` + table + ` WHERE resource_id = ?`).
+						WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+				}
+
 				mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM resources WHERE id = ?`).
 					WithArgs(2).WillReturnError(assert.AnError)
+				mocks.SQL.Sqlmock.ExpectRollback()
+			},
+		},
+		{
+			name:       "Dependent Rows Error",
+			resourceID: 3,
+			expErr:     assert.AnError,
+			mockCalls: func() {
+				mocks.SQL.Sqlmock.ExpectBegin()
+				mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM resource_labels WHERE resource_id = ?`).
+					WithArgs(3).WillReturnError(assert.AnError)
+				mocks.SQL.Sqlmock.ExpectRollback()
 			},
 		},
 	}
@@ -266,7 +392,8 @@ func TestStore_GetResourceByID(t *testing.T) {
 	settings := models.Settings{"region": "us-central1", "zone": "us-central1-a"}
 	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
 	query := `SELECT id, resource_uid, name, state, cloud_account_id,
-		cloud_provider, resource_type, created_at, updated_at, settings, region
+		cloud_provider, resource_type, created_at, updated_at, settings, region, 
+       vcpu, memory_gb, storage_gb, machine_class, high_availability
 	FROM resources WHERE id = ?`
 	mockResp := &models.Resource{
 		ID:     1,
@@ -299,11 +426,12 @@ func TestStore_GetResourceByID(t *testing.T) {
 			mockCalls: func() {
 				mocks.SQL.ExpectQuery(query).WithArgs(int64(1)).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "resource_uid", "name", "state", "cloud_account_id",
-						"cloud_provider", "resource_type", "created_at", "updated_at", "settings", "region"}).
+						"cloud_provider", "resource_type", "created_at", "updated_at", "settings", "region",
+						"vcpu", "memory_gb", "storage_gb", "machine_class", "high_availability"}).
 						AddRow(1, "zopdev/sql-instance-1", "sql-instance-1", "RUNNING", 123, "GCP",
-							"SQL", mockTime, mockTime, &settings, "us-central1").
+							"SQL", mockTime, mockTime, &settings, "us-central1", 0, 0, 0, "", false).
 						AddRow(2, "zopdev/vm-instance-1", "vm-instance-1", "STOPPED", 123, "GCP",
-							"VM", mockTime, mockTime, &settings, "us-central1"))
+							"VM", mockTime, mockTime, &settings, "us-central1", 0, 0, 0, "", false))
 			},
 		},
 		{
@@ -0,0 +1,59 @@
+package resource
+
+import (
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// InsertSyncRun records the start of a sync of the resources of a cloud account and sets the ID of the inserted run.
+func (*Store) InsertSyncRun(ctx *gofr.Context, run *models.SyncRun) error {
+	result, err := ctx.SQL.ExecContext(ctx, `INSERT INTO sync_runs (cloud_account_id, started_at) VALUES (?, ?)`,
+		run.CloudAccountID, run.StartedAt)
+	if err != nil {
+		return err
+	}
+
+	run.ID, err = result.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CompleteSyncRun records the end of a sync with its counts, statuses and errors.
+func (*Store) CompleteSyncRun(ctx *gofr.Context, run *models.SyncRun) error {
+	_, err := ctx.SQL.ExecContext(ctx, `UPDATE sync_runs SET finished_at = ?, counts = ?, statuses = ?, errors = ? 
+WHERE id = ?`, run.FinishedAt, run.Counts, run.Statuses, run.Errors, run.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSyncRuns fetches the most recent sync runs of a cloud account, the most recent run first.
+func (*Store) GetSyncRuns(ctx *gofr.Context, cloudAccountID int64, limit int) ([]models.SyncRun, error) {
+	runs := make([]models.SyncRun, 0)
+
+	rows, err := ctx.SQL.QueryContext(ctx, `SELECT id, cloud_account_id, started_at, finished_at, counts, statuses, 
+       errors FROM sync_runs WHERE cloud_account_id = ? ORDER BY id DESC LIMIT ?`, cloudAccountID, limit)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var run models.SyncRun
+		if er := rows.Scan(&run.ID, &run.CloudAccountID, &run.StartedAt, &run.FinishedAt, &run.Counts,
+			&run.Statuses, &run.Errors); er != nil {
+			return nil, er
+		}
+
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,90 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gofr.dev/pkg/gofr"
+	"gofr.dev/pkg/gofr/container"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+func TestStore_InsertSyncRun(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	startedAt := time.Now()
+	query := `INSERT INTO sync_runs (cloud_account_id, started_at) VALUES (?, ?)`
+	run := &models.SyncRun{CloudAccountID: 2, StartedAt: startedAt}
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(int64(2), startedAt).WillReturnResult(sqlmock.NewResult(5, 1))
+
+	err := store.InsertSyncRun(ctx, run)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), run.ID)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(int64(2), startedAt).WillReturnError(assert.AnError)
+
+	err = store.InsertSyncRun(ctx, run)
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestStore_CompleteSyncRun(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	finishedAt := time.Now()
+	query := `UPDATE sync_runs SET finished_at = ?, counts = ?, statuses = ?, errors = ? 
+WHERE id = ?`
+	run := &models.SyncRun{ID: 5, FinishedAt: &finishedAt, Counts: models.SyncCounts{"SQL": {Added: 1}},
+		Statuses: models.SyncStatuses{"SQL": {Status: "SUCCEEDED"}, "GCE": {Status: "FAILED", Error: "mock error"}}}
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(&finishedAt, run.Counts, run.Statuses, run.Errors, int64(5)).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+
+	err := store.CompleteSyncRun(ctx, run)
+	require.NoError(t, err)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(&finishedAt, run.Counts, run.Statuses, run.Errors, int64(5)).
+		WillReturnError(assert.AnError)
+
+	err = store.CompleteSyncRun(ctx, run)
+	assert.Equal(t, assert.AnError, err)
+}
+
+func TestStore_GetSyncRuns(t *testing.T) {
+	mockContainer, mocks := container.NewMockContainer(t)
+	ctx := &gofr.Context{Container: mockContainer, Context: context.Background()}
+	store := New()
+	mockTime := time.Now()
+	columns := []string{"id", "cloud_account_id", "started_at", "finished_at", "counts", "statuses", "errors"}
+	query := `SELECT id, cloud_account_id, started_at, finished_at, counts, statuses, 
+       errors FROM sync_runs WHERE cloud_account_id = ? ORDER BY id DESC LIMIT ?`
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(int64(2), 10).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(6, 2, mockTime, nil, []byte(`{}`), []byte(`{}`), []byte(`[]`)).
+			AddRow(5, 2, mockTime, mockTime, []byte(`{"SQL":{"added":1,"updated":2,"removed":0}}`),
+				[]byte(`{"GCE":{"status":"FAILED","error":"mock error"}}`), []byte(`["mock error"]`)))
+
+	runs, err := store.GetSyncRuns(ctx, 2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []models.SyncRun{
+		{ID: 6, CloudAccountID: 2, StartedAt: mockTime, Counts: models.SyncCounts{}, Statuses: models.SyncStatuses{},
+			Errors: models.SyncErrors{}},
+		{ID: 5, CloudAccountID: 2, StartedAt: mockTime, FinishedAt: &mockTime,
+			Counts:   models.SyncCounts{"SQL": {Added: 1, Updated: 2}},
+			Statuses: models.SyncStatuses{"GCE": {Status: "FAILED", Error: "mock error"}},
+			Errors:   models.SyncErrors{"mock error"}},
+	}, runs)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(int64(2), 10).WillReturnError(assert.AnError)
+
+	_, err = store.GetSyncRuns(ctx, 2, 10)
+	assert.Equal(t, assert.AnError, err)
+}
@@ -0,0 +1,242 @@
+package resourcegroup
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+	gofrSQL "gofr.dev/pkg/gofr/datasource/sql"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// groupCalendarQuery selects the calendars attached to resource groups, and whether a period of each calendar is in
+// progress at a time.
+const groupCalendarQuery = `SELECT gc.group_id, g.cloud_account_id, gc.calendar_id, c.name, gc.kind,
+		EXISTS (SELECT 1 FROM calendar_periods p WHERE p.calendar_id = gc.calendar_id AND p.start_at <= ?
+		AND p.end_at > ?), gc.last_state FROM resource_group_calendars gc
+		JOIN resource_groups g ON g.id = gc.group_id JOIN calendars c ON c.id = gc.calendar_id
+		WHERE g.deleted_at IS NULL`
+
+// CreateCalendar inserts a calendar with its periods and sets its ID. The calendar is inserted in a transaction so
+// that it is not created without its periods.
+func (*Store) CreateCalendar(ctx *gofr.Context, cal *models.Calendar) error {
+	return inTx(ctx, func(tx *gofrSQL.Tx) error {
+		res, err := tx.ExecContext(ctx, `INSERT INTO calendars (cloud_account_id, name, timezone) VALUES (?, ?, ?)`,
+			cal.CloudAccountID, cal.Name, cal.Timezone)
+		if err != nil {
+			return err
+		}
+
+		cal.ID, err = res.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		return insertPeriods(ctx, tx, cal)
+	})
+}
+
+// GetCalendars retrieves the calendars of a cloud account without their periods.
+func (*Store) GetCalendars(ctx *gofr.Context, cloudAccountID int64) ([]models.Calendar, error) {
+	rows, err := ctx.SQL.QueryContext(ctx, `SELECT id, cloud_account_id, name, timezone, created_at, updated_at
+		FROM calendars WHERE cloud_account_id = ? ORDER BY name, id`, cloudAccountID)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	calendars := make([]models.Calendar, 0)
+
+	for rows.Next() {
+		var cal models.Calendar
+
+		if er := rows.Scan(&cal.ID, &cal.CloudAccountID, &cal.Name, &cal.Timezone, &cal.CreatedAt,
+			&cal.UpdatedAt); er != nil {
+			return nil, er
+		}
+
+		calendars = append(calendars, cal)
+	}
+
+	return calendars, nil
+}
+
+// GetCalendarByID retrieves a calendar of a cloud account with its periods, it returns nil when the calendar does
+// not exist in the cloud account.
+func (*Store) GetCalendarByID(ctx *gofr.Context, cloudAccountID, id int64) (*models.Calendar, error) {
+	row := ctx.SQL.QueryRowContext(ctx, `SELECT id, cloud_account_id, name, timezone, created_at, updated_at
+		FROM calendars WHERE id = ? AND cloud_account_id = ?`, id, cloudAccountID)
+
+	var cal models.Calendar
+
+	err := row.Scan(&cal.ID, &cal.CloudAccountID, &cal.Name, &cal.Timezone, &cal.CreatedAt, &cal.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	rows, err := ctx.SQL.QueryContext(ctx,
+		`SELECT name, start_at, end_at FROM calendar_periods WHERE calendar_id = ? ORDER BY start_at, id`, id)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	cal.Periods = make([]models.CalendarPeriod, 0)
+
+	for rows.Next() {
+		var p models.CalendarPeriod
+
+		if er := rows.Scan(&p.Name, &p.Start, &p.End); er != nil {
+			return nil, er
+		}
+
+		cal.Periods = append(cal.Periods, p)
+	}
+
+	return &cal, nil
+}
+
+// UpdateCalendar updates the name and the time zone of a calendar and replaces its periods. The calendar is updated
+// in a transaction so that a failed insert keeps the previous periods.
+func (*Store) UpdateCalendar(ctx *gofr.Context, cal *models.Calendar) error {
+	return inTx(ctx, func(tx *gofrSQL.Tx) error {
+		_, err := tx.ExecContext(ctx, `UPDATE calendars SET name = ?, timezone = ?, updated_at = ? WHERE id = ?`,
+			cal.Name, cal.Timezone, time.Now().UTC(), cal.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM calendar_periods WHERE calendar_id = ?`, cal.ID)
+		if err != nil {
+			return err
+		}
+
+		return insertPeriods(ctx, tx, cal)
+	})
+}
+
+// DeleteCalendar deletes a calendar with its periods and detaches it from the resource groups, in a transaction so
+// that a calendar is not left without its periods.
+func (*Store) DeleteCalendar(ctx *gofr.Context, id int64) error {
+	return inTx(ctx, func(tx *gofrSQL.Tx) error {
+		for _, query := range []string{
+			`DELETE FROM resource_group_calendars WHERE calendar_id = ?`,
+			`DELETE FROM calendar_periods WHERE calendar_id = ?`,
+			`DELETE FROM calendars WHERE id = ?`,
+		} {
+			if _, err := tx.ExecContext(ctx, query, id); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetGroupCalendars retrieves the calendars attached to a resource group, and whether a period of each calendar is
+// in progress at a time.
+func (*Store) GetGroupCalendars(ctx *gofr.Context, groupID int64, at time.Time) ([]models.GroupCalendar, error) {
+	return getGroupCalendars(ctx, groupCalendarQuery+` AND gc.group_id = ? ORDER BY gc.calendar_id`, at, at, groupID)
+}
+
+// GetOffCalendars retrieves the off calendars attached to the resource groups that are not deleted, and whether a
+// period of each calendar is in progress at a time. The calendars of a group are consecutive.
+func (*Store) GetOffCalendars(ctx *gofr.Context, at time.Time) ([]models.GroupCalendar, error) {
+	return getGroupCalendars(ctx, groupCalendarQuery+` AND gc.kind = ? ORDER BY gc.group_id, gc.calendar_id`,
+		at, at, models.CalendarOff)
+}
+
+// AttachCalendar attaches a calendar to a resource group, or changes the kind of an attached calendar.
+func (*Store) AttachCalendar(ctx *gofr.Context, gc *models.GroupCalendar) error {
+	_, err := ctx.SQL.ExecContext(ctx, `INSERT INTO resource_group_calendars (group_id, calendar_id, kind)
+		VALUES (?, ?, ?) ON CONFLICT (group_id, calendar_id) DO UPDATE SET kind = excluded.kind`,
+		gc.GroupID, gc.CalendarID, gc.Kind)
+
+	return err
+}
+
+// DetachCalendar detaches a calendar from a resource group. It reports whether the calendar was attached.
+func (*Store) DetachCalendar(ctx *gofr.Context, groupID, calendarID int64) (bool, error) {
+	res, err := ctx.SQL.ExecContext(ctx,
+		`DELETE FROM resource_group_calendars WHERE group_id = ? AND calendar_id = ?`, groupID, calendarID)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// UpdateCalendarState records the state last applied to the members of a resource group by its off calendars.
+func (*Store) UpdateCalendarState(ctx *gofr.Context, groupID int64, state string) error {
+	_, err := ctx.SQL.ExecContext(ctx,
+		`UPDATE resource_group_calendars SET last_state = ? WHERE group_id = ? AND kind = ?`,
+		state, groupID, models.CalendarOff)
+
+	return err
+}
+
+// inTx runs fn in a transaction, the transaction is rolled back when fn fails.
+func inTx(ctx *gofr.Context, fn func(tx *gofrSQL.Tx) error) error {
+	tx, err := ctx.SQL.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err = fn(tx); err != nil {
+		_ = tx.Rollback()
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertPeriods(ctx *gofr.Context, tx *gofrSQL.Tx, cal *models.Calendar) error {
+	for i := range cal.Periods {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO calendar_periods (calendar_id, name, start_at, end_at) VALUES (?, ?, ?, ?)`,
+			cal.ID, cal.Periods[i].Name, cal.Periods[i].Start, cal.Periods[i].End)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getGroupCalendars(ctx *gofr.Context, query string, args ...any) ([]models.GroupCalendar, error) {
+	rows, err := ctx.SQL.QueryContext(ctx, query, args...)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	calendars := make([]models.GroupCalendar, 0)
+
+	for rows.Next() {
+		var gc models.GroupCalendar
+
+		if er := rows.Scan(&gc.GroupID, &gc.CloudAccountID, &gc.CalendarID, &gc.CalendarName, &gc.Kind, &gc.Active,
+			&gc.LastState); er != nil {
+			return nil, er
+		}
+
+		calendars = append(calendars, gc)
+	}
+
+	return calendars, nil
+}
@@ -0,0 +1,263 @@
+package resourcegroup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+const insertPeriodQuery = `INSERT INTO calendar_periods (calendar_id, name, start_at, end_at) VALUES (?, ?, ?, ?)`
+
+var (
+	calendarColumns      = []string{"id", "cloud_account_id", "name", "timezone", "created_at", "updated_at"}
+	groupCalendarColumns = []string{"group_id", "cloud_account_id", "calendar_id", "name", "kind", "active",
+		"last_state"}
+)
+
+func TestStore_CreateCalendar(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	start := time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)
+	cal := &models.Calendar{CloudAccountID: 3, Name: "Holidays", Timezone: "UTC",
+		Periods: []models.CalendarPeriod{{Name: "Christmas", Start: start, End: start.AddDate(0, 0, 1)}}}
+
+	mocks.SQL.Sqlmock.ExpectBegin()
+	mocks.SQL.Sqlmock.ExpectExec(`INSERT INTO calendars (cloud_account_id, name, timezone) VALUES (?, ?, ?)`).
+		WithArgs(3, "Holidays", "UTC").
+		WillReturnResult(sqlmock.NewResult(4, 1))
+	mocks.SQL.Sqlmock.ExpectExec(insertPeriodQuery).
+		WithArgs(4, "Christmas", start, start.AddDate(0, 0, 1)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mocks.SQL.Sqlmock.ExpectCommit()
+
+	require.NoError(t, store.CreateCalendar(ctx, cal))
+	assert.Equal(t, int64(4), cal.ID)
+
+	// The calendar is not created without its periods.
+	mocks.SQL.Sqlmock.ExpectBegin()
+	mocks.SQL.Sqlmock.ExpectExec(`INSERT INTO calendars (cloud_account_id, name, timezone) VALUES (?, ?, ?)`).
+		WithArgs(3, "Holidays", "UTC").
+		WillReturnResult(sqlmock.NewResult(4, 1))
+	mocks.SQL.Sqlmock.ExpectExec(insertPeriodQuery).
+		WithArgs(4, "Christmas", start, start.AddDate(0, 0, 1)).
+		WillReturnError(assert.AnError)
+	mocks.SQL.Sqlmock.ExpectRollback()
+
+	require.ErrorIs(t, store.CreateCalendar(ctx, cal), assert.AnError)
+
+	mocks.SQL.Sqlmock.ExpectBegin().WillReturnError(assert.AnError)
+
+	require.ErrorIs(t, store.CreateCalendar(ctx, cal), assert.AnError)
+}
+
+func TestStore_GetCalendars(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	query := `SELECT id, cloud_account_id, name, timezone, created_at, updated_at
+		FROM calendars WHERE cloud_account_id = ? ORDER BY name, id`
+	mockTime := time.Now()
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(3).
+		WillReturnRows(sqlmock.NewRows(calendarColumns).AddRow(4, 3, "Holidays", "UTC", mockTime, mockTime))
+
+	calendars, err := store.GetCalendars(ctx, 3)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Calendar{{ID: 4, CloudAccountID: 3, Name: "Holidays", Timezone: "UTC",
+		CreatedAt: mockTime, UpdatedAt: mockTime}}, calendars)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(3).WillReturnError(assert.AnError)
+
+	calendars, err = store.GetCalendars(ctx, 3)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, calendars)
+}
+
+func TestStore_GetCalendarByID(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	query := `SELECT id, cloud_account_id, name, timezone, created_at, updated_at
+		FROM calendars WHERE id = ? AND cloud_account_id = ?`
+	periodQuery := `SELECT name, start_at, end_at FROM calendar_periods WHERE calendar_id = ? ORDER BY start_at, id`
+	mockTime := time.Now()
+	start := time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(4, 3).
+		WillReturnRows(sqlmock.NewRows(calendarColumns).AddRow(4, 3, "Holidays", "UTC", mockTime, mockTime))
+	mocks.SQL.Sqlmock.ExpectQuery(periodQuery).WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "start_at", "end_at"}).
+			AddRow("Christmas", start, start.AddDate(0, 0, 1)))
+
+	cal, err := store.GetCalendarByID(ctx, 3, 4)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.Calendar{ID: 4, CloudAccountID: 3, Name: "Holidays", Timezone: "UTC", CreatedAt: mockTime,
+		UpdatedAt: mockTime, Periods: []models.CalendarPeriod{{Name: "Christmas", Start: start,
+			End: start.AddDate(0, 0, 1)}}}, cal)
+
+	// The calendar belongs to another cloud account.
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(4, 5).WillReturnRows(sqlmock.NewRows(calendarColumns))
+
+	cal, err = store.GetCalendarByID(ctx, 5, 4)
+
+	require.NoError(t, err)
+	assert.Nil(t, cal)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(4, 3).
+		WillReturnRows(sqlmock.NewRows(calendarColumns).AddRow(4, 3, "Holidays", "UTC", mockTime, mockTime))
+	mocks.SQL.Sqlmock.ExpectQuery(periodQuery).WithArgs(4).WillReturnError(assert.AnError)
+
+	cal, err = store.GetCalendarByID(ctx, 3, 4)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, cal)
+}
+
+func TestStore_UpdateCalendar(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cal := &models.Calendar{ID: 4, Name: "Holidays 2026", Timezone: "UTC",
+		Periods: []models.CalendarPeriod{{Name: "New Year", Start: start, End: start.AddDate(0, 0, 1)}}}
+
+	mocks.SQL.Sqlmock.ExpectBegin()
+	mocks.SQL.Sqlmock.ExpectExec(`UPDATE calendars SET name = ?, timezone = ?, updated_at = ? WHERE id = ?`).
+		WithArgs("Holidays 2026", "UTC", sqlmock.AnyArg(), 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM calendar_periods WHERE calendar_id = ?`).
+		WithArgs(4).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mocks.SQL.Sqlmock.ExpectExec(insertPeriodQuery).
+		WithArgs(4, "New Year", start, start.AddDate(0, 0, 1)).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+	mocks.SQL.Sqlmock.ExpectCommit()
+
+	require.NoError(t, store.UpdateCalendar(ctx, cal))
+
+	// A failed insert keeps the previous periods.
+	mocks.SQL.Sqlmock.ExpectBegin()
+	mocks.SQL.Sqlmock.ExpectExec(`UPDATE calendars SET name = ?, timezone = ?, updated_at = ? WHERE id = ?`).
+		WithArgs("Holidays 2026", "UTC", sqlmock.AnyArg(), 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM calendar_periods WHERE calendar_id = ?`).
+		WithArgs(4).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mocks.SQL.Sqlmock.ExpectExec(insertPeriodQuery).
+		WithArgs(4, "New Year", start, start.AddDate(0, 0, 1)).
+		WillReturnError(assert.AnError)
+	mocks.SQL.Sqlmock.ExpectRollback()
+
+	require.ErrorIs(t, store.UpdateCalendar(ctx, cal), assert.AnError)
+}
+
+func TestStore_DeleteCalendar(t *testing.T) {
+	ctx, mocks, store := setup(t)
+
+	mocks.SQL.Sqlmock.ExpectBegin()
+	mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM resource_group_calendars WHERE calendar_id = ?`).
+		WithArgs(4).WillReturnResult(sqlmock.NewResult(0, 1))
+	mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM calendar_periods WHERE calendar_id = ?`).
+		WithArgs(4).WillReturnResult(sqlmock.NewResult(0, 2))
+	mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM calendars WHERE id = ?`).
+		WithArgs(4).WillReturnResult(sqlmock.NewResult(0, 1))
+	mocks.SQL.Sqlmock.ExpectCommit()
+
+	require.NoError(t, store.DeleteCalendar(ctx, 4))
+
+	// The calendar stays attached with its periods when it cannot be deleted.
+	mocks.SQL.Sqlmock.ExpectBegin()
+	mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM resource_group_calendars WHERE calendar_id = ?`).
+		WithArgs(4).WillReturnResult(sqlmock.NewResult(0, 1))
+	mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM calendar_periods WHERE calendar_id = ?`).
+		WithArgs(4).WillReturnError(assert.AnError)
+	mocks.SQL.Sqlmock.ExpectRollback()
+
+	require.ErrorIs(t, store.DeleteCalendar(ctx, 4), assert.AnError)
+}
+
+func TestStore_GetGroupCalendars(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	now := time.Now()
+
+	mocks.SQL.Sqlmock.ExpectQuery(groupCalendarQuery+` AND gc.group_id = ? ORDER BY gc.calendar_id`).
+		WithArgs(now, now, 2).
+		WillReturnRows(sqlmock.NewRows(groupCalendarColumns).
+			AddRow(2, 1, 4, "Holidays", "OFF", true, "SUSPEND").
+			AddRow(2, 1, 5, "Quarter-end", "BLACKOUT", false, ""))
+
+	calendars, err := store.GetGroupCalendars(ctx, 2, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.GroupCalendar{
+		{GroupID: 2, CloudAccountID: 1, CalendarID: 4, CalendarName: "Holidays", Kind: models.CalendarOff,
+			Active: true, LastState: "SUSPEND"},
+		{GroupID: 2, CloudAccountID: 1, CalendarID: 5, CalendarName: "Quarter-end", Kind: models.CalendarBlackout},
+	}, calendars)
+}
+
+func TestStore_GetOffCalendars(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	now := time.Now()
+	query := groupCalendarQuery + ` AND gc.kind = ? ORDER BY gc.group_id, gc.calendar_id`
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).
+		WithArgs(now, now, "OFF").
+		WillReturnRows(sqlmock.NewRows(groupCalendarColumns).AddRow(2, 1, 4, "Holidays", "OFF", false, ""))
+
+	calendars, err := store.GetOffCalendars(ctx, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.GroupCalendar{{GroupID: 2, CloudAccountID: 1, CalendarID: 4, CalendarName: "Holidays",
+		Kind: models.CalendarOff}}, calendars)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(now, now, "OFF").WillReturnError(assert.AnError)
+
+	calendars, err = store.GetOffCalendars(ctx, now)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, calendars)
+}
+
+func TestStore_AttachCalendar(t *testing.T) {
+	ctx, mocks, store := setup(t)
+
+	mocks.SQL.Sqlmock.ExpectExec(`INSERT INTO resource_group_calendars (group_id, calendar_id, kind)
+		VALUES (?, ?, ?) ON CONFLICT (group_id, calendar_id) DO UPDATE SET kind = excluded.kind`).
+		WithArgs(2, 4, "BLACKOUT").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, store.AttachCalendar(ctx, &models.GroupCalendar{GroupID: 2, CalendarID: 4,
+		Kind: models.CalendarBlackout}))
+}
+
+func TestStore_DetachCalendar(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	query := `DELETE FROM resource_group_calendars WHERE group_id = ? AND calendar_id = ?`
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(2, 4).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	detached, err := store.DetachCalendar(ctx, 2, 4)
+
+	require.NoError(t, err)
+	assert.True(t, detached)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(2, 5).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	detached, err = store.DetachCalendar(ctx, 2, 5)
+
+	require.NoError(t, err)
+	assert.False(t, detached)
+}
+
+func TestStore_UpdateCalendarState(t *testing.T) {
+	ctx, mocks, store := setup(t)
+
+	mocks.SQL.Sqlmock.ExpectExec(
+		`UPDATE resource_group_calendars SET last_state = ? WHERE group_id = ? AND kind = ?`).
+		WithArgs("SUSPEND", 2, "OFF").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, store.UpdateCalendarState(ctx, 2, "SUSPEND"))
+}
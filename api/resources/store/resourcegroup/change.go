@@ -0,0 +1,103 @@
+package resourcegroup
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// changeColumns are the columns of a resource group change in the order they are scanned by scanChange.
+const changeColumns = `id, group_id, cloud_account_id, state, requested_by, tiers, tier, tier_timeout, tier_deadline,
+		members, status, attempt, created_at, updated_at, completed_at`
+
+// InsertGroupChange records a state change of a resource group and sets its ID.
+func (*Store) InsertGroupChange(ctx *gofr.Context, ch *models.RGChange) error {
+	res, err := ctx.SQL.ExecContext(ctx, `INSERT INTO resource_group_changes (group_id, cloud_account_id, state,
+		requested_by, tiers, tier, tier_timeout, tier_deadline, members, status, attempt, completed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, ch.GroupID, ch.CloudAccountID, ch.State, ch.RequestedBy,
+		ch.Tiers, ch.Tier, int64(ch.TierTimeout/time.Second), ch.TierDeadline, ch.Members, ch.Status, ch.Attempt,
+		ch.CompletedAt)
+	if err != nil {
+		return err
+	}
+
+	ch.ID, err = res.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateGroupChange records the progress of a state change of a resource group.
+func (*Store) UpdateGroupChange(ctx *gofr.Context, ch *models.RGChange) error {
+	_, err := ctx.SQL.ExecContext(ctx, `UPDATE resource_group_changes SET tier = ?, tier_deadline = ?, members = ?,
+		status = ?, completed_at = ?, updated_at = ? WHERE id = ?`, ch.Tier, ch.TierDeadline, ch.Members, ch.Status,
+		ch.CompletedAt, time.Now().UTC(), ch.ID)
+
+	return err
+}
+
+// GetGroupChange retrieves the last state change of a resource group, it returns nil when the group was never
+// changed.
+func (*Store) GetGroupChange(ctx *gofr.Context, groupID int64) (*models.RGChange, error) {
+	row := ctx.SQL.QueryRowContext(ctx, `SELECT `+changeColumns+` FROM resource_group_changes WHERE group_id = ?
+		ORDER BY id DESC LIMIT 1`, groupID)
+
+	ch, err := scanChange(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return ch, nil
+}
+
+// GetGroupChangesByStatus retrieves the state changes of all the resource groups that are in the given status.
+func (*Store) GetGroupChangesByStatus(ctx *gofr.Context, status string) ([]models.RGChange, error) {
+	rows, err := ctx.SQL.QueryContext(ctx, `SELECT `+changeColumns+` FROM resource_group_changes WHERE status = ?
+		ORDER BY id`, status)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	changes := make([]models.RGChange, 0)
+
+	for rows.Next() {
+		ch, er := scanChange(rows)
+		if er != nil {
+			return nil, er
+		}
+
+		changes = append(changes, *ch)
+	}
+
+	return changes, nil
+}
+
+func scanChange(row interface{ Scan(dest ...any) error }) (*models.RGChange, error) {
+	var (
+		ch      models.RGChange
+		timeout int64
+	)
+
+	err := row.Scan(&ch.ID, &ch.GroupID, &ch.CloudAccountID, &ch.State, &ch.RequestedBy, &ch.Tiers, &ch.Tier,
+		&timeout, &ch.TierDeadline, &ch.Members, &ch.Status, &ch.Attempt, &ch.CreatedAt, &ch.UpdatedAt,
+		&ch.CompletedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	ch.TierTimeout = time.Duration(timeout) * time.Second
+
+	return &ch, nil
+}
@@ -0,0 +1,114 @@
+package resourcegroup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+var changeRowColumns = []string{"id", "group_id", "cloud_account_id", "state", "requested_by", "tiers", "tier",
+	"tier_timeout", "tier_deadline", "members", "status", "attempt", "created_at", "updated_at", "completed_at"}
+
+func TestStore_InsertGroupChange(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	query := `INSERT INTO resource_group_changes (group_id, cloud_account_id, state,
+		requested_by, tiers, tier, tier_timeout, tier_deadline, members, status, attempt, completed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	deadline := time.Now().UTC()
+	ch := &models.RGChange{GroupID: 2, CloudAccountID: 1, State: "START", RequestedBy: "alice",
+		Tiers: models.GroupTiers{{10}, {11}}, TierTimeout: 5 * time.Minute, TierDeadline: &deadline,
+		Status: "IN_PROGRESS", Attempt: 2, Members: models.MemberOutcomes{{ResourceID: 10, Succeeded: true},
+			{ResourceID: 11, Pending: true}}}
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(2, 1, "START", "alice", ch.Tiers, 0, 300, ch.TierDeadline,
+		ch.Members, "IN_PROGRESS", 2, ch.CompletedAt).WillReturnResult(sqlmock.NewResult(7, 1))
+
+	require.NoError(t, store.InsertGroupChange(ctx, ch))
+	assert.Equal(t, int64(7), ch.ID)
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WillReturnError(assert.AnError)
+
+	require.ErrorIs(t, store.InsertGroupChange(ctx, ch), assert.AnError)
+}
+
+func TestStore_UpdateGroupChange(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	query := `UPDATE resource_group_changes SET tier = ?, tier_deadline = ?, members = ?,
+		status = ?, completed_at = ?, updated_at = ? WHERE id = ?`
+	completed := time.Now().UTC()
+	ch := &models.RGChange{ID: 7, Tier: 1, Members: models.MemberOutcomes{{ResourceID: 10, Succeeded: true}},
+		Status: "SUCCEEDED", CompletedAt: &completed}
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs(1, ch.TierDeadline, ch.Members, "SUCCEEDED", ch.CompletedAt,
+		sqlmock.AnyArg(), 7).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, store.UpdateGroupChange(ctx, ch))
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WillReturnError(assert.AnError)
+
+	require.ErrorIs(t, store.UpdateGroupChange(ctx, ch), assert.AnError)
+}
+
+func TestStore_GetGroupChange(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	query := `SELECT ` + changeColumns + ` FROM resource_group_changes WHERE group_id = ?
+		ORDER BY id DESC LIMIT 1`
+	mockTime := time.Now()
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(2).WillReturnRows(sqlmock.NewRows(changeRowColumns).
+		AddRow(7, 2, 1, "SUSPEND", "alice", `[[11],[10]]`, 0, 600, mockTime,
+			`[{"resource_id":11,"succeeded":true},{"resource_id":10,"succeeded":false,"pending":true}]`,
+			"IN_PROGRESS", 3, mockTime, mockTime, nil))
+
+	ch, err := store.GetGroupChange(ctx, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.RGChange{ID: 7, GroupID: 2, CloudAccountID: 1, State: "SUSPEND", RequestedBy: "alice",
+		Tiers: models.GroupTiers{{11}, {10}}, TierTimeout: 10 * time.Minute, TierDeadline: &mockTime,
+		Status: "IN_PROGRESS", Attempt: 3, CreatedAt: mockTime, UpdatedAt: mockTime,
+		Members: models.MemberOutcomes{{ResourceID: 11, Succeeded: true}, {ResourceID: 10, Pending: true}}}, ch)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(3).WillReturnRows(sqlmock.NewRows(changeRowColumns))
+
+	ch, err = store.GetGroupChange(ctx, 3)
+
+	require.NoError(t, err)
+	assert.Nil(t, ch)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(4).WillReturnError(assert.AnError)
+
+	ch, err = store.GetGroupChange(ctx, 4)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, ch)
+}
+
+func TestStore_GetGroupChangesByStatus(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	query := `SELECT ` + changeColumns + ` FROM resource_group_changes WHERE status = ?
+		ORDER BY id`
+	mockTime := time.Now()
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs("IN_PROGRESS").WillReturnRows(sqlmock.NewRows(changeRowColumns).
+		AddRow(7, 2, 1, "START", "", `[[10],[11]]`, 0, 60, mockTime, `[]`, "IN_PROGRESS", 1, mockTime, mockTime, nil))
+
+	changes, err := store.GetGroupChangesByStatus(ctx, "IN_PROGRESS")
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.RGChange{{ID: 7, GroupID: 2, CloudAccountID: 1, State: "START",
+		Tiers: models.GroupTiers{{10}, {11}}, TierTimeout: time.Minute, TierDeadline: &mockTime,
+		Members: models.MemberOutcomes{}, Status: "IN_PROGRESS", Attempt: 1, CreatedAt: mockTime,
+		UpdatedAt: mockTime}}, changes)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs("IN_PROGRESS").WillReturnError(assert.AnError)
+
+	changes, err = store.GetGroupChangesByStatus(ctx, "IN_PROGRESS")
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, changes)
+}
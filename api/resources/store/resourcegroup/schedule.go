@@ -0,0 +1,85 @@
+package resourcegroup
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"gofr.dev/pkg/gofr"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+// GetSchedule retrieves the schedule of a resource group, it returns nil when the group has no schedule.
+func (*Store) GetSchedule(ctx *gofr.Context, groupID int64) (*models.Schedule, error) {
+	row := ctx.SQL.QueryRowContext(ctx, `SELECT group_id, timezone, windows, last_state, last_error, created_at,
+		updated_at FROM resource_group_schedules WHERE group_id = ?`, groupID)
+
+	var sch models.Schedule
+
+	err := row.Scan(&sch.GroupID, &sch.Timezone, &sch.Windows, &sch.LastState, &sch.LastError, &sch.CreatedAt,
+		&sch.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &sch, nil
+}
+
+// GetAllSchedules retrieves the schedules of all the resource groups that are not deleted.
+func (*Store) GetAllSchedules(ctx *gofr.Context) ([]models.Schedule, error) {
+	rows, err := ctx.SQL.QueryContext(ctx, `SELECT s.group_id, g.cloud_account_id, s.timezone, s.windows,
+		s.last_state, s.last_error, s.created_at, s.updated_at FROM resource_group_schedules s
+		JOIN resource_groups g ON g.id = s.group_id WHERE g.deleted_at IS NULL ORDER BY s.group_id`)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	schedules := make([]models.Schedule, 0)
+
+	for rows.Next() {
+		var sch models.Schedule
+
+		if er := rows.Scan(&sch.GroupID, &sch.CloudAccountID, &sch.Timezone, &sch.Windows, &sch.LastState,
+			&sch.LastError, &sch.CreatedAt, &sch.UpdatedAt); er != nil {
+			return nil, er
+		}
+
+		schedules = append(schedules, sch)
+	}
+
+	return schedules, nil
+}
+
+// UpsertSchedule creates or replaces the schedule of a resource group. The state last applied to the members
+// and its failure are cleared so that the new windows are applied by the next scheduler run.
+func (*Store) UpsertSchedule(ctx *gofr.Context, sch *models.Schedule) error {
+	_, err := ctx.SQL.ExecContext(ctx, `INSERT INTO resource_group_schedules (group_id, timezone, windows)
+		VALUES (?, ?, ?) ON CONFLICT (group_id) DO UPDATE SET timezone = excluded.timezone,
+		windows = excluded.windows, last_state = '', last_error = '', updated_at = ?`,
+		sch.GroupID, sch.Timezone, sch.Windows, time.Now().UTC())
+
+	return err
+}
+
+// UpdateScheduleState records the state last applied to the members of a resource group by its schedule, along with
+// the failure of the members when the schedule gave up applying it.
+func (*Store) UpdateScheduleState(ctx *gofr.Context, groupID int64, state, lastError string) error {
+	_, err := ctx.SQL.ExecContext(ctx, `UPDATE resource_group_schedules SET last_state = ?, last_error = ?
+		WHERE group_id = ?`, state, lastError, groupID)
+
+	return err
+}
+
+// DeleteSchedule deletes the schedule of a resource group.
+func (*Store) DeleteSchedule(ctx *gofr.Context, groupID int64) error {
+	_, err := ctx.SQL.ExecContext(ctx, `DELETE FROM resource_group_schedules WHERE group_id = ?`, groupID)
+
+	return err
+}
@@ -0,0 +1,115 @@
+package resourcegroup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zopdev/zopdev/api/resources/models"
+)
+
+var scheduleColumns = []string{"group_id", "timezone", "windows", "last_state", "last_error", "created_at",
+	"updated_at"}
+
+func TestStore_GetSchedule(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	query := `SELECT group_id, timezone, windows, last_state, last_error, created_at,
+		updated_at FROM resource_group_schedules WHERE group_id = ?`
+	mockTime := time.Now()
+	windows := `[{"days":["MON","FRI"],"start":"08:00","stop":"20:00"}]`
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(scheduleColumns).AddRow(1, "Europe/Berlin", windows, "SUSPEND",
+			"gave up after 5 attempts", mockTime, mockTime))
+
+	sch, err := store.GetSchedule(ctx, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.Schedule{GroupID: 1, Timezone: "Europe/Berlin", LastState: "SUSPEND",
+		LastError: "gave up after 5 attempts",
+		Windows:   models.ScheduleWindows{{Days: []string{"MON", "FRI"}, Start: "08:00", Stop: "20:00"}},
+		CreatedAt: mockTime, UpdatedAt: mockTime}, sch)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(2).WillReturnRows(sqlmock.NewRows(scheduleColumns))
+
+	sch, err = store.GetSchedule(ctx, 2)
+
+	require.NoError(t, err)
+	assert.Nil(t, sch)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(3).WillReturnError(assert.AnError)
+
+	sch, err = store.GetSchedule(ctx, 3)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, sch)
+}
+
+func TestStore_GetAllSchedules(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	query := `SELECT s.group_id, g.cloud_account_id, s.timezone, s.windows,
+		s.last_state, s.last_error, s.created_at, s.updated_at FROM resource_group_schedules s
+		JOIN resource_groups g ON g.id = s.group_id WHERE g.deleted_at IS NULL ORDER BY s.group_id`
+	mockTime := time.Now()
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).
+		WillReturnRows(sqlmock.NewRows([]string{"group_id", "cloud_account_id", "timezone", "windows", "last_state",
+			"last_error", "created_at", "updated_at"}).
+			AddRow(1, 123, "UTC", `[{"days":["SAT"],"start":"22:00","stop":"06:00"}]`, "", "", mockTime, mockTime))
+
+	schedules, err := store.GetAllSchedules(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Schedule{{GroupID: 1, CloudAccountID: 123, Timezone: "UTC",
+		Windows:   models.ScheduleWindows{{Days: []string{"SAT"}, Start: "22:00", Stop: "06:00"}},
+		CreatedAt: mockTime, UpdatedAt: mockTime}}, schedules)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WillReturnError(assert.AnError)
+
+	schedules, err = store.GetAllSchedules(ctx)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, schedules)
+}
+
+func TestStore_UpsertSchedule(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	sch := &models.Schedule{GroupID: 1, Timezone: "UTC",
+		Windows: models.ScheduleWindows{{Days: []string{"MON"}, Start: "08:00", Stop: "20:00"}}}
+
+	mocks.SQL.Sqlmock.ExpectExec(`INSERT INTO resource_group_schedules (group_id, timezone, windows)
+		VALUES (?, ?, ?) ON CONFLICT (group_id) DO UPDATE SET timezone = excluded.timezone,
+		windows = excluded.windows, last_state = '', last_error = '', updated_at = ?`).
+		WithArgs(1, "UTC", sch.Windows, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	require.NoError(t, store.UpsertSchedule(ctx, sch))
+}
+
+func TestStore_UpdateScheduleState(t *testing.T) {
+	ctx, mocks, store := setup(t)
+
+	query := `UPDATE resource_group_schedules SET last_state = ?, last_error = ?
+		WHERE group_id = ?`
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs("SUSPEND", "gave up after 5 attempts", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, store.UpdateScheduleState(ctx, 1, "SUSPEND", "gave up after 5 attempts"))
+
+	mocks.SQL.Sqlmock.ExpectExec(query).WithArgs("SUSPEND", "", 1).WillReturnError(assert.AnError)
+
+	require.ErrorIs(t, store.UpdateScheduleState(ctx, 1, "SUSPEND", ""), assert.AnError)
+}
+
+func TestStore_DeleteSchedule(t *testing.T) {
+	ctx, mocks, store := setup(t)
+
+	mocks.SQL.Sqlmock.ExpectExec(`DELETE FROM resource_group_schedules WHERE group_id = ?`).
+		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, store.DeleteSchedule(ctx, 1))
+}
@@ -3,9 +3,11 @@ package resourcegroup
 import (
 	"database/sql"
 	"errors"
+	"slices"
 	"time"
 
 	"gofr.dev/pkg/gofr"
+	gofrSQL "gofr.dev/pkg/gofr/datasource/sql"
 
 	"github.com/zopdev/zopdev/api/resources/models"
 )
@@ -154,3 +156,63 @@ func (*Store) RemoveResourceFromGroup(ctx *gofr.Context, groupID, resourceID int
 
 	return nil
 }
+
+// GetResourceTiers retrieves the tier of each resource of a resource group.
+func (*Store) GetResourceTiers(ctx *gofr.Context, groupID int64) (map[int64]int, error) {
+	rows, err := ctx.SQL.QueryContext(ctx,
+		`SELECT resource_id, tier FROM resource_group_memberships WHERE group_id = ?`, groupID)
+	if err != nil || rows.Err() != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	tiers := make(map[int64]int)
+
+	for rows.Next() {
+		var (
+			id   int64
+			tier int
+		)
+
+		if er := rows.Scan(&id, &tier); er != nil {
+			return nil, er
+		}
+
+		tiers[id] = tier
+	}
+
+	return tiers, nil
+}
+
+// SetResourceTiers sets the tiers of the resources of a resource group, the resources without a tier are moved to
+// the first tier. The tiers are set in a transaction so that a failed update keeps the previous tiers.
+func (*Store) SetResourceTiers(ctx *gofr.Context, groupID int64, tiers map[int64]int) error {
+	ids := make([]int64, 0, len(tiers))
+
+	for id, tier := range tiers {
+		if tier != 0 {
+			ids = append(ids, id)
+		}
+	}
+
+	slices.Sort(ids)
+
+	return inTx(ctx, func(tx *gofrSQL.Tx) error {
+		_, err := tx.ExecContext(ctx, `UPDATE resource_group_memberships SET tier = 0 WHERE group_id = ?`, groupID)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			_, err = tx.ExecContext(ctx,
+				`UPDATE resource_group_memberships SET tier = ? WHERE group_id = ? AND resource_id = ?`,
+				tiers[id], groupID, id)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -212,3 +212,55 @@ func TestStore_RemoveResourceFromGroup_Error(t *testing.T) {
 
 	require.Error(t, err)
 }
+
+func TestStore_GetResourceTiers(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	query := `SELECT resource_id, tier FROM resource_group_memberships WHERE group_id = ?`
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"resource_id", "tier"}).AddRow(10, 0).AddRow(11, 1))
+
+	tiers, err := store.GetResourceTiers(ctx, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]int{10: 0, 11: 1}, tiers)
+
+	mocks.SQL.Sqlmock.ExpectQuery(query).WithArgs(1).WillReturnError(assert.AnError)
+
+	tiers, err = store.GetResourceTiers(ctx, 1)
+
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, tiers)
+}
+
+func TestStore_SetResourceTiers(t *testing.T) {
+	ctx, mocks, store := setup(t)
+	reset := `UPDATE resource_group_memberships SET tier = 0 WHERE group_id = ?`
+	update := `UPDATE resource_group_memberships SET tier = ? WHERE group_id = ? AND resource_id = ?`
+
+	mocks.SQL.Sqlmock.ExpectBegin()
+	mocks.SQL.Sqlmock.ExpectExec(reset).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 3))
+	mocks.SQL.Sqlmock.ExpectExec(update).WithArgs(1, 1, 11).WillReturnResult(sqlmock.NewResult(0, 1))
+	mocks.SQL.Sqlmock.ExpectExec(update).WithArgs(2, 1, 12).WillReturnResult(sqlmock.NewResult(0, 1))
+	mocks.SQL.Sqlmock.ExpectCommit()
+
+	require.NoError(t, store.SetResourceTiers(ctx, 1, map[int64]int{10: 0, 12: 2, 11: 1}))
+
+	// The previous tiers are kept when a resource fails to move to its tier.
+	mocks.SQL.Sqlmock.ExpectBegin()
+	mocks.SQL.Sqlmock.ExpectExec(reset).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 3))
+	mocks.SQL.Sqlmock.ExpectExec(update).WithArgs(1, 1, 11).WillReturnError(assert.AnError)
+	mocks.SQL.Sqlmock.ExpectRollback()
+
+	require.ErrorIs(t, store.SetResourceTiers(ctx, 1, map[int64]int{11: 1}), assert.AnError)
+
+	mocks.SQL.Sqlmock.ExpectBegin()
+	mocks.SQL.Sqlmock.ExpectExec(reset).WithArgs(1).WillReturnError(assert.AnError)
+	mocks.SQL.Sqlmock.ExpectRollback()
+
+	require.ErrorIs(t, store.SetResourceTiers(ctx, 1, nil), assert.AnError)
+
+	mocks.SQL.Sqlmock.ExpectBegin().WillReturnError(assert.AnError)
+
+	require.ErrorIs(t, store.SetResourceTiers(ctx, 1, nil), assert.AnError)
+}